@@ -4,9 +4,11 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 )
@@ -24,6 +26,20 @@ func NewAuth(client bind.ContractBackend, privHex string) (*bind.TransactOpts, e
 	}
 
 	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
+	return prepare(client, fromAddress, bind.NewKeyedTransactor(privateKey))
+}
+
+// NewKeystoreAuth is NewAuth for a key held in an encrypted keystore file
+func NewKeystoreAuth(client bind.ContractBackend, keyin io.Reader, passphrase string) (*bind.TransactOpts, error) {
+	auth, err := bind.NewTransactor(keyin, passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	return prepare(client, auth.From, auth)
+}
+
+// prepare sets the nonce, gas price and default limits of auth
+func prepare(client bind.ContractBackend, fromAddress common.Address, auth *bind.TransactOpts) (*bind.TransactOpts, error) {
 	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
 	if err != nil {
 		return nil, errors.Wrap(err, "Could not fetch nonce: ")
@@ -34,7 +50,6 @@ func NewAuth(client bind.ContractBackend, privHex string) (*bind.TransactOpts, e
 		return nil, errors.Wrap(err, "could not estimate gas price")
 	}
 
-	auth := bind.NewKeyedTransactor(privateKey)
 	auth.Nonce = big.NewInt(int64(nonce))
 	auth.Value = big.NewInt(0)      // in wei
 	auth.GasLimit = uint64(3000000) // in units
@@ -0,0 +1,268 @@
+package bind
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ReadArtifacts walks a directory of contract artifacts, as written by Hardhat
+// (artifacts/), Truffle (build/contracts/) or Foundry (out/), and reads the
+// contracts matching the filters. Filters are globs matched against either the
+// contract name or its fully qualified name (source:Name), and the ones starting
+// with ! exclude contracts; without any filter every contract is read.
+// Placeholders of the linked libraries are rewritten from the artifacts' link
+// references, and returned mapped to the library names like ParseSolcOutput.
+func ReadArtifacts(dir string, filters []string) ([]Contract, map[string]string, error) {
+	if err := checkFilters(filters); err != nil {
+		return nil, nil, err
+	}
+	var artifacts []*artifact
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// Hardhat keeps the full compiler input and output next to the artifacts
+		if info.IsDir() {
+			if info.Name() == "build-info" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".json" || strings.HasSuffix(path, ".dbg.json") {
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		a, err := parseArtifact(data, path)
+		if err != nil {
+			return fmt.Errorf("invalid artifact %s: %v", path, err)
+		}
+		if a != nil {
+			artifacts = append(artifacts, a)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(artifacts, func(i, j int) bool { return artifacts[i].qualified() < artifacts[j].qualified() })
+
+	var (
+		contracts []Contract
+		libs      = make(map[string]string)
+		declared  = make(map[string]string)
+	)
+	for _, a := range artifacts {
+		for pattern, name := range a.links {
+			libs[pattern] = name
+		}
+		if !matchContract(a.name, a.qualified(), filters) {
+			continue
+		}
+		if other, ok := declared[a.name]; ok {
+			return nil, nil, fmt.Errorf("contract %s is declared by both %s and %s, filter one out", a.name, other, a.qualified())
+		}
+		declared[a.name] = a.qualified()
+		contracts = append(contracts, a.contract)
+	}
+	if len(contracts) == 0 {
+		return nil, nil, fmt.Errorf("no contract artifacts found in %s", dir)
+	}
+	return contracts, libs, nil
+}
+
+// artifact is a contract read from an artifact file.
+type artifact struct {
+	name     string            // Name of the contract
+	source   string            // Source file declaring the contract
+	contract Contract          // Contract to bind, with normalized link placeholders
+	links    map[string]string // Link placeholders of the libraries used -> library name
+}
+
+// qualified returns the fully qualified name of the contract.
+func (a *artifact) qualified() string {
+	return a.source + ":" + a.name
+}
+
+// linkReferences are the positions of library addresses in bytecode, keyed by
+// source file and library name.
+type linkReferences map[string]map[string][]struct {
+	Start  int
+	Length int
+}
+
+// parseArtifact reads a Hardhat, Truffle or Foundry artifact. Other JSON files
+// found along the artifacts are skipped by returning nil.
+func parseArtifact(data []byte, path string) (*artifact, error) {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil {
+		return nil, nil
+	}
+	abi, ok := fields["abi"]
+	if !ok {
+		return nil, nil
+	}
+	var raw struct {
+		ContractName   string            `json:"contractName"`
+		SourceName     string            `json:"sourceName"` // Hardhat
+		SourcePath     string            `json:"sourcePath"` // Truffle
+		Bytecode       json.RawMessage   `json:"bytecode"`
+		LinkReferences linkReferences    `json:"linkReferences"`
+		Deployed       json.RawMessage   `json:"deployedBytecode"`
+		DeployedLinks  linkReferences    `json:"deployedLinkReferences"` // Hardhat
+		UserDoc        json.RawMessage   `json:"userdoc"`
+		DevDoc         json.RawMessage   `json:"devdoc"`
+		Methods        map[string]string `json:"methodIdentifiers"` // Foundry
+		Metadata       json.RawMessage   `json:"metadata"`          // Foundry
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	a := &artifact{
+		name:   raw.ContractName,
+		source: raw.SourceName,
+		links:  make(map[string]string),
+	}
+	if a.source == "" {
+		a.source = raw.SourcePath
+	}
+	// Foundry nests the bytecode with its link references and names artifacts
+	// out/Source.sol/Name.json
+	bin, runtime := solcString(raw.Bytecode), solcString(raw.Deployed)
+	var bytecode, deployed struct {
+		Object         string
+		LinkReferences linkReferences `json:"linkReferences"`
+	}
+	if strings.HasPrefix(strings.TrimSpace(string(raw.Bytecode)), "{") {
+		if err := json.Unmarshal(raw.Bytecode, &bytecode); err != nil {
+			return nil, err
+		}
+		bin, raw.LinkReferences = bytecode.Object, bytecode.LinkReferences
+		if len(raw.Deployed) > 0 {
+			if err := json.Unmarshal(raw.Deployed, &deployed); err != nil {
+				return nil, err
+			}
+			runtime, raw.DeployedLinks = deployed.Object, deployed.LinkReferences
+		}
+		var metadata struct {
+			Settings struct {
+				CompilationTarget map[string]string `json:"compilationTarget"`
+			}
+			Output struct {
+				UserDoc json.RawMessage `json:"userdoc"`
+				DevDoc  json.RawMessage `json:"devdoc"`
+			}
+		}
+		// Metadata only provides names and docs, so it's read on a best effort basis
+		if len(raw.Metadata) > 0 {
+			json.Unmarshal([]byte(solcString(raw.Metadata)), &metadata)
+		}
+		for source, name := range metadata.Settings.CompilationTarget {
+			a.source, a.name = source, name
+		}
+		raw.UserDoc, raw.DevDoc = metadata.Output.UserDoc, metadata.Output.DevDoc
+	}
+	if a.name == "" {
+		a.name = strings.TrimSuffix(filepath.Base(path), ".json")
+	}
+	if a.source == "" {
+		a.source = filepath.Base(filepath.Dir(path))
+	}
+	bin = strings.TrimPrefix(strings.TrimSpace(bin), "0x")
+	bin, err := linkArtifact(bin, raw.LinkReferences, a.links)
+	if err != nil {
+		return nil, err
+	}
+	runtime = strings.TrimPrefix(strings.TrimSpace(runtime), "0x")
+	if runtime, err = linkArtifact(runtime, raw.DeployedLinks, a.links); err != nil {
+		return nil, err
+	}
+	a.contract = Contract{
+		Type:            a.name,
+		ABI:             solcString(abi),
+		Bytecode:        bin,
+		RuntimeBytecode: runtime,
+		FuncSigs:        raw.Methods,
+		UserDoc:         solcString(raw.UserDoc),
+		DevDoc:          solcString(raw.DevDoc),
+	}
+	return a, nil
+}
+
+// linkArtifact rewrites the library placeholders of bytecode into the ones solc
+// derives from the fully qualified library names, recording them in links.
+// Without link references (Truffle), the placeholders solc 0.4 wrote, the library
+// name padded with underscores, are rewritten instead.
+func linkArtifact(bin string, refs linkReferences, links map[string]string) (string, error) {
+	code := []byte(bin)
+	for source, libs := range refs {
+		for name, positions := range libs {
+			pattern := LibraryPattern(source + ":" + name)
+			placeholder := "__$" + pattern + "$__"
+			for _, pos := range positions {
+				start, end := pos.Start*2, (pos.Start+pos.Length)*2
+				if pos.Length != 20 || start < 0 || end > len(code) {
+					return "", fmt.Errorf("invalid link reference to %s at %d", name, pos.Start)
+				}
+				copy(code[start:end], placeholder)
+			}
+			links[pattern] = name
+		}
+	}
+	bin = namedPlaceholder.ReplaceAllStringFunc(string(code), func(placeholder string) string {
+		if strings.HasPrefix(placeholder, "__$") {
+			return placeholder
+		}
+		name := strings.TrimRight(placeholder[2:], "_")
+		pattern := LibraryPattern(name)
+		links[pattern] = name[strings.LastIndex(name, ":")+1:]
+		return "__$" + pattern + "$__"
+	})
+	return bin, nil
+}
+
+// namedPlaceholder matches both the library placeholders of solc 0.5 onwards and
+// the older ones, which are the library name padded with underscores to the 40
+// characters of an address.
+var namedPlaceholder = regexp.MustCompile(`__\$[0-9a-f]{34}\$__|__[^$][0-9A-Za-z_.:/-]{37}`)
+
+// checkFilters validates the globs used to select contracts.
+func checkFilters(filters []string) error {
+	for _, filter := range filters {
+		if _, err := filepath.Match(strings.TrimPrefix(filter, "!"), ""); err != nil {
+			return fmt.Errorf("invalid filter %q: %v", filter, err)
+		}
+	}
+	return nil
+}
+
+// matchContract reports whether a contract is selected by the filters, given its
+// name and fully qualified name. Without inclusive filters every contract is
+// selected, and later filters take precedence over earlier ones.
+func matchContract(name, qualified string, filters []string) bool {
+	selected := true
+	for _, filter := range filters {
+		if !strings.HasPrefix(filter, "!") {
+			selected = false
+			break
+		}
+	}
+	for _, filter := range filters {
+		exclude := strings.HasPrefix(filter, "!")
+		pattern := strings.TrimPrefix(filter, "!")
+		byName, _ := filepath.Match(pattern, name)
+		byQualified, _ := filepath.Match(pattern, qualified)
+		if byName || byQualified {
+			selected = !exclude
+		}
+	}
+	return selected
+}
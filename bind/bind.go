@@ -22,14 +22,17 @@ package bind
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"go/format"
 	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 	"unicode"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -48,38 +51,150 @@ const (
 	LangObjC
 )
 
-// types should just be []string{"mainStructType"}
-// abis should just be the string version of the abis most likely []string{"single json abi"}
-// fsigs can be empty I'm pretty sure...
-// pkg is the name of the package
-// libs can be empty I'm pretty sure...
-//
+// Contract describes a single contract to generate bindings for.
+type Contract struct {
+	Type      string            // Type name of the contract binding
+	ABI       string            // JSON ABI of the contract
+	Bytecode  string            // Optional deploy bytecode, used to generate the Deploy method
+	Interface string            // Optional name of the generated interface (default = TypeInterface)
+	FuncSigs  map[string]string // Optional map: string signature -> 4-byte signature
+	UserDoc   string            // Optional solc userdoc JSON, carried into the doc comments
+	DevDoc    string            // Optional solc devdoc JSON, carried into the doc comments
+	Standards []string          // Optional standards (erc20, erc721, erc1155) the binding asserts it implements when the ABI conforms
+
+	RuntimeBytecode  string // Optional runtime bytecode, the code deployed by Bytecode
+	SourceMap        string // Optional solc source map of Bytecode
+	RuntimeSourceMap string // Optional solc source map of RuntimeBytecode
+}
+
+// Options configures the bindings generated by Bind and BindMock.
+type Options struct {
+	Package   string            // Name of the package to generate the bindings in
+	Contracts []Contract        // Contracts to generate bindings for
+	Aliases   map[string]string // Optional renaming of methods and events: original name -> alias
+	Libraries map[string]string // Optional map: library link pattern -> library type name
+}
 
 // Bind generates a Go wrapper around a contract ABI. This wrapper isn't meant
 // to be used as is in client code, but rather as an intermediate struct which
 // enforces compile time type safety and naming convention opposed to having to
 // manually maintain hard coded strings that break on runtime.
-func Bind(types []string, abis []string, bytecodes []string, pkg string) (string, error) {
+func Bind(opts Options) (string, error) {
+	data, err := parse(opts)
+	if err != nil {
+		return "", err
+	}
+	return render(tmplSourceGo, data, LangGo)
+}
+
+// BindMock generates a scriptable mock for each of the contracts passed, meant
+// to live next to the output of Bind in the same package. Every mock implements
+// the interface generated for its contract, records the calls made to it and
+// returns whatever was scripted using its On{{Method}} helpers.
+func BindMock(opts Options) (string, error) {
+	data, err := parse(opts)
+	if err != nil {
+		return "", err
+	}
+	return render(tmplMockGo, data, LangGo)
+}
+
+// BindTests generates a test skeleton for each of the contracts passed that has
+// bytecode, meant to live next to the output of Bind in the same package. Each
+// contract is deployed onto a simulated backend, funded from sim.Accounts, and
+// gets a skipped subtest per transaction to fill in.
+func BindTests(opts Options) (string, error) {
+	data, err := parse(opts)
+	if err != nil {
+		return "", err
+	}
+	return render(tmplTestGo, data, LangGo)
+}
+
+// StructsFile is the name of the file BindPackage declares shared structs in.
+const StructsFile = "structs_gen.go"
+
+// GeneratedHeader opens every file generated by buddy, apart from the test
+// skeletons meant to be edited.
+const GeneratedHeader = "// Code generated by buddy. DO NOT EDIT."
+
+// BindPackage generates the bindings of several contracts as the files of a
+// single package, keyed by file name. Every contract gets a Type_gen.go file,
+// a Type_mock.go file if mock is set and a Type_test.go file if tests is set,
+// while the structs used across them are declared once in StructsFile. Struct
+// names clashing between contracts are prefixed with the contract type, so the
+// output is stable across runs.
+func BindPackage(opts Options, mock, tests bool) (map[string]string, error) {
+	data, err := parse(opts)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]string)
+	if len(data.Structs) > 0 {
+		code, err := render(tmplStructsGo, data, LangGo)
+		if err != nil {
+			return nil, err
+		}
+		files[StructsFile] = code
+	}
+	for _, contract := range opts.Contracts {
+		single := &tmplData{
+			Package:       data.Package,
+			Contracts:     map[string]*tmplContract{contract.Type: data.Contracts[contract.Type]},
+			Libraries:     data.Libraries,
+			Structs:       data.Structs,
+			SharedStructs: true,
+		}
+		sources := map[string]string{contract.Type + "_gen.go": tmplSourceGo}
+		if mock {
+			sources[contract.Type+"_mock.go"] = tmplMockGo
+		}
+		if tests && single.Contracts[contract.Type].InputBin != "" {
+			sources[contract.Type+"_test.go"] = tmplTestGo
+		}
+		for name, source := range sources {
+			if _, ok := files[name]; ok {
+				return nil, fmt.Errorf("contract %s: file %s is generated twice", contract.Type, name)
+			}
+			code, err := render(source, single, LangGo)
+			if err != nil {
+				return nil, err
+			}
+			files[name] = code
+		}
+	}
+	return files, nil
+}
+
+// linkPlaceholder matches the library placeholders solc leaves in unlinked bytecode.
+var linkPlaceholder = regexp.MustCompile(`__\$([0-9a-f]{34})\$__`)
+
+// parse digests the contract ABIs into the data structure used to fill the
+// binding templates.
+func parse(opts Options) (*tmplData, error) {
 	// put in defaults here
 	lang := LangGo
-	var fsigs []map[string]string
-	libs := make(map[string]string)
-	aliases := make(map[string]string)
+	libs := opts.Libraries
+	if libs == nil {
+		libs = make(map[string]string)
+	}
 	var (
 		// contracts is the map of each individual contract requested binding
 		contracts = make(map[string]*tmplContract)
 
 		// structs is the map of all reclared structs shared by passed contracts.
 		structs = make(map[string]*tmplStruct)
-
-		// isLib is the map used to flag each encountered library as such
-		isLib = make(map[string]struct{})
 	)
-	for i := 0; i < len(types); i++ {
+	for _, contract := range opts.Contracts {
+		if _, ok := contracts[contract.Type]; ok {
+			return nil, fmt.Errorf("duplicated contract type %s", contract.Type)
+		}
+		// Overloads named by the standards keep their names in conforming contracts
+		aliases := standardAliases(opts.Aliases, contract.Standards)
 		// Parse the actual ABI to generate the binding for
-		evmABI, err := abi.JSON(strings.NewReader(abis[i]))
+		evmABI, err := abi.JSON(strings.NewReader(contract.ABI))
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		// Strip any whitespace from the JSON ABI
 		strippedABI := strings.Map(func(r rune) rune {
@@ -87,7 +202,7 @@ func Bind(types []string, abis []string, bytecodes []string, pkg string) (string
 				return -1
 			}
 			return r
-		}, abis[i])
+		}, contract.ABI)
 
 		// Extract the call and transact methods; events, struct definitions; and sort them alphabetically
 		var (
@@ -95,6 +210,10 @@ func Bind(types []string, abis []string, bytecodes []string, pkg string) (string
 			transacts = make(map[string]*tmplMethod)
 			events    = make(map[string]*tmplEvent)
 
+			// jsonFields is set when an event has fields converted to JSON
+			// at runtime, see jsonType
+			jsonFields bool
+
 			// identifiers are used to detect duplicated identifier of function
 			// and event. For all calls, transacts and events, abigen will generate
 			// corresponding bindings. However we have to ensure there is no
@@ -103,17 +222,35 @@ func Bind(types []string, abis []string, bytecodes []string, pkg string) (string
 			transactIdentifiers = make(map[string]bool)
 			eventIdentifiers    = make(map[string]bool)
 		)
-		for _, original := range evmABI.Methods {
+		docs, err := parseNatspec(contract)
+		if err != nil {
+			return nil, err
+		}
+		overloads := overloadNames(evmABI.Methods)
+		// Walk methods and events in order, so anonymous structs are numbered
+		// the same way on every run
+		for _, key := range sortedKeys(evmABI.Methods) {
+			original := evmABI.Methods[key]
+			doc := docs.method(original)
+			// Overloads are named after their inputs and called by full signature
+			name := alias(aliases, original.Name)
+			if overload, ok := overloads[original.Name]; ok {
+				name = alias(aliases, original.Sig())
+				if name == original.Sig() {
+					name = overload
+				}
+				original.Name = original.Sig()
+			}
 			// Normalize the method for capital cases and non-anonymous inputs/outputs
 			normalized := original
-			normalizedName := methodNormalizer[lang](alias(aliases, original.Name))
+			normalizedName := methodNormalizer[lang](name)
 			// Ensure there is no duplicated identifier
 			var identifiers = callIdentifiers
 			if !original.Const {
 				identifiers = transactIdentifiers
 			}
 			if identifiers[normalizedName] {
-				return "", fmt.Errorf("duplicated identifier \"%s\"(normalized \"%s\"), use --alias for renaming", original.Name, normalizedName)
+				return nil, fmt.Errorf("duplicated identifier \"%s\"(normalized \"%s\"), use --alias for renaming", original.Name, normalizedName)
 			}
 			identifiers[normalizedName] = true
 			normalized.Name = normalizedName
@@ -139,12 +276,13 @@ func Bind(types []string, abis []string, bytecodes []string, pkg string) (string
 			}
 			// Append the methods to the call or transact lists
 			if original.Const {
-				calls[original.Name] = &tmplMethod{Original: original, Normalized: normalized, Structured: structured(original.Outputs)}
+				calls[original.Name] = &tmplMethod{Original: original, Normalized: normalized, Structured: structured(original.Outputs), Doc: doc}
 			} else {
-				transacts[original.Name] = &tmplMethod{Original: original, Normalized: normalized, Structured: structured(original.Outputs)}
+				transacts[original.Name] = &tmplMethod{Original: original, Normalized: normalized, Structured: structured(original.Outputs), Doc: doc}
 			}
 		}
-		for _, original := range evmABI.Events {
+		for _, key := range sortedKeys(evmABI.Events) {
+			original := evmABI.Events[key]
 			// Skip anonymous events as they don't support explicit filtering
 			if original.Anonymous {
 				continue
@@ -155,7 +293,7 @@ func Bind(types []string, abis []string, bytecodes []string, pkg string) (string
 			// Ensure there is no duplicated identifier
 			normalizedName := methodNormalizer[lang](alias(aliases, original.Name))
 			if eventIdentifiers[normalizedName] {
-				return "", fmt.Errorf("duplicated identifier \"%s\"(normalized \"%s\"), use --alias for renaming", original.Name, normalizedName)
+				return nil, fmt.Errorf("duplicated identifier \"%s\"(normalized \"%s\"), use --alias for renaming", original.Name, normalizedName)
 			}
 			eventIdentifiers[normalizedName] = true
 			normalized.Name = normalizedName
@@ -169,54 +307,157 @@ func Bind(types []string, abis []string, bytecodes []string, pkg string) (string
 				if hasStruct(input.Type) {
 					bindStructType[lang](input.Type, structs)
 				}
+				goType := bindType[lang](input.Type, structs)
+				if input.Indexed {
+					goType = bindTopicType[lang](input.Type, structs)
+				}
+				if lang == LangGo && jsonType(goType) == "json.RawMessage" {
+					jsonFields = true
+				}
 			}
 			// Append the event to the accumulator list
-			newEvent := &tmplEvent{Original: original, Normalized: normalized, Topic: original.ID().Hex()}
+			newEvent := &tmplEvent{Original: original, Normalized: normalized, Topic: original.ID().Hex(), Doc: docs.event(original)}
 			events[original.Name] = newEvent
 		}
+		// Methods share the contract type with the helpers generated for
+		// transactions and events, so they can't take the same names
+		generated := generatedMethodNames(transacts, events)
+		for _, methods := range []map[string]*tmplMethod{calls, transacts} {
+			for _, key := range sortedKeys(methods) {
+				method := methods[key]
+				if source, ok := generated[method.Normalized.Name]; ok {
+					return nil, fmt.Errorf("identifier \"%s\"(normalized \"%s\") clashes with the method generated for %s, use --alias for renaming", method.Original.Name, method.Normalized.Name, source)
+				}
+			}
+		}
+		// Custom errors are skipped by abi.JSON, so dig them out separately
+		errs, errsABI, err := parseErrors(contract.ABI, aliases, lang, structs)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range errs {
+			e.Doc = docs.error(e.Sig, e.Inputs)
+		}
+		// Constructor arguments may be structs as well
+		for _, input := range evmABI.Constructor.Inputs {
+			if hasStruct(input.Type) {
+				bindStructType[lang](input.Type, structs)
+			}
+		}
+		// Remember which contract declared each struct first, to name clashes after it
+		for _, s := range structs {
+			if s.Owner == "" {
+				s.Owner = capitalise(contract.Type)
+			}
+		}
 
-		contracts[types[i]] = &tmplContract{
-			Type:        capitalise(types[i]),
-			InputABI:    strings.Replace(strippedABI, "\"", "\\\"", -1),
-			InputBin:    strings.TrimPrefix(strings.TrimSpace(bytecodes[i]), "0x"),
-			Constructor: evmABI.Constructor,
-			Calls:       calls,
-			Transacts:   transacts,
-			Events:      events,
-			Libraries:   make(map[string]string),
+		// Default the interface name to the contract type if none was requested
+		ifaceName := capitalise(contract.Type) + "Interface"
+		if contract.Interface != "" {
+			ifaceName = capitalise(contract.Interface)
 		}
-		// Function 4-byte signatures are stored in the same sequence
-		// as types, if available.
-		if len(fsigs) > i {
-			contracts[types[i]].FuncSigs = fsigs[i]
+		contracts[contract.Type] = &tmplContract{
+			Type:             capitalise(contract.Type),
+			InterfaceName:    ifaceName,
+			InputABI:         strings.Replace(strippedABI, "\"", "\\\"", -1),
+			InputBin:         strings.TrimPrefix(strings.TrimSpace(contract.Bytecode), "0x"),
+			RuntimeBin:       strings.TrimPrefix(strings.TrimSpace(contract.RuntimeBytecode), "0x"),
+			SourceMap:        strings.TrimSpace(contract.SourceMap),
+			RuntimeSourceMap: strings.TrimSpace(contract.RuntimeSourceMap),
+			Constructor:      evmABI.Constructor,
+			Doc:              docs.contract(),
+			ConstructorDoc:   docs.constructor(evmABI.Constructor),
+			Calls:            calls,
+			Transacts:        transacts,
+			Events:           events,
+			JSONFields:       jsonFields,
+			FuncSigs:         contract.FuncSigs,
+			Errors:           errs,
+			ErrorsABI:        strings.Replace(errsABI, "\"", "\\\"", -1),
+			Libraries:        make(map[string]string),
 		}
 		// Parse library references.
 		for pattern, name := range libs {
-			matched, err := regexp.Match("__\\$"+pattern+"\\$__", []byte(contracts[types[i]].InputBin))
+			matched, err := regexp.Match("__\\$"+pattern+"\\$__", []byte(contracts[contract.Type].InputBin))
 			if err != nil {
-				log.Error("Could not search for pattern", "pattern", pattern, "contract", contracts[types[i]], "err", err)
+				log.Error("Could not search for pattern", "pattern", pattern, "contract", contracts[contract.Type], "err", err)
 			}
 			if matched {
-				contracts[types[i]].Libraries[pattern] = name
-				// keep track that this type is a library
-				if _, ok := isLib[name]; !ok {
-					isLib[name] = struct{}{}
-				}
+				contracts[contract.Type].Libraries[pattern] = name
+			}
+		}
+		// Refuse to generate a deployer that can never be linked
+		for _, placeholder := range linkPlaceholder.FindAllStringSubmatch(contracts[contract.Type].InputBin, -1) {
+			if _, ok := contracts[contract.Type].Libraries[placeholder[1]]; !ok {
+				return nil, fmt.Errorf("contract %s links against unknown library %s, name it with --lib", contract.Type, placeholder[0])
+			}
+		}
+		// Assert the binding implements the interfaces of the standards its ABI
+		// conforms to, as long as aliases left their methods alone
+		for _, standard := range contract.Standards {
+			conformance, err := checkStandard(evmABI, standard)
+			if err != nil {
+				return nil, err
+			}
+			if !conformance.Conforms() {
+				continue
+			}
+			std, err := parseStandard(standard)
+			if err != nil {
+				return nil, err
+			}
+			if implements(contracts[contract.Type], std) {
+				contracts[contract.Type].Standards = append(contracts[contract.Type].Standards, std.Type)
 			}
 		}
 	}
-	// Check if that type has already been identified as a library
-	for i := 0; i < len(types); i++ {
-		_, ok := isLib[types[i]]
-		contracts[types[i]].Library = ok
+	// Calldata helpers are package level, so they're prefixed with the contract
+	// type, while event logs are only prefixed when clashing between contracts
+	owners := make(map[string]int)
+	for _, contract := range contracts {
+		for _, event := range contract.Events {
+			owners[event.Normalized.Name+"Log"]++
+		}
 	}
-	// Generate the contract template data content and render it
+	for _, contract := range contracts {
+		for _, method := range contract.methods() {
+			method.Calldata = contract.Type + method.Normalized.Name
+		}
+		for _, event := range contract.Events {
+			event.Log = event.Normalized.Name + "Log"
+			if owners[event.Log] > 1 {
+				event.Log = contract.Type + event.Log
+			}
+		}
+	}
+	renameStructs(structs)
+	// Libraries bound in the same run can be deployed ahead of the contract
+	// itself, as long as they are deployable and need no linking of their own
+	for _, contract := range contracts {
+		if len(contract.Libraries) == 0 {
+			continue
+		}
+		contract.DeployLibraries = true
+		for _, name := range contract.Libraries {
+			lib, ok := contracts[name]
+			if !ok || lib.InputBin == "" || len(lib.Libraries) > 0 || len(lib.Constructor.Inputs) > 0 {
+				contract.DeployLibraries = false
+				break
+			}
+		}
+	}
+	// Generate the contract template data content
 	data := &tmplData{
-		Package:   pkg,
+		Package:   opts.Package,
 		Contracts: contracts,
 		Libraries: libs,
 		Structs:   structs,
 	}
+	return data, nil
+}
+
+// render fills the provided template with the contract data.
+func render(source string, data interface{}, lang Lang) (string, error) {
 	buffer := new(bytes.Buffer)
 
 	funcs := map[string]interface{}{
@@ -226,9 +467,11 @@ func Bind(types []string, abis []string, bytecodes []string, pkg string) (string
 		"formatmethod":  formatMethod,
 		"formatevent":   formatEvent,
 		"capitalise":    capitalise,
+		"jsontype":      jsonType,
+		"zerovalue":     zeroValueGo,
 		"decapitalise":  decapitalise,
 	}
-	tmpl := template.Must(template.New("").Funcs(funcs).Parse(tmplSourceGo))
+	tmpl := template.Must(template.New("").Funcs(funcs).Parse(source))
 	if err := tmpl.Execute(buffer, data); err != nil {
 		return "", err
 	}
@@ -244,6 +487,12 @@ func Bind(types []string, abis []string, bytecodes []string, pkg string) (string
 	return buffer.String(), nil
 }
 
+// LibraryPattern returns the placeholder solc leaves in bytecode for the library
+// with the given fully qualified name, e.g. "contracts/Math.sol:Math".
+func LibraryPattern(qualifiedName string) string {
+	return crypto.Keccak256Hash([]byte(qualifiedName)).String()[2:36]
+}
+
 // bindType is a set of type binders that convert Solidity types to some supported
 // programming language types.
 var bindType = map[Lang]func(kind abi.Type, structs map[string]*tmplStruct) string{
@@ -433,7 +682,7 @@ func bindStructTypeGo(kind abi.Type, structs map[string]*tmplStruct) string {
 		var fields []*tmplField
 		for i, elem := range kind.TupleElems {
 			field := bindStructTypeGo(*elem, structs)
-			fields = append(fields, &tmplField{Type: field, Name: capitalise(kind.TupleRawNames[i]), SolKind: *elem})
+			fields = append(fields, &tmplField{Type: field, Name: capitalise(kind.TupleRawNames[i]), RawName: kind.TupleRawNames[i], SolKind: *elem})
 		}
 		name := kind.TupleRawName
 		if name == "" {
@@ -523,6 +772,27 @@ func namedTypeJava(javaKind string, solKind abi.Type) string {
 	}
 }
 
+// generatedMethodNames maps the names of the methods generated for transactions
+// and events to what they were generated for.
+func generatedMethodNames(transacts map[string]*tmplMethod, events map[string]*tmplEvent) map[string]string {
+	names := make(map[string]string)
+	for _, method := range transacts {
+		source := fmt.Sprintf("method %s", method.Original.Name)
+		names["Estimate"+method.Normalized.Name] = source
+		names["Simulate"+method.Normalized.Name] = source
+	}
+	for _, event := range events {
+		source := fmt.Sprintf("event %s", event.Original.Name)
+		names["Filter"+event.Normalized.Name] = source
+		names["Watch"+event.Normalized.Name] = source
+		names["Unpack"+event.Normalized.Name+"Log"] = source
+	}
+	if len(events) > 0 {
+		names["Dispatch"] = "the events"
+	}
+	return names
+}
+
 // alias returns an alias of the given string based on the aliasing rules
 // or returns itself if no rule is matched.
 func alias(aliases map[string]string, n string) string {
@@ -532,6 +802,404 @@ func alias(aliases map[string]string, n string) string {
 	return n
 }
 
+// builtinErrorsABI describes the errors solidity itself reverts with as functions,
+// so that abi.JSON can unpack their arguments.
+const builtinErrorsABI = `{"type":"function","name":"Error","outputs":[{"name":"reason","type":"string"}]},{"type":"function","name":"Panic","outputs":[{"name":"code","type":"uint256"}]}`
+
+// reservedErrorNames are the suffixes of other generated types, which custom
+// errors can't be named after.
+var reservedErrorNames = map[string]bool{
+	"ABI": true, "Bin": true, "ErrorsABI": true, "FuncSigs": true, "Interface": true, "EventHandler": true,
+	"Libraries": true, "Mock": true, "MockCall": true, "Panic": true, "Revert": true,
+	"RuntimeBin": true, "SourceMap": true, "RuntimeSourceMap": true,
+	"Session": true, "CallerSession": true, "TransactorSession": true, "SessionInterface": true,
+}
+
+// parseErrors extracts the custom errors declared in a JSON ABI, along with an
+// ABI describing them (and the builtin Error and Panic) as functions returning
+// the error arguments, which is used to decode revert data.
+func parseErrors(rawABI string, aliases map[string]string, lang Lang, structs map[string]*tmplStruct) (map[string]*tmplError, string, error) {
+	var fields []struct {
+		Type   string
+		Name   string
+		Inputs json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(rawABI), &fields); err != nil {
+		return nil, "", err
+	}
+	var (
+		errs    = make(map[string]*tmplError)
+		entries = []string{builtinErrorsABI}
+	)
+	for _, field := range fields {
+		if field.Type != "error" {
+			continue
+		}
+		// The raw inputs are kept around to describe the error as a function
+		var (
+			args []abi.ArgumentMarshaling
+			raw  []map[string]interface{}
+		)
+		if len(field.Inputs) > 0 {
+			if err := json.Unmarshal(field.Inputs, &args); err != nil {
+				return nil, "", err
+			}
+			if err := json.Unmarshal(field.Inputs, &raw); err != nil {
+				return nil, "", err
+			}
+		}
+		inputs := make([]abi.Argument, len(args))
+		types := make([]string, len(args))
+		for i, arg := range args {
+			if arg.Name == "" {
+				arg.Name = fmt.Sprintf("arg%d", i)
+				raw[i]["name"] = arg.Name
+			}
+			typ, err := abi.NewType(arg.Type, arg.InternalType, arg.Components)
+			if err != nil {
+				return nil, "", fmt.Errorf("error %s: %v", field.Name, err)
+			}
+			if hasStruct(typ) {
+				bindStructType[lang](typ, structs)
+			}
+			inputs[i] = abi.Argument{Name: abi.ToCamelCase(arg.Name), Type: typ}
+			types[i] = typ.String()
+		}
+		sig := fmt.Sprintf("%s(%s)", field.Name, strings.Join(types, ","))
+		name := alias(aliases, sig)
+		if name == sig {
+			name = alias(aliases, field.Name)
+		}
+		name = methodNormalizer[lang](name)
+		if _, ok := errs[name]; ok || reservedErrorNames[name] {
+			return nil, "", fmt.Errorf("duplicated identifier \"%s\"(normalized \"%s\"), use --alias for renaming", sig, name)
+		}
+		if raw == nil {
+			raw = []map[string]interface{}{}
+		}
+		outputs, err := json.Marshal(raw)
+		if err != nil {
+			return nil, "", err
+		}
+		entries = append(entries, fmt.Sprintf(`{"type":"function","name":%q,"outputs":%s}`, name, outputs))
+		errs[name] = &tmplError{
+			Name:     name,
+			RawName:  field.Name,
+			Sig:      sig,
+			Selector: fmt.Sprintf("%x", crypto.Keccak256([]byte(sig))[:4]),
+			Inputs:   inputs,
+		}
+	}
+	return errs, "[" + strings.Join(entries, ",") + "]", nil
+}
+
+// natspec is the user and developer documentation solc emits next to the ABI
+// (--userdoc and --devdoc), keyed by method and event signatures.
+type natspec struct {
+	user struct {
+		Notice  string
+		Methods map[string]natspecEntry
+		Events  map[string]natspecEntry
+		Errors  map[string][]natspecEntry
+	}
+	dev struct {
+		Title          string
+		Author         string
+		Details        string
+		Methods        map[string]natspecEntry
+		Events         map[string]natspecEntry
+		Errors         map[string][]natspecEntry
+		StateVariables map[string]natspecEntry `json:"stateVariables"`
+	}
+}
+
+// natspecEntry is the documentation of a single method, event or error.
+type natspecEntry struct {
+	Notice  string
+	Details string
+	Params  map[string]string
+	Returns map[string]string
+	Return  string // Older solc versions document a single return value
+}
+
+// UnmarshalJSON accepts both documentation objects and the plain notice strings
+// older solc versions use for constructors.
+func (e *natspecEntry) UnmarshalJSON(input []byte) error {
+	var notice string
+	if err := json.Unmarshal(input, &notice); err == nil {
+		e.Notice = notice
+		return nil
+	}
+	type entry natspecEntry
+	return json.Unmarshal(input, (*entry)(e))
+}
+
+// parseNatspec decodes the userdoc and devdoc of a contract, either of which may
+// be empty.
+func parseNatspec(contract Contract) (*natspec, error) {
+	docs := new(natspec)
+	if strings.TrimSpace(contract.UserDoc) != "" {
+		if err := json.Unmarshal([]byte(contract.UserDoc), &docs.user); err != nil {
+			return nil, fmt.Errorf("invalid userdoc of contract %s: %v", contract.Type, err)
+		}
+	}
+	if strings.TrimSpace(contract.DevDoc) != "" {
+		if err := json.Unmarshal([]byte(contract.DevDoc), &docs.dev); err != nil {
+			return nil, fmt.Errorf("invalid devdoc of contract %s: %v", contract.Type, err)
+		}
+	}
+	return docs, nil
+}
+
+// contract formats the documentation of the contract itself.
+func (n *natspec) contract() string {
+	var b docBuilder
+	if n.dev.Title != "" {
+		b.section("Title: " + n.dev.Title)
+	}
+	b.section(n.user.Notice)
+	b.section(n.dev.Details)
+	if n.dev.Author != "" {
+		b.section("Author: " + n.dev.Author)
+	}
+	return b.String()
+}
+
+// constructor formats the documentation of the constructor.
+func (n *natspec) constructor(method abi.Method) string {
+	return formatNatspec(n.user.Methods["constructor"], n.dev.Methods["constructor"], method.Inputs, nil)
+}
+
+// method formats the documentation of a method, falling back to the docs of the
+// state variable a getter was generated for.
+func (n *natspec) method(method abi.Method) string {
+	dev, ok := n.dev.Methods[method.Sig()]
+	if !ok && method.Const {
+		dev = n.dev.StateVariables[method.RawName]
+	}
+	return formatNatspec(n.user.Methods[method.Sig()], dev, method.Inputs, method.Outputs)
+}
+
+// event formats the documentation of an event.
+func (n *natspec) event(event abi.Event) string {
+	sig := event.Sig()
+	return formatNatspec(n.user.Events[sig], n.dev.Events[sig], event.Inputs, nil)
+}
+
+// error formats the documentation of a custom error. solc documents errors as
+// lists, since several errors can share a signature across contracts.
+func (n *natspec) error(sig string, inputs abi.Arguments) string {
+	var user, dev natspecEntry
+	if entries := n.user.Errors[sig]; len(entries) > 0 {
+		user = entries[0]
+	}
+	if entries := n.dev.Errors[sig]; len(entries) > 0 {
+		dev = entries[0]
+	}
+	return formatNatspec(user, dev, inputs, nil)
+}
+
+// formatNatspec renders documentation as comment lines to append to a doc
+// comment, or an empty string if there is none. Parameters and returns are
+// listed in the order of the ABI.
+func formatNatspec(user, dev natspecEntry, inputs, outputs abi.Arguments) string {
+	var b docBuilder
+	b.section(user.Notice)
+	b.section(dev.Notice)
+	b.section(dev.Details)
+	var params []string
+	for _, input := range inputs {
+		if text, ok := dev.Params[input.Name]; ok && input.Name != "" {
+			params = append(params, input.Name+": "+text)
+		}
+	}
+	b.list("Params:", params)
+	var returns []string
+	for i, output := range outputs {
+		key, name := output.Name, output.Name
+		if key == "" {
+			key, name = fmt.Sprintf("_%d", i), fmt.Sprintf("ret%d", i)
+		}
+		if text, ok := dev.Returns[key]; ok {
+			returns = append(returns, name+": "+text)
+		}
+	}
+	if len(returns) == 0 && dev.Return != "" {
+		returns = append(returns, dev.Return)
+	}
+	b.list("Returns:", returns)
+	return b.String()
+}
+
+// docBuilder accumulates paragraphs of a doc comment, separated by empty lines.
+type docBuilder struct {
+	strings.Builder
+}
+
+// section appends a paragraph, normalizing the whitespace of each line.
+func (b *docBuilder) section(text string) {
+	lines := docLines(text)
+	if len(lines) == 0 {
+		return
+	}
+	b.WriteString("//\n")
+	for _, line := range lines {
+		b.WriteString(strings.TrimRight("// "+line, " ") + "\n")
+	}
+}
+
+// list appends a titled list of entries, continuing multiline entries on
+// indented lines.
+func (b *docBuilder) list(title string, entries []string) {
+	if len(entries) == 0 {
+		return
+	}
+	b.WriteString("//\n// " + title + "\n")
+	for _, entry := range entries {
+		for i, line := range docLines(entry) {
+			if i == 0 {
+				b.WriteString("//   - " + line + "\n")
+			} else {
+				b.WriteString("//     " + line + "\n")
+			}
+		}
+	}
+}
+
+// docLines splits documentation into trimmed lines, dropping empty ones.
+func docLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// sortedKeys returns the keys of a map of methods or events in order.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]abi.Method:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]abi.Event:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]*tmplMethod:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]*tmplEvent:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// renameStructs resolves structs of different shapes sharing a name, which
+// happens when contracts declare their own struct of the same name. Clashing
+// structs are prefixed with the contract that declared them first, numbered if
+// that is still ambiguous, and the fields referring to them are updated.
+func renameStructs(structs map[string]*tmplStruct) {
+	ids := make([]string, 0, len(structs))
+	byName := make(map[string][]string)
+	for id, s := range structs {
+		ids = append(ids, id)
+		byName[s.Name] = append(byName[s.Name], id)
+	}
+	sort.Strings(ids)
+	taken := make(map[string]bool)
+	for name := range byName {
+		taken[name] = true
+	}
+	renamed := false
+	for _, id := range ids {
+		s := structs[id]
+		if len(byName[s.Name]) < 2 {
+			continue
+		}
+		name := s.Owner + s.Name
+		for i := 0; taken[name]; i++ {
+			name = fmt.Sprintf("%s%s%d", s.Owner, s.Name, i)
+		}
+		taken[name] = true
+		s.Name = name
+		renamed = true
+	}
+	if !renamed {
+		return
+	}
+	// Field types were resolved while parsing, so resolve them again
+	for _, s := range structs {
+		for _, field := range s.Fields {
+			field.Type = bindStructTypeGo(field.SolKind, structs)
+		}
+	}
+}
+
+// overloadNames gives every overloaded method a deterministic name derived from
+// its inputs, keyed by the name go-ethereum assigned it. The overload with the
+// fewest inputs keeps the bare name, the others are suffixed with their arity,
+// or with their argument types when several overloads share an arity.
+func overloadNames(methods map[string]abi.Method) map[string]string {
+	groups := make(map[string][]abi.Method)
+	for _, method := range methods {
+		groups[method.RawName] = append(groups[method.RawName], method)
+	}
+	names := make(map[string]string)
+	for raw, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+		arities, shortest := make(map[int]int), -1
+		for _, method := range group {
+			arities[len(method.Inputs)]++
+			if shortest < 0 || len(method.Inputs) < shortest {
+				shortest = len(method.Inputs)
+			}
+		}
+		for _, method := range group {
+			arity := len(method.Inputs)
+			switch {
+			case arities[arity] > 1:
+				names[method.Name] = raw + typeSuffix(method.Inputs)
+			case arity == shortest:
+				names[method.Name] = raw
+			default:
+				names[method.Name] = fmt.Sprintf("%s%d", raw, arity)
+			}
+		}
+	}
+	return names
+}
+
+// typeSuffix spells out the argument types of an overload, e.g. AddressUint256.
+func typeSuffix(args abi.Arguments) string {
+	var suffix string
+	for _, arg := range args {
+		suffix += typeSuffixName(arg.Type)
+	}
+	return suffix
+}
+
+func typeSuffixName(t abi.Type) string {
+	switch t.T {
+	case abi.SliceTy:
+		return typeSuffixName(*t.Elem) + "Slice"
+	case abi.ArrayTy:
+		return fmt.Sprintf("%sArray%d", typeSuffixName(*t.Elem), t.Size)
+	case abi.TupleTy:
+		return "Tuple"
+	}
+	return capitalise(t.String())
+}
+
 // methodNormalizer is a name transformer that modifies Solidity method names to
 // conform to target language naming concentions.
 var methodNormalizer = map[Lang]func(string) string{
@@ -539,6 +1207,54 @@ var methodNormalizer = map[Lang]func(string) string{
 	LangJava: decapitalise,
 }
 
+// fixedBytes matches the Go types of fixed size byte arrays, e.g. [32]byte.
+var fixedBytes = regexp.MustCompile(`^\[\d+\]byte$`)
+
+// sliceOrArray matches the Go types of slices and arrays, capturing their
+// element type.
+var sliceOrArray = regexp.MustCompile(`^\[\d*\](.+)$`)
+
+// jsonType gives the type a generated event field is encoded as in JSON, big
+// numbers being written as decimal strings and byte arrays as hex. Slices,
+// arrays and structs holding any of them are converted element by element at
+// runtime, so they are carried as raw JSON.
+func jsonType(goType string) string {
+	switch {
+	case goType == "*big.Int":
+		return "string"
+	case goType == "[]byte" || fixedBytes.MatchString(goType):
+		return "hexutil.Bytes"
+	case goType == "common.Address" || goType == "common.Hash" || goType == "bool" || goType == "string":
+		return goType
+	case strings.HasPrefix(goType, "int") || strings.HasPrefix(goType, "uint"):
+		return goType
+	}
+	if elem := sliceOrArray.FindStringSubmatch(goType); elem != nil && jsonType(elem[1]) == elem[1] {
+		return goType
+	}
+	return "json.RawMessage"
+}
+
+// zeroValueGo returns a Go expression of the zero value of a Solidity type, used
+// to fill in arguments in generated tests.
+func zeroValueGo(kind abi.Type, structs map[string]*tmplStruct) string {
+	goType := bindTypeGo(kind, structs)
+	switch {
+	case strings.HasPrefix(goType, "[]"):
+		return "nil"
+	case goType == "*big.Int":
+		return "new(big.Int)"
+	case goType == "string":
+		return `""`
+	case goType == "bool":
+		return "false"
+	case strings.HasPrefix(goType, "int") || strings.HasPrefix(goType, "uint"):
+		return "0"
+	default:
+		return goType + "{}"
+	}
+}
+
 // capitalise makes a camel-case string which starts with an upper case character.
 func capitalise(input string) string {
 	return abi.ToCamelCase(input)
@@ -2,15 +2,1366 @@ package bind
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
-	"github.com/evan-forbes/ethq/contracts/erc20/dai"
+	"github.com/evan-forbes/buddy/std/erc20"
 )
 
-func TestBind(t *testing.T) {
-	code, err := Bind([]string{"dai"}, []string{dai.DaiABI}, []string{dai.DaiBin}, "dai")
+// tupleABI returns several named outputs, eventerABI and eventerBin raise events
+// with a mix of indexed arguments, while tokenABI and tokenBin are the sample
+// token contract from https://ethereum.org/token
+const (
+	eventerABI    = `[{"constant":false,"inputs":[{"name":"str","type":"string"},{"name":"blob","type":"bytes"}],"name":"raiseDynamicEvent","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"addr","type":"address"},{"name":"id","type":"bytes32"},{"name":"flag","type":"bool"},{"name":"value","type":"uint256"}],"name":"raiseSimpleEvent","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"blob","type":"bytes24"}],"name":"raiseFixedBytesEvent","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"number","type":"uint256"},{"name":"short","type":"int16"},{"name":"long","type":"uint32"}],"name":"raiseNodataEvent","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"name":"Addr","type":"address"},{"indexed":true,"name":"Id","type":"bytes32"},{"indexed":true,"name":"Flag","type":"bool"},{"indexed":false,"name":"Value","type":"uint256"}],"name":"SimpleEvent","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"Number","type":"uint256"},{"indexed":true,"name":"Short","type":"int16"},{"indexed":true,"name":"Long","type":"uint32"}],"name":"NodataEvent","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"IndexedString","type":"string"},{"indexed":true,"name":"IndexedBytes","type":"bytes"},{"indexed":false,"name":"NonIndexedString","type":"string"},{"indexed":false,"name":"NonIndexedBytes","type":"bytes"}],"name":"DynamicEvent","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"IndexedBytes","type":"bytes24"},{"indexed":false,"name":"NonIndexedBytes","type":"bytes24"}],"name":"FixedBytesEvent","type":"event"}]`
+	eventerBin    = `608060405234801561001057600080fd5b5061043f806100206000396000f3006080604052600436106100615763ffffffff7c0100000000000000000000000000000000000000000000000000000000600035041663528300ff8114610066578063630c31e2146100ff5780636cc6b94014610138578063c7d116dd1461015b575b600080fd5b34801561007257600080fd5b506040805160206004803580820135601f81018490048402850184019095528484526100fd94369492936024939284019190819084018382808284375050604080516020601f89358b018035918201839004830284018301909452808352979a9998810197919650918201945092508291508401838280828437509497506101829650505050505050565b005b34801561010b57600080fd5b506100fd73ffffffffffffffffffffffffffffffffffffffff60043516602435604435151560643561033c565b34801561014457600080fd5b506100fd67ffffffffffffffff1960043516610394565b34801561016757600080fd5b506100fd60043560243560010b63ffffffff604435166103d6565b806040518082805190602001908083835b602083106101b25780518252601f199092019160209182019101610193565b51815160209384036101000a6000190180199092169116179052604051919093018190038120875190955087945090928392508401908083835b6020831061020b5780518252601f1990920191602091820191016101ec565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405180910390207f3281fd4f5e152dd3385df49104a3f633706e21c9e80672e88d3bcddf33101f008484604051808060200180602001838103835285818151815260200191508051906020019080838360005b8381101561029c578181015183820152602001610284565b50505050905090810190601f1680156102c95780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b838110156102fc5781810151838201526020016102e4565b50505050905090810190601f1680156103295780820380516001836020036101000a031916815260200191505b5094505050505060405180910390a35050565b60408051828152905183151591859173ffffffffffffffffffffffffffffffffffffffff8816917f1f097de4289df643bd9c11011cc61367aa12983405c021056e706eb5ba1250c8919081900360200190a450505050565b6040805167ffffffffffffffff19831680825291517fcdc4c1b1aed5524ffb4198d7a5839a34712baef5fa06884fac7559f4a5854e0a9181900360200190a250565b8063ffffffff168260010b847f3ca7f3a77e5e6e15e781850bc82e32adfa378a2a609370db24b4d0fae10da2c960405160405180910390a45050505600a165627a7a72305820468b5843bf653145bd924b323c64ef035d3dd922c170644b44d61aa666ea6eee0029`
+	tupleABI      = `[{"constant":true,"inputs":[],"name":"tuple","outputs":[{"name":"a","type":"string"},{"name":"b","type":"int256"},{"name":"c","type":"bytes32"}],"type":"function"}]`
+	tokenABI      = `[{"constant":true,"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"type":"function"},{"constant":false,"inputs":[{"name":"_from","type":"address"},{"name":"_to","type":"address"},{"name":"_value","type":"uint256"}],"name":"transferFrom","outputs":[{"name":"success","type":"bool"}],"type":"function"},{"constant":true,"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"type":"function"},{"constant":true,"inputs":[{"name":"","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"type":"function"},{"constant":true,"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"type":"function"},{"constant":false,"inputs":[{"name":"_to","type":"address"},{"name":"_value","type":"uint256"}],"name":"transfer","outputs":[],"type":"function"},{"constant":false,"inputs":[{"name":"_spender","type":"address"},{"name":"_value","type":"uint256"},{"name":"_extraData","type":"bytes"}],"name":"approveAndCall","outputs":[{"name":"success","type":"bool"}],"type":"function"},{"constant":true,"inputs":[{"name":"","type":"address"},{"name":"","type":"address"}],"name":"spentAllowance","outputs":[{"name":"","type":"uint256"}],"type":"function"},{"constant":true,"inputs":[{"name":"","type":"address"},{"name":"","type":"address"}],"name":"allowance","outputs":[{"name":"","type":"uint256"}],"type":"function"},{"inputs":[{"name":"initialSupply","type":"uint256"},{"name":"tokenName","type":"string"},{"name":"decimalUnits","type":"uint8"},{"name":"tokenSymbol","type":"string"}],"type":"constructor"},{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+	useLibraryABI = `[{"constant":true,"inputs":[{"name":"c","type":"uint256"},{"name":"d","type":"uint256"}],"name":"add","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"}]`
+	useLibraryBin = `608060405234801561001057600080fd5b5061011d806100206000396000f3fe6080604052348015600f57600080fd5b506004361060285760003560e01c8063771602f714602d575b600080fd5b604d60048036036040811015604157600080fd5b5080359060200135605f565b60408051918252519081900360200190f35b600073__$b98c933f0a6ececcd167bd4f9d3299b1a0$__63771602f784846040518363ffffffff1660e01b8152600401808381526020018281526020019250505060206040518083038186803b15801560b757600080fd5b505af415801560ca573d6000803e3d6000fd5b505050506040513d602081101560df57600080fd5b5051939250505056fea265627a7a72305820eb5c38f42445604cfa43d85e3aa5ecc48b0a646456c902dd48420ae7241d06f664736f6c63430005090032`
+	mathABI       = `[{"constant":true,"inputs":[{"name":"a","type":"uint256"},{"name":"b","type":"uint256"}],"name":"add","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"}]`
+	mathBin       = `60a3610024600b82828239805160001a607314601757fe5b30600052607381538281f3fe730000000000000000000000000000000000000000301460806040526004361060335760003560e01c8063771602f7146038575b600080fd5b605860048036036040811015604c57600080fd5b5080359060200135606a565b60408051918252519081900360200190f35b019056fea265627a7a723058206fc6c05f3078327f9c763edffdb5ab5f8bd212e293a1306c7d0ad05af3ad35f464736f6c63430005090032`
+	overloadABI   = `[{"constant":false,"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"name":"safeTransferFrom","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"},{"name":"data","type":"bytes"}],"name":"safeTransferFrom","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[{"name":"id","type":"uint256"}],"name":"lookup","outputs":[{"name":"","type":"bytes32"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"who","type":"address"}],"name":"lookup","outputs":[{"name":"","type":"bytes32"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"ids","type":"uint256[]"}],"name":"lookup","outputs":[{"name":"","type":"bytes32"}],"payable":false,"stateMutability":"view","type":"function"}]`
+	// reverterBin is hand assembled: it reverts with its calldata minus the first 32
+	// bytes, so callers pick the revert data through the trailing bytes of the first
+	// argument and the following ones.
+	reverterABI = `[{"constant":true,"inputs":[{"name":"selector","type":"uint256"},{"name":"have","type":"uint256"},{"name":"want","type":"uint256"}],"name":"check","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"selector","type":"uint256"},{"name":"have","type":"uint256"},{"name":"want","type":"uint256"}],"name":"spend","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":true,"inputs":[{"name":"selector","type":"uint256"},{"name":"offset","type":"uint256"},{"name":"length","type":"uint256"},{"name":"text","type":"bytes32"}],"name":"reason","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"selector","type":"uint256"},{"name":"code","type":"uint256"}],"name":"panic","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"selector","type":"uint256"}],"name":"deny","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"},{"inputs":[{"name":"have","type":"uint256"},{"name":"want","type":"uint256"}],"name":"InsufficientBalance","type":"error"},{"inputs":[],"name":"Unauthorized","type":"error"}]`
+	reverterBin = `600e80600b6000396000f336602090038060206000376000fd`
+	// immutableBin is hand assembled: its runtime code pushes a 32 byte immutable,
+	// set to the deployer by the constructor, and carries a bzzr0 metadata hash.
+	immutableBin = `604e601060003933600152604e6000f37f00000000000000000000000000000000000000000000000000000000000000005000a165627a7a7230582011111111111111111111111111111111111111111111111111111111111111110029`
+	// alphaABI and betaABI share the Pair struct and the Moved event, but each
+	// declares its own Point struct
+	alphaABI = `[{"name":"point","constant":true,"type":"function","inputs":[],"outputs":[{"name":"","type":"tuple","internalType":"struct Point","components":[{"name":"x","type":"uint256","internalType":"uint256"},{"name":"y","type":"uint256","internalType":"uint256"}]}]},{"name":"pair","constant":false,"type":"function","inputs":[{"name":"p","type":"tuple","internalType":"struct Pair","components":[{"name":"a","type":"address","internalType":"address"},{"name":"b","type":"address","internalType":"address"}]}],"outputs":[]},{"name":"Moved","type":"event","anonymous":false,"inputs":[{"name":"by","type":"address","indexed":true}]}]`
+	// tuplerABI logs big numbers nested in a struct, a dynamic and a fixed array
+	tuplerABI = `[{"name":"Moved","type":"event","anonymous":false,"inputs":[{"name":"point","type":"tuple","indexed":false,"internalType":"struct Point","components":[{"name":"x","type":"uint256","internalType":"uint256"},{"name":"ys","type":"int256[]","internalType":"int256[]"},{"name":"tag","type":"bytes4","internalType":"bytes4"}]},{"name":"amounts","type":"uint256[]","indexed":false},{"name":"pair","type":"uint256[2]","indexed":false},{"name":"blobs","type":"bytes[]","indexed":false}]}]`
+	betaABI   = `[{"name":"point","constant":true,"type":"function","inputs":[],"outputs":[{"name":"","type":"tuple","internalType":"struct Point","components":[{"name":"x","type":"int256","internalType":"int256"},{"name":"y","type":"int256","internalType":"int256"},{"name":"z","type":"int256","internalType":"int256"}]}]},{"name":"pair","constant":false,"type":"function","inputs":[{"name":"p","type":"tuple","internalType":"struct Pair","components":[{"name":"a","type":"address","internalType":"address"},{"name":"b","type":"address","internalType":"address"}]}],"outputs":[]},{"name":"Moved","type":"event","anonymous":false,"inputs":[{"name":"by","type":"address","indexed":true}]}]`
+	tokenBin  = `60606040526040516107fd3803806107fd83398101604052805160805160a05160c051929391820192909101600160a060020a0333166000908152600360209081526040822086905581548551838052601f6002600019610100600186161502019093169290920482018390047f290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e56390810193919290918801908390106100e857805160ff19168380011785555b506101189291505b8082111561017157600081556001016100b4565b50506002805460ff19168317905550505050610658806101a56000396000f35b828001600101855582156100ac579182015b828111156100ac5782518260005055916020019190600101906100fa565b50508060016000509080519060200190828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061017557805160ff19168380011785555b506100c89291506100b4565b5090565b82800160010185558215610165579182015b8281111561016557825182600050559160200191906001019061018756606060405236156100775760e060020a600035046306fdde03811461007f57806323b872dd146100dc578063313ce5671461010e57806370a082311461011a57806395d89b4114610132578063a9059cbb1461018e578063cae9ca51146101bd578063dc3080f21461031c578063dd62ed3e14610341575b610365610002565b61036760008054602060026001831615610100026000190190921691909104601f810182900490910260809081016040526060828152929190828280156104eb5780601f106104c0576101008083540402835291602001916104eb565b6103d5600435602435604435600160a060020a038316600090815260036020526040812054829010156104f357610002565b6103e760025460ff1681565b6103d560043560036020526000908152604090205481565b610367600180546020600282841615610100026000190190921691909104601f810182900490910260809081016040526060828152929190828280156104eb5780601f106104c0576101008083540402835291602001916104eb565b610365600435602435600160a060020a033316600090815260036020526040902054819010156103f157610002565b60806020604435600481810135601f8101849004909302840160405260608381526103d5948235946024803595606494939101919081908382808284375094965050505050505060006000836004600050600033600160a060020a03168152602001908152602001600020600050600087600160a060020a031681526020019081526020016000206000508190555084905080600160a060020a0316638f4ffcb1338630876040518560e060020a0281526004018085600160a060020a0316815260200184815260200183600160a060020a03168152602001806020018281038252838181518152602001915080519060200190808383829060006004602084601f0104600f02600301f150905090810190601f1680156102f25780820380516001836020036101000a031916815260200191505b50955050505050506000604051808303816000876161da5a03f11561000257505050509392505050565b6005602090815260043560009081526040808220909252602435815220546103d59081565b60046020818152903560009081526040808220909252602435815220546103d59081565b005b60405180806020018281038252838181518152602001915080519060200190808383829060006004602084601f0104600f02600301f150905090810190601f1680156103c75780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b60408051918252519081900360200190f35b6060908152602090f35b600160a060020a03821660009081526040902054808201101561041357610002565b806003600050600033600160a060020a03168152602001908152602001600020600082828250540392505081905550806003600050600084600160a060020a0316815260200190815260200160002060008282825054019250508190555081600160a060020a031633600160a060020a03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040518082815260200191505060405180910390a35050565b820191906000526020600020905b8154815290600101906020018083116104ce57829003601f168201915b505050505081565b600160a060020a03831681526040812054808301101561051257610002565b600160a060020a0380851680835260046020908152604080852033949094168086529382528085205492855260058252808520938552929052908220548301111561055c57610002565b816003600050600086600160a060020a03168152602001908152602001600020600082828250540392505081905550816003600050600085600160a060020a03168152602001908152602001600020600082828250540192505081905550816005600050600086600160a060020a03168152602001908152602001600020600050600033600160a060020a0316815260200190815260200160002060008282825054019250508190555082600160a060020a031633600160a060020a03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040518082815260200191505060405180910390a3939250505056`
+	// coinABI drops approveAndCall from tokenABI, returns decimals as a uint256 and
+	// adds mint along with a Mint event
+	coinABI = `[{"constant":true,"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"type":"function"},{"constant":false,"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transferFrom","outputs":[{"name":"ok","type":"bool"}],"type":"function"},{"constant":true,"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint256"}],"type":"function"},{"constant":true,"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"type":"function"},{"constant":false,"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transfer","outputs":[],"type":"function"},{"constant":false,"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"mint","outputs":[],"type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Mint","type":"event"}]`
+)
+
+func TestBindCommonInterface(t *testing.T) {
+	code, missing, err := BindInterface("Erc20", Options{
+		Package:   "token",
+		Contracts: []Contract{{Type: "token", ABI: tokenABI}, {Type: "coin", ABI: coinABI}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"type Erc20 interface",
+		"// Events:\n//   - event Transfer(address indexed from, address indexed to, uint256 value)",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("common interface is missing %q\n%s", want, code)
+		}
+	}
+	for _, unwanted := range []string{"Decimals", "ApproveAndCall", "Mint", "Symbol"} {
+		if strings.Contains(code, unwanted) {
+			t.Errorf("common interface should not list %s\n%s", unwanted, code)
+		}
+	}
+	if want := []string{
+		"event Mint(address indexed to, uint256 value)",
+		"function decimals() constant returns(uint256)",
+		"function mint(address to, uint256 value) returns()",
+	}; !reflect.DeepEqual(missing["token"], want) {
+		t.Errorf("unexpected members missing from token: %q", missing["token"])
+	}
+	if len(missing["coin"]) != 5 {
+		t.Errorf("unexpected members missing from coin: %q", missing["coin"])
+	}
+}
+
+func TestCheckStandard(t *testing.T) {
+	// tokenABI predates ERC20: it lacks totalSupply and approve, and its transfer
+	// returns no bool
+	conformance, err := CheckStandard(tokenABI, "erc20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conformance.Conforms() {
+		t.Fatal("tokenABI should not conform to erc20")
+	}
+	if want := []string{"approve(address,uint256) [095ea7b3]", "totalSupply() [18160ddd]", "event Approval(address,address,uint256) [0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925]"}; !reflect.DeepEqual(conformance.Missing, want) {
+		t.Errorf("unexpected missing members %q", conformance.Missing)
+	}
+	if want := []string{"transfer(address,uint256) [a9059cbb] returns () instead of (bool)"}; !reflect.DeepEqual(conformance.Mismatched, want) {
+		t.Errorf("unexpected mismatched members %q", conformance.Mismatched)
+	}
+	// an ERC20 Transfer indexing the value is an ERC721 one
+	erc721, err := StandardABI("erc721")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conformance, err = CheckStandard(erc721, "erc20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "event Transfer(address,address,uint256) [0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef] is declared (address indexed,address indexed,uint256 indexed) instead of (address indexed,address indexed,uint256)"; !containsString(conformance.Mismatched, want) {
+		t.Errorf("unexpected mismatched members %q", conformance.Mismatched)
+	}
+	if _, err := CheckStandard(tokenABI, "erc777"); err == nil {
+		t.Error("expected an error for an unknown standard")
+	}
+}
+
+func TestBindStandards(t *testing.T) {
+	// the shipped interfaces are up to date
+	code, err := BindStandards("std")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shipped, err := ioutil.ReadFile(filepath.Join("..", "std", "standards_gen.go"))
 	if err != nil {
+		t.Fatal(err)
+	}
+	if string(shipped) != code {
+		t.Error("std/standards_gen.go is stale, regenerate it with go generate ./std")
+	}
+}
+
+func TestBindOptions(t *testing.T) {
+	// renaming two methods to the same identifier should be refused
+	_, err := Bind(Options{
+		Package:   "token",
+		Contracts: []Contract{{Type: "token", ABI: tokenABI}},
+		Aliases:   map[string]string{"transfer": "send", "transferFrom": "send"},
+	})
+	if err == nil {
+		t.Error("expected a duplicated identifier error")
+	}
+}
+
+func TestBindReservedNames(t *testing.T) {
+	for _, test := range []struct {
+		abi, clash string
+	}{
+		{`[{"type":"function","name":"dispatch","inputs":[],"outputs":[]},{"type":"event","name":"Moved","inputs":[]}]`, "the events"},
+		{`[{"type":"function","name":"approve","inputs":[],"outputs":[]},{"type":"function","name":"estimateApprove","inputs":[],"outputs":[]}]`, "method approve"},
+		{`[{"type":"function","name":"simulateApprove","constant":true,"inputs":[],"outputs":[]},{"type":"function","name":"approve","inputs":[],"outputs":[]}]`, "method approve"},
+		{`[{"type":"function","name":"filterMoved","inputs":[],"outputs":[]},{"type":"event","name":"Moved","inputs":[]}]`, "event Moved"},
+		{`[{"type":"function","name":"watchMoved","constant":true,"inputs":[],"outputs":[]},{"type":"event","name":"Moved","inputs":[]}]`, "event Moved"},
+	} {
+		_, err := Bind(Options{Package: "clash", Contracts: []Contract{{Type: "clash", ABI: test.abi}}})
+		if err == nil || !strings.Contains(err.Error(), test.clash) || !strings.Contains(err.Error(), "--alias") {
+			t.Errorf("binding %s failed with %v, want a clash with %s", test.abi, err, test.clash)
+		}
+	}
+	// aliasing the method out of the way lifts the clash
+	if _, err := Bind(Options{
+		Package:   "clash",
+		Contracts: []Contract{{Type: "clash", ABI: `[{"type":"function","name":"dispatch","inputs":[],"outputs":[]},{"type":"event","name":"Moved","inputs":[]}]`}},
+		Aliases:   map[string]string{"dispatch": "send"},
+	}); err != nil {
 		t.Error(err)
 	}
-	fmt.Println(code)
+}
+
+func TestBindOverloads(t *testing.T) {
+	// the bindings built in TestBindings alias the overload taking data
+	code, err := Bind(Options{Package: "overload", Contracts: []Contract{{Type: "overload", ABI: overloadABI}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(code, "SafeTransferFrom4(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error)") {
+		t.Error("overloads sharing no arity are not suffixed with it")
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	// custom errors can't shadow the builtin ones, nor other generated types
+	for _, name := range []string{"panic", "Session", "callerSession", "TransactorSession", "SessionInterface"} {
+		clash := `[{"inputs":[],"name":"` + name + `","type":"error"}]`
+		if _, err := Bind(Options{Package: "clash", Contracts: []Contract{{Type: "clash", ABI: clash}}}); err == nil {
+			t.Errorf("expected an error for a custom error named %s", name)
+		}
+	}
+}
+
+func TestBindNatspec(t *testing.T) {
+	userdoc := `{"kind":"user","methods":{"constructor":"Mints the initial supply to the deployer.","transfer(address,uint256)":{"notice":"Sends tokens from the caller."}},"events":{"Transfer(address,address,uint256)":{"notice":"Emitted when tokens move."}},"notice":"A minimal token."}`
+	devdoc := `{"kind":"dev","title":"Token","author":"buddy","methods":{"constructor":{"params":{"initialSupply":"amount minted"}},"transfer(address,uint256)":{"details":"Throws when the balance is too low.\n  Does not return a value.","params":{"_to":"recipient","_value":"amount sent"}},"transferFrom(address,address,uint256)":{"returns":{"success":"whether the transfer happened"}},"balanceOf(address)":{"returns":{"_0":"the balance"}}},"events":{"Transfer(address,address,uint256)":{"params":{"value":"amount moved"}}}}`
+	code, err := Bind(Options{
+		Package:   "natspec",
+		Contracts: []Contract{{Type: "token", ABI: tokenABI, Bytecode: tokenBin, UserDoc: userdoc, DevDoc: devdoc}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"// QoL helper methods\n//\n// Title: Token\n//\n// A minimal token.\n//\n// Author: buddy\ntype Token struct",
+		"to it.\n//\n// Mints the initial supply to the deployer.\n//\n// Params:\n//   - initialSupply: amount minted\nfunc DeployToken(",
+		"//\n// Sends tokens from the caller.\n//\n// Throws when the balance is too low.\n// Does not return a value.\n//\n// Params:\n//   - _to: recipient\n//   - _value: amount sent\nfunc (_Token *Token) Transfer(",
+		"//\n// Returns:\n//   - success: whether the transfer happened\nfunc (_Token *Token) TransferFrom(",
+		"//\n// Returns:\n//   - ret0: the balance\nfunc (_Token *Token) BalanceOf(",
+		"//\n// Emitted when tokens move.\n//\n// Params:\n//   - value: amount moved\ntype TransferLog struct",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code is missing the documentation %q", want)
+		}
+	}
+	// undocumented methods keep their plain comments
+	if !strings.Contains(code, "function decimals() constant returns(uint8)\nfunc (_Token *Token) Decimals(") {
+		t.Error("undocumented method gained a doc comment")
+	}
+	if _, err := Bind(Options{Package: "natspec", Contracts: []Contract{{Type: "token", ABI: tokenABI, DevDoc: "{"}}}); err == nil {
+		t.Error("expected an error binding an invalid devdoc")
+	}
+}
+
+func TestBindPackage(t *testing.T) {
+	files, err := BindPackage(layoutOptions, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{StructsFile, "alpha_gen.go", "alpha_mock.go", "beta_gen.go", "beta_mock.go"} {
+		if _, ok := files[name]; !ok {
+			t.Fatalf("missing generated file %s", name)
+		}
+	}
+	// shared structs are only declared in their own file, clashing ones are prefixed
+	for _, want := range []string{"type Pair struct", "type AlphaPoint struct", "type BetaPoint struct"} {
+		if strings.Count(files[StructsFile], want) != 1 {
+			t.Errorf("structs file should declare %q once", want)
+		}
+		for _, name := range []string{"alpha_gen.go", "beta_gen.go"} {
+			if strings.Contains(files[name], want) {
+				t.Errorf("%s redeclares %q", name, want)
+			}
+		}
+	}
+	// the layout doesn't depend on map ordering
+	for i := 0; i < 5; i++ {
+		again, err := BindPackage(layoutOptions, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, code := range files {
+			if again[name] != code {
+				t.Fatalf("%s differs between runs", name)
+			}
+		}
+	}
+	// a single file declares the structs once as well
+	code, err := Bind(layoutOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(code, "type Pair struct") != 1 {
+		t.Error("single file bindings should declare shared structs once")
+	}
+}
+
+func TestBindTests(t *testing.T) {
+	files, err := BindPackage(scaffoldOptions, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files["Alpha_test.go"]; ok {
+		t.Error("contracts without bytecode can't be deployed in tests")
+	}
+	for _, want := range []string{
+		`_, _, contract, err := DeployToken(accounts["alice"].TxOpts, backend, new(big.Int), "", 0, "")`,
+		`t.Run("TransferFrom", func(t *testing.T) {`,
+		`contract.ApproveAndCall(accounts["alice"].TxOpts, common.Address{}, new(big.Int), nil)`,
+	} {
+		if !strings.Contains(files["Token_test.go"], want) {
+			t.Errorf("generated tests are missing %q", want)
+		}
+	}
+	if !strings.Contains(files["UseLibrary_test.go"], "DeployUseLibraryWithLibraries(") {
+		t.Error("generated tests should deploy the libraries first")
+	}
+	// test skeletons are meant to be edited, unlike the bindings
+	for name, code := range files {
+		if strings.HasPrefix(code, GeneratedHeader) == strings.HasSuffix(name, "_test.go") {
+			t.Errorf("%s is not marked as generated correctly", name)
+		}
+	}
+}
+
+func TestParseSolcOutput(t *testing.T) {
+	// link UseLibrary against Math by its fully qualified name
+	useLibraryBin := strings.Replace(useLibraryBin, "b98c933f0a6ececcd167bd4f9d3299b1a0", LibraryPattern("contracts/Math.sol:Math"), -1)
+	// older solc versions encode the abi and docs of --combined-json as strings
+	combined := fmt.Sprintf(`{"contracts":{"contracts/Math.sol:Math":{"abi":%q,"bin":%q},"contracts/UseLibrary.sol:UseLibrary":{"abi":%s,"bin":%q,"userdoc":%q,"hashes":{"add(uint256,uint256)":"771602f7"}}},"version":"0.5.16"}`,
+		mathABI, mathBin, useLibraryABI, useLibraryBin, `{"methods":{"add(uint256,uint256)":{"notice":"Adds two numbers."}}}`)
+	standard := fmt.Sprintf(`{"errors":[{"severity":"warning","formattedMessage":"unused variable"}],"contracts":{"contracts/Math.sol":{"Math":{"abi":%s,"evm":{"bytecode":{"object":%q}}}},"contracts/UseLibrary.sol":{"UseLibrary":{"abi":%s,"evm":{"bytecode":{"object":%q,"sourceMap":"0:120:0:-;;"},"deployedBytecode":{"object":"6080","sourceMap":"0:120:0:-"},"methodIdentifiers":{"add(uint256,uint256)":"771602f7"}}}}}}`,
+		mathABI, mathBin, useLibraryABI, useLibraryBin)
+
+	for name, output := range map[string]string{"combined": combined, "standard": standard} {
+		contracts, libs, err := ParseSolcOutput([]byte(output), nil)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(contracts) != 2 || contracts[0].Type != "Math" || contracts[1].Type != "UseLibrary" {
+			t.Fatalf("%s: unexpected contracts %+v", name, contracts)
+		}
+		if contracts[1].FuncSigs["add(uint256,uint256)"] != "771602f7" {
+			t.Errorf("%s: function signatures were not read", name)
+		}
+		if libs[LibraryPattern("contracts/Math.sol:Math")] != "Math" {
+			t.Errorf("%s: library placeholders were not recognized: %v", name, libs)
+		}
+		code, err := Bind(Options{Package: "solc", Contracts: contracts, Libraries: libs})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !strings.Contains(code, "func DeployUseLibraryWithLibraries(") {
+			t.Errorf("%s: UseLibrary should be linked against Math", name)
+		}
+		if name == "combined" && !strings.Contains(code, "// Adds two numbers.") {
+			t.Errorf("%s: userdoc was not carried into the bindings", name)
+		}
+		if name == "standard" && (!strings.Contains(code, `const UseLibraryRuntimeBin = "0x6080"`) || !strings.Contains(code, `const UseLibrarySourceMap = "0:120:0:-;;"`)) {
+			t.Errorf("%s: runtime bytecode and source maps were not carried into the bindings", name)
+		}
+	}
+	for _, output := range []string{
+		`{"errors":[{"severity":"error","formattedMessage":"ParserError: Expected ';'"}]}`,
+		`{"contracts":{"a.sol:Token":{"abi":[]},"b.sol:Token":{"abi":[]}}}`,
+		`[]`,
+	} {
+		if _, _, err := ParseSolcOutput([]byte(output), nil); err == nil {
+			t.Errorf("expected an error parsing %s", output)
+		}
+	}
+}
+
+func TestReadArtifacts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	start := strings.Index(useLibraryBin, "__$") / 2
+	links := fmt.Sprintf(`{"contracts/Math.sol":{"Math":[{"start":%d,"length":20}]}}`, start)
+	truffleBin := strings.Replace(useLibraryBin, "__$b98c933f0a6ececcd167bd4f9d3299b1a0$__", "__Math"+strings.Repeat("_", 34), -1)
+	files := map[string]string{
+		// hardhat
+		"hardhat/contracts/Math.sol/Math.json":             fmt.Sprintf(`{"_format":"hh-sol-artifact-1","contractName":"Math","sourceName":"contracts/Math.sol","abi":%s,"bytecode":"0x%s","linkReferences":{}}`, mathABI, mathBin),
+		"hardhat/contracts/Math.sol/Math.dbg.json":         `{"_format":"hh-sol-dbg-1","buildInfo":"../../build-info/1.json"}`,
+		"hardhat/contracts/UseLibrary.sol/UseLibrary.json": fmt.Sprintf(`{"contractName":"UseLibrary","sourceName":"contracts/UseLibrary.sol","abi":%s,"bytecode":"0x%s","deployedBytecode":"0x6080","linkReferences":%s,"deployedLinkReferences":{}}`, useLibraryABI, useLibraryBin, links),
+		"hardhat/contracts/Token.sol/Token.json":           fmt.Sprintf(`{"contractName":"Token","sourceName":"contracts/Token.sol","abi":%s,"bytecode":"0x%s","linkReferences":{}}`, tokenABI, tokenBin),
+		"hardhat/build-info/1.json":                        `{"input":{},"output":{"contracts":{}}}`,
+		// foundry
+		"foundry/Math.sol/Math.json":             fmt.Sprintf(`{"abi":%s,"bytecode":{"object":"0x%s","linkReferences":{}},"methodIdentifiers":{"add(uint256,uint256)":"771602f7"}}`, mathABI, mathBin),
+		"foundry/UseLibrary.sol/UseLibrary.json": fmt.Sprintf(`{"abi":%s,"bytecode":{"object":"0x%s","linkReferences":%s},"deployedBytecode":{"object":"0x6080","linkReferences":{}},"metadata":{"settings":{"compilationTarget":{"contracts/UseLibrary.sol":"UseLibrary"}},"output":{"userdoc":{"methods":{"add(uint256,uint256)":{"notice":"Adds through Math."}}}}}}`, useLibraryABI, useLibraryBin, links),
+		// truffle
+		"truffle/build/contracts/Math.json":       fmt.Sprintf(`{"contractName":"Math","sourcePath":"/src/contracts/Math.sol","abi":%s,"bytecode":"0x%s","networks":{}}`, mathABI, mathBin),
+		"truffle/build/contracts/UseLibrary.json": fmt.Sprintf(`{"contractName":"UseLibrary","sourcePath":"/src/contracts/UseLibrary.sol","abi":%s,"bytecode":"0x%s","deployedBytecode":"0x6080","networks":{}}`, useLibraryABI, truffleBin),
+		"truffle/build/contracts/package.json":    `{"name":"not an artifact"}`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, layout := range []string{"hardhat", "foundry", "truffle"} {
+		contracts, libs, err := ReadArtifacts(filepath.Join(dir, layout), []string{"*", "!Token"})
+		if err != nil {
+			t.Fatalf("%s: %v", layout, err)
+		}
+		if len(contracts) != 2 {
+			t.Fatalf("%s: expected Math and UseLibrary, got %d contracts", layout, len(contracts))
+		}
+		code, err := Bind(Options{Package: layout, Contracts: contracts, Libraries: libs})
+		if err != nil {
+			t.Fatalf("%s: %v", layout, err)
+		}
+		if !strings.Contains(code, "func DeployUseLibraryWithLibraries(") {
+			t.Errorf("%s: UseLibrary should be linked against Math", layout)
+		}
+		if !strings.Contains(code, `const UseLibraryRuntimeBin = "0x6080"`) {
+			t.Errorf("%s: runtime bytecode was not carried into the bindings", layout)
+		}
+		if layout == "foundry" && !strings.Contains(code, "// Adds through Math.") {
+			t.Errorf("%s: metadata docs were not carried into the bindings", layout)
+		}
+	}
+	contracts, _, err := ReadArtifacts(filepath.Join(dir, "hardhat"), []string{"contracts/Token.sol:*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contracts) != 1 || contracts[0].Type != "Token" {
+		t.Errorf("qualified filter selected %+v", contracts)
+	}
+	if _, _, err := ReadArtifacts(filepath.Join(dir, "hardhat"), []string{"Missing"}); err == nil {
+		t.Error("expected an error when no contract matches")
+	}
+}
+
+func TestBindLibraries(t *testing.T) {
+	// unnamed placeholders can never be linked
+	opts := libraryOptions
+	opts.Libraries = nil
+	if _, err := Bind(opts); err == nil {
+		t.Error("expected an error for an unlinked library placeholder")
+	}
+}
+
+var (
+	// layoutOptions bind structs and events shared or clashing between contracts
+	layoutOptions = Options{
+		Package:   "layout",
+		Contracts: []Contract{{Type: "alpha", ABI: alphaABI}, {Type: "beta", ABI: betaABI}},
+	}
+	// scaffoldOptions bind deployable contracts, libraries and others along with tests
+	scaffoldOptions = Options{
+		Package: "scaffold",
+		Contracts: []Contract{
+			{Type: "Token", ABI: tokenABI, Bytecode: tokenBin},
+			{Type: "UseLibrary", ABI: useLibraryABI, Bytecode: useLibraryBin},
+			{Type: "Math", ABI: mathABI, Bytecode: mathBin},
+			{Type: "Alpha", ABI: alphaABI},
+		},
+		Libraries: map[string]string{"b98c933f0a6ececcd167bd4f9d3299b1a0": "Math"},
+	}
+	// libraryOptions bind a contract along with the library it links against
+	libraryOptions = Options{
+		Package: "uselibrary",
+		Contracts: []Contract{
+			{Type: "UseLibrary", ABI: useLibraryABI, Bytecode: useLibraryBin},
+			{Type: "Math", ABI: mathABI, Bytecode: mathBin},
+		},
+		Libraries: map[string]string{"b98c933f0a6ececcd167bd4f9d3299b1a0": "Math"},
+	}
+)
+
+// bindFiles binds opts into a single file, along with its mock if asked for.
+func bindFiles(opts Options, mock bool) (map[string]string, error) {
+	code, err := Bind(opts)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]string{opts.Package + "_gen.go": code}
+	if mock {
+		if files[opts.Package+"_mock.go"], err = BindMock(opts); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// bindingCases are the packages of bindings TestBindings compiles and tests.
+// Features are checked by the tests run against the bindings, while want and
+// unwanted cover the template details those can't observe.
+var bindingCases = []struct {
+	dir      string
+	bind     func() (map[string]string, error)
+	want     []string // Code expected in one of the generated files
+	unwanted []string // Code expected in none of them
+	tests    string   // Test file run in the package
+}{
+	{
+		dir: "erc20",
+		bind: func() (map[string]string, error) {
+			return bindFiles(Options{Package: "erc20", Contracts: []Contract{{Type: "ERC20", ABI: erc20.ERC20ABI, Bytecode: erc20.ERC20Bin, Standards: []string{"erc20"}}}}, false)
+		},
+		want: []string{"var _ std.ERC20 = (*ERC20)(nil)"},
+	},
+	{
+		// bindings of conforming ABIs assert they implement the standards, others don't
+		dir: "standards",
+		bind: func() (map[string]string, error) {
+			erc20, err := StandardABI("erc20")
+			if err != nil {
+				return nil, err
+			}
+			coinABI := strings.TrimSuffix(erc20, "]") + `,{"name":"name","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]}]`
+			return bindFiles(Options{
+				Package: "coin",
+				Contracts: []Contract{
+					{Type: "coin", ABI: coinABI, Standards: []string{"erc20", "erc721"}},
+					{Type: "token", ABI: tokenABI, Standards: []string{"erc20"}},
+				},
+			}, false)
+		},
+		want:     []string{"var _ std.ERC20 = (*Coin)(nil)"},
+		unwanted: []string{"std.ERC721", "std.ERC20 = (*Token)"},
+	},
+	{
+		dir: "token",
+		bind: func() (map[string]string, error) {
+			return bindFiles(Options{
+				Package:   "token",
+				Contracts: []Contract{{Type: "token", ABI: tokenABI, Bytecode: tokenBin}, {Type: "tupler", ABI: tupleABI}},
+			}, true)
+		},
+		tests: `package token
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evan-forbes/buddy/sim"
+)
+
+// the interface covers calls, transactions and unpacking events
+var (
+	_ func(TokenInterface, *bind.CallOpts, common.Address) (*big.Int, error)                         = TokenInterface.BalanceOf
+	_ func(TokenInterface, *bind.TransactOpts, common.Address, *big.Int) (*types.Transaction, error) = TokenInterface.Transfer
+	_ func(TokenInterface, types.Log) (*TransferLog, error)                                          = TokenInterface.UnpackTransferLog
+)
+
+func deployToken(t *testing.T) (*bind.TransactOpts, *sim.SimulatedBackend, *Token) {
+	key, _ := crypto.GenerateKey()
+	auth := bind.NewKeyedTransactor(key)
+	backend := sim.NewSimulatedBackend(core.GenesisAlloc{auth.From: {Balance: big.NewInt(1000000000000000000)}}, 10000000)
+	_, _, token, err := DeployToken(auth, backend, big.NewInt(1000), "Buddy", 0, "BDY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	return auth, backend, token
+}
+
+func TestTokenMock(t *testing.T) {
+	mock := new(TokenMock)
+	addr := common.HexToAddress("0x01")
+	mock.OnBalanceOf(addr).Return(big.NewInt(5), nil)
+
+	var token TokenInterface = mock
+	bal, err := token.BalanceOf(nil, addr)
+	if err != nil || bal.Int64() != 5 {
+		t.Fatalf("unexpected scripted result: %v %v", bal, err)
+	}
+	if _, err := token.BalanceOf(nil, common.Address{}); err == nil {
+		t.Fatal("expected an error for unscripted arguments")
+	}
+	mock.OnTransfer(addr, big.NewInt(1)).Return(nil, nil)
+	if _, err := token.Transfer(nil, addr, new(big.Int).SetUint64(1)); err != nil {
+		t.Fatal(err)
+	}
+	calls := mock.MockCalls()
+	if len(calls) != 3 || calls[0].Method != "BalanceOf" || calls[2].Method != "Transfer" {
+		t.Fatalf("unexpected recorded calls: %v", calls)
+	}
+
+	// several outputs are scripted as a struct
+	tupler := new(TuplerMock)
+	tupler.OnTuple().Return(struct {
+		A string
+		B *big.Int
+		C [32]byte
+	}{A: "a", B: big.NewInt(-1)}, nil)
+	if ret, err := TuplerInterface(tupler).Tuple(nil); err != nil || ret.A != "a" || ret.B.Int64() != -1 {
+		t.Fatalf("unexpected scripted tuple: %+v, %v", ret, err)
+	}
+}
+
+func TestTokenSession(t *testing.T) {
+	auth, backend, token := deployToken(t)
+	defer backend.Close()
+
+	var session TokenSessionInterface = NewTokenSession(token, auth)
+	bob := common.HexToAddress("0xb0b")
+	if _, err := session.Transfer(bob, big.NewInt(10)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	caller := TokenCallerSession{Contract: token}
+	if balance, err := caller.BalanceOf(bob); err != nil || balance.Int64() != 10 {
+		t.Fatalf("unexpected balance: %v, %v", balance, err)
+	}
+	transactor := TokenTransactorSession{Contract: token, TransactOpts: *auth}
+	if _, err := transactor.Transfer(bob, big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if balance, err := session.BalanceOf(auth.From); err != nil || balance.Int64() != 985 {
+		t.Fatalf("unexpected balance: %v, %v", balance, err)
+	}
+}
+
+func TestTokenCalldata(t *testing.T) {
+	auth, backend, token := deployToken(t)
+	defer backend.Close()
+
+	bob := common.HexToAddress("0xb0b")
+	tx, err := token.Transfer(auth, bob, big.NewInt(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed, err := PackTokenTransfer(bob, big.NewInt(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(packed, tx.Data()) {
+		t.Fatalf("packed calldata %x does not match the transaction's %x", packed, tx.Data())
+	}
+	decoded, err := DecodeTokenCall(tx.Data())
+	if err != nil {
+		t.Fatal(err)
+	}
+	transfer, ok := decoded.(*TokenTransferInput)
+	if !ok || transfer.To != bob || transfer.Value.Int64() != 10 {
+		t.Fatalf("unexpected decoded call: %+v", decoded)
+	}
+
+	// unnamed arguments are named after their position
+	packed, err = PackTokenBalanceOf(bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input, err := UnpackTokenBalanceOfInput(packed); err != nil || input.Arg0 != bob {
+		t.Fatalf("unexpected input: %+v, %v", input, err)
+	}
+	if _, err := UnpackTokenTransferInput(packed); err == nil {
+		t.Fatal("expected an error unpacking calldata of another method")
+	}
+
+	// the transfer is still pending, so the deployer holds the whole supply
+	if packed, err = PackTokenBalanceOf(auth.From); err != nil {
+		t.Fatal(err)
+	}
+	returned, err := backend.CallContract(context.Background(), ethereum.CallMsg{To: tx.To(), Data: packed}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance, err := UnpackTokenBalanceOfOutput(returned); err != nil || balance.Int64() != 1000 {
+		t.Fatalf("unexpected output: %v, %v", balance, err)
+	}
+	if _, err := DecodeTokenCall([]byte{1, 2, 3, 4}); !errors.Is(err, ErrTokenUnknownMethod) {
+		t.Fatalf("unexpected error for an unknown selector: %v", err)
+	}
+}
+`,
+	},
+	{
+		dir: "options",
+		bind: func() (map[string]string, error) {
+			return bindFiles(Options{
+				Package: "options",
+				Contracts: []Contract{{
+					Type:      "token",
+					ABI:       tokenABI,
+					Interface: "erc20",
+					FuncSigs:  map[string]string{"transfer(address,uint256)": "a9059cbb"},
+				}},
+				Aliases: map[string]string{"transfer": "send", "Transfer": "Sent"},
+			}, false)
+		},
+		tests: `package options
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// the interface takes the requested name, and the aliases replace the names of
+// the method and the event
+var (
+	_ Erc20                                                                                  = (*Token)(nil)
+	_ func(Erc20, *bind.TransactOpts, common.Address, *big.Int) (*types.Transaction, error) = Erc20.Send
+	_ func(Erc20, types.Log) (*SentLog, error)                                               = Erc20.UnpackSentLog
+)
+
+func TestFuncSigs(t *testing.T) {
+	if sig := TokenFuncSigs["a9059cbb"]; sig != "transfer(address,uint256)" {
+		t.Fatalf("unexpected signature %q", sig)
+	}
+}
+`,
+	},
+	{
+		// both contracts satisfy the interface of their common members
+		dir: "common",
+		bind: func() (map[string]string, error) {
+			opts := Options{
+				Package:   "token",
+				Contracts: []Contract{{Type: "token", ABI: tokenABI}, {Type: "coin", ABI: coinABI}},
+			}
+			files, err := bindFiles(opts, false)
+			if err != nil {
+				return nil, err
+			}
+			files["erc20_gen.go"], _, err = BindInterface("Erc20", opts)
+			return files, err
+		},
+		tests: `package token
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	_ Erc20 = (*Token)(nil)
+	_ Erc20 = (*Coin)(nil)
+
+	_ func(Erc20, *bind.CallOpts) (string, error)                                                            = Erc20.Name
+	_ func(Erc20, *bind.TransactOpts, common.Address, common.Address, *big.Int) (*types.Transaction, error) = Erc20.TransferFrom
+)
+`,
+	},
+	{
+		dir: "eventer",
+		bind: func() (map[string]string, error) {
+			return bindFiles(Options{
+				Package:   "eventer",
+				Contracts: []Contract{{Type: "eventer", ABI: eventerABI, Bytecode: eventerBin}, {Type: "tupler", ABI: tuplerABI}},
+			}, false)
+		},
+		tests: `package eventer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evan-forbes/buddy/sim"
+)
+
+func deployEventer(t *testing.T) (*bind.TransactOpts, *sim.SimulatedBackend, common.Address, *Eventer) {
+	key, _ := crypto.GenerateKey()
+	auth := bind.NewKeyedTransactor(key)
+	backend := sim.NewSimulatedBackend(core.GenesisAlloc{auth.From: {Balance: big.NewInt(1000000000000000000)}}, 10000000)
+	addr, _, eventer, err := DeployEventer(auth, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	return auth, backend, addr, eventer
+}
+
+func TestEventerFilterWatch(t *testing.T) {
+	auth, backend, _, eventer := deployEventer(t)
+	defer backend.Close()
+
+	sink := make(chan *SimpleEventLog, 2)
+	sub, err := eventer.WatchSimpleEvent(nil, sink, nil, nil, []bool{true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	alice, bob := common.HexToAddress("0xa1"), common.HexToAddress("0xb0b")
+	if _, err := eventer.RaiseSimpleEvent(auth, alice, [32]byte{1}, true, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := eventer.RaiseSimpleEvent(auth, bob, [32]byte{2}, false, big.NewInt(2)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	it, err := eventer.FilterSimpleEvent(&bind.FilterOpts{}, []common.Address{bob}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	var found []*SimpleEventLog
+	for it.Next() {
+		found = append(found, it.Event)
+	}
+	if it.Error() != nil {
+		t.Fatal(it.Error())
+	}
+	if len(found) != 1 || found[0].Addr != bob || found[0].Value.Int64() != 2 {
+		t.Fatalf("unexpected filtered events: %v", found)
+	}
+
+	select {
+	case ev := <-sink:
+		if ev.Addr != alice || !ev.Flag || ev.Raw.TxHash == (common.Hash{}) {
+			t.Fatalf("unexpected watched event: %+v", ev)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the watched event")
+	}
+}
+
+type counter map[string]int
+
+func (c counter) HandleSimpleEvent(*SimpleEventLog) error         { c["simple"]++; return nil }
+func (c counter) HandleNodataEvent(*NodataEventLog) error         { c["nodata"]++; return nil }
+func (c counter) HandleDynamicEvent(*DynamicEventLog) error       { c["dynamic"]++; return nil }
+func (c counter) HandleFixedBytesEvent(*FixedBytesEventLog) error { c["fixed"]++; return nil }
+
+func TestEventerDispatch(t *testing.T) {
+	auth, backend, addr, eventer := deployEventer(t)
+	defer backend.Close()
+
+	eventer.RaiseSimpleEvent(auth, common.Address{}, [32]byte{}, true, big.NewInt(1))
+	eventer.RaiseNodataEvent(auth, big.NewInt(1), 2, 3)
+	eventer.RaiseNodataEvent(auth, big.NewInt(4), 5, 6)
+	backend.Commit()
+
+	logs, err := backend.FilterLogs(context.Background(), ethereum.FilterQuery{Addresses: []common.Address{addr}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := make(counter)
+	for _, log := range logs {
+		if err := eventer.Dispatch(log, handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if handler["simple"] != 1 || handler["nodata"] != 2 {
+		t.Fatalf("unexpected dispatched events: %v", handler)
+	}
+	err = eventer.Dispatch(types.Log{Topics: []common.Hash{{1}}}, handler)
+	if !errors.Is(err, ErrEventerUnknownEvent) {
+		t.Fatalf("expected an unknown event error, got %v", err)
+	}
+}
+
+func TestEventerLogJSON(t *testing.T) {
+	auth, backend, _, eventer := deployEventer(t)
+	defer backend.Close()
+
+	value, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	if _, err := eventer.RaiseSimpleEvent(auth, common.HexToAddress("0xa1"), [32]byte{1}, true, value); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	it, err := eventer.FilterSimpleEvent(nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	if !it.Next() {
+		t.Fatal("no event found")
+	}
+	encoded, err := json.Marshal(it.Event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shape struct {
+		Event       string
+		Contract    string
+		Fields      map[string]interface{}
+		BlockNumber uint64
+		TxHash      common.Hash ` + "`json:\"transactionHash\"`" + `
+	}
+	if err := json.Unmarshal(encoded, &shape); err != nil {
+		t.Fatal(err)
+	}
+	if shape.Event != "SimpleEvent" || shape.Contract != "Eventer" || shape.BlockNumber != 2 || shape.TxHash != it.Event.Raw.TxHash {
+		t.Fatalf("unexpected envelope: %s", encoded)
+	}
+	if shape.Fields["Value"] != value.String() || shape.Fields["Id"] != "0x01"+strings.Repeat("00", 31) {
+		t.Fatalf("unexpected fields: %s", encoded)
+	}
+
+	decoded := new(SimpleEventLog)
+	if err := json.Unmarshal(encoded, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, it.Event) {
+		t.Fatalf("round trip mismatch:\nhave %+v\nwant %+v", decoded, it.Event)
+	}
+	// events don't decode as one another
+	if err := json.Unmarshal(encoded, new(NodataEventLog)); err == nil {
+		t.Fatal("expected an error decoding into another event")
+	}
+}
+
+func TestTuplerLogJSON(t *testing.T) {
+	moved := MovedLog{
+		Point:   Point{X: big.NewInt(1), Ys: []*big.Int{big.NewInt(-2), big.NewInt(3)}, Tag: [4]byte{0xa9, 0x05, 0x9c, 0xbb}},
+		Amounts: []*big.Int{new(big.Int).Lsh(big.NewInt(1), 200)},
+		Pair:    [2]*big.Int{big.NewInt(4), big.NewInt(5)},
+		Blobs:   [][]byte{{0xde, 0xad}},
+	}
+	encoded, err := json.Marshal(moved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shape struct {
+		Fields json.RawMessage
+	}
+	if err := json.Unmarshal(encoded, &shape); err != nil {
+		t.Fatal(err)
+	}
+	want := ` + "`" + `{"point":{"x":"1","ys":["-2","3"],"tag":"0xa9059cbb"},"amounts":["1606938044258990275541962092341162602522202993782792835301376"],"pair":["4","5"],"blobs":["0xdead"]}` + "`" + `
+	if string(shape.Fields) != want {
+		t.Fatalf("fields encoded as\n%s\nwant\n%s", shape.Fields, want)
+	}
+	decoded := new(MovedLog)
+	if err := json.Unmarshal(encoded, decoded); err != nil {
+		t.Fatal(err)
+	}
+	// the raw log round trips in TestEventerLogJSON
+	decoded.Raw = moved.Raw
+	if !reflect.DeepEqual(*decoded, moved) {
+		t.Fatalf("round trip mismatch:\nhave %+v\nwant %+v", decoded, moved)
+	}
+	if err := json.Unmarshal([]byte(strings.Replace(string(encoded), ` + "`" + `"4"` + "`" + `, ` + "`" + `"four"` + "`" + `, 1)), decoded); err == nil {
+		t.Fatal("expected an error decoding an invalid number")
+	}
+}
+`,
+	},
+	{
+		// overloads can be aliased by their signature
+		dir: "overload",
+		bind: func() (map[string]string, error) {
+			return bindFiles(Options{
+				Package:   "overload",
+				Contracts: []Contract{{Type: "overload", ABI: overloadABI}},
+				Aliases:   map[string]string{"safeTransferFrom(address,address,uint256,bytes)": "safeTransferFromWithData"},
+			}, true)
+		},
+		tests: `package overload
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// the overload with the fewest arguments keeps the plain name, others sharing
+// an arity are named after their arguments
+var (
+	_ func(OverloadInterface, *bind.TransactOpts, common.Address, common.Address, *big.Int) (*types.Transaction, error)         = OverloadInterface.SafeTransferFrom
+	_ func(OverloadInterface, *bind.TransactOpts, common.Address, common.Address, *big.Int, []byte) (*types.Transaction, error) = OverloadInterface.SafeTransferFromWithData
+	_ func(OverloadInterface, *bind.CallOpts, *big.Int) ([32]byte, error)                                                       = OverloadInterface.LookupUint256
+	_ func(OverloadInterface, *bind.CallOpts, common.Address) ([32]byte, error)                                                 = OverloadInterface.LookupAddress
+	_ func(OverloadInterface, *bind.CallOpts, []*big.Int) ([32]byte, error)                                                     = OverloadInterface.LookupUint256Slice
+	_ OverloadInterface                                                                                                          = (*OverloadMock)(nil)
+)
+
+func TestOverloadSelectors(t *testing.T) {
+	parsed, err := parseOverloadABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for sig, id := range map[string]string{
+		"safeTransferFrom(address,address,uint256)":       "42842e0e",
+		"safeTransferFrom(address,address,uint256,bytes)": "b88d4fde",
+	} {
+		method, ok := parsed.Methods[sig]
+		if !ok {
+			t.Fatalf("overload %s is not keyed by its signature", sig)
+		}
+		if !bytes.Equal(method.ID(), common.Hex2Bytes(id)) {
+			t.Errorf("unexpected selector for %s: %x", sig, method.ID())
+		}
+	}
+	if _, err := parsed.Pack("lookup(uint256[])", []*big.Int{big.NewInt(1)}); err != nil {
+		t.Fatal(err)
+	}
+}
+`,
+	},
+	{
+		dir: "reverter",
+		bind: func() (map[string]string, error) {
+			return bindFiles(Options{Package: "reverter", Contracts: []Contract{{Type: "reverter", ABI: reverterABI, Bytecode: reverterBin}}}, false)
+		},
+		tests: `package reverter
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evan-forbes/buddy/sim"
+)
+
+func selector(sig string) *big.Int {
+	return new(big.Int).SetBytes(crypto.Keccak256([]byte(sig))[:4])
+}
+
+func TestReverterErrors(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	auth := bind.NewKeyedTransactor(key)
+	backend := sim.NewSimulatedBackend(core.GenesisAlloc{auth.From: {Balance: big.NewInt(1000000000000000000)}}, 10000000)
+	defer backend.Close()
+
+	_, _, reverter, err := DeployReverter(auth, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	insufficient := selector("InsufficientBalance(uint256,uint256)")
+	_, err = reverter.Check(nil, insufficient, big.NewInt(1), big.NewInt(2))
+	var balance ReverterInsufficientBalance
+	if !errors.As(err, &balance) || balance.Have.Int64() != 1 || balance.Want.Int64() != 2 {
+		t.Fatalf("unexpected call error: %v", err)
+	}
+	_, err = reverter.Spend(auth, insufficient, big.NewInt(3), big.NewInt(4))
+	if !errors.As(err, &balance) || balance.Have.Int64() != 3 || balance.Want.Int64() != 4 {
+		t.Fatalf("unexpected transaction error: %v", err)
+	}
+	if _, err = reverter.Deny(nil, selector("Unauthorized()")); !errors.As(err, &ReverterUnauthorized{}) {
+		t.Fatalf("unexpected call error: %v", err)
+	}
+
+	var text [32]byte
+	copy(text[:], "nope")
+	_, err = reverter.Reason(nil, selector("Error(string)"), big.NewInt(32), big.NewInt(4), text)
+	var revert ReverterRevert
+	if !errors.As(err, &revert) || revert.Reason != "nope" {
+		t.Fatalf("unexpected revert reason: %v", err)
+	}
+	_, err = reverter.Panic(nil, selector("Panic(uint256)"), big.NewInt(0x11))
+	var panicked ReverterPanic
+	if !errors.As(err, &panicked) || panicked.Code.Int64() != 0x11 {
+		t.Fatalf("unexpected panic: %v", err)
+	}
+
+	// revert data the contract doesn't declare is left alone
+	_, err = reverter.Deny(nil, big.NewInt(0xdeadbeef))
+	var reverted *sim.RevertError
+	if !errors.As(err, &reverted) || DecodeReverterRevert(reverted.Data) != nil {
+		t.Fatalf("unexpected unknown revert: %v", err)
+	}
+}
+`,
+	},
+	{
+		dir: "preflight",
+		bind: func() (map[string]string, error) {
+			return bindFiles(Options{
+				Package: "preflight",
+				Contracts: []Contract{
+					{Type: "token", ABI: tokenABI, Bytecode: tokenBin},
+					{Type: "reverter", ABI: reverterABI, Bytecode: reverterBin},
+				},
+			}, false)
+		},
+		tests: `package preflight
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evan-forbes/buddy/sim"
+)
+
+func TestPreflight(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	auth := bind.NewKeyedTransactor(key)
+	backend := sim.NewSimulatedBackend(core.GenesisAlloc{auth.From: {Balance: big.NewInt(1000000000000000000)}}, 10000000)
+	defer backend.Close()
+
+	_, _, token, err := DeployToken(auth, backend, big.NewInt(1000), "Buddy", 0, "BDY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, reverter, err := DeployReverter(auth, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	bob := common.HexToAddress("0xb0b")
+	if gas, err := token.EstimateTransfer(auth, bob, big.NewInt(10)); err != nil || gas <= 21000 {
+		t.Fatalf("unexpected gas estimate: %d, %v", gas, err)
+	}
+	if err := token.SimulateTransfer(auth, bob, big.NewInt(10)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := token.SimulateTransferFrom(auth, bob, auth.From, big.NewInt(10)); err == nil {
+		t.Fatal("expected transferring without an allowance to fail")
+	}
+	// simulations don't change any state
+	backend.Commit()
+	if balance, err := token.BalanceOf(nil, bob); err != nil || balance.Sign() != 0 {
+		t.Fatalf("unexpected balance after simulating: %v, %v", balance, err)
+	}
+
+	insufficient := new(big.Int).SetBytes(crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4])
+	var balance ReverterInsufficientBalance
+	if err := reverter.SimulateSpend(auth, insufficient, big.NewInt(1), big.NewInt(2)); !errors.As(err, &balance) || balance.Want.Int64() != 2 {
+		t.Fatalf("unexpected simulation error: %v", err)
+	}
+	if _, err := reverter.EstimateSpend(auth, insufficient, big.NewInt(1), big.NewInt(2)); !errors.As(err, &balance) || balance.Have.Int64() != 1 {
+		t.Fatalf("unexpected estimation error: %v", err)
+	}
+}
+`,
+	},
+	{
+		dir: "verified",
+		bind: func() (map[string]string, error) {
+			return bindFiles(Options{
+				Package: "verified",
+				Contracts: []Contract{
+					{Type: "token", ABI: tokenABI, Bytecode: tokenBin},
+					{Type: "immutable", ABI: "[]", Bytecode: immutableBin},
+				},
+			}, false)
+		},
+		tests: `package verified
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evan-forbes/buddy/sim"
+)
+
+func TestVerified(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	auth := bind.NewKeyedTransactor(key)
+	backend := sim.NewSimulatedBackend(core.GenesisAlloc{auth.From: {Balance: big.NewInt(1000000000000000000)}}, 10000000)
+	defer backend.Close()
+
+	tokenAddr, _, _, err := DeployToken(auth, backend, big.NewInt(1000), "Buddy", 0, "BDY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	immutableAddr, _, _, err := DeployImmutable(auth, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	ctx := context.Background()
+	if _, err := NewTokenVerified(ctx, tokenAddr, backend); err != nil {
+		t.Fatal(err)
+	}
+	// the immutable set by the constructor is ignored
+	if _, err := NewImmutableVerified(ctx, immutableAddr, backend); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewTokenVerified(ctx, immutableAddr, backend); !errors.Is(err, ErrTokenCodeMismatch) {
+		t.Fatalf("unexpected error binding to the wrong contract: %v", err)
+	}
+	if _, err := NewTokenVerified(ctx, common.HexToAddress("0xb0b"), backend); !errors.Is(err, bind.ErrNoCode) {
+		t.Fatalf("unexpected error binding to an empty account: %v", err)
+	}
+
+	// so is the metadata hash, but not the code itself
+	code, err := backend.CodeAt(ctx, immutableAddr, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code[len(code)-3] ^= 0xff
+	if !matchImmutableCode(code) {
+		t.Fatal("changing the metadata hash should not matter")
+	}
+	code[len(code)-44] ^= 0xff
+	if matchImmutableCode(code) {
+		t.Fatal("changing the runtime code should matter")
+	}
+}
+`,
+	},
+	{
+		dir: "layout",
+		bind: func() (map[string]string, error) {
+			return BindPackage(layoutOptions, true, false)
+		},
+		tests: `package layout
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// clashing events are prefixed with the contract type
+var (
+	_ *AlphaMovedLog
+	_ *BetaMovedLog
+)
+
+func TestLayout(t *testing.T) {
+	var (
+		_ AlphaInterface = new(AlphaMock)
+		_ BetaInterface  = new(BetaMock)
+	)
+	point := AlphaPoint{X: big.NewInt(1), Y: big.NewInt(2)}
+	_ = BetaPoint{X: point.X, Y: point.Y, Z: big.NewInt(3)}
+
+	data, err := PackAlphaPair(Pair{A: common.HexToAddress("0xa"), B: common.HexToAddress("0xb")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, err := UnpackBetaPairInput(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input.P.B != common.HexToAddress("0xb") {
+		t.Fatalf("unexpected pair %v", input.P)
+	}
+}
+`,
+	},
+	{
+		// the generated test skeletons run as they are
+		dir: "scaffold",
+		bind: func() (map[string]string, error) {
+			return BindPackage(scaffoldOptions, false, true)
+		},
+	},
+	{
+		dir: "uselibrary",
+		bind: func() (map[string]string, error) {
+			return bindFiles(libraryOptions, false)
+		},
+		tests: `package uselibrary
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evan-forbes/buddy/sim"
+)
+
+func TestUseLibraryLinking(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	auth := bind.NewKeyedTransactor(key)
+	backend := sim.NewSimulatedBackend(core.GenesisAlloc{auth.From: {Balance: big.NewInt(1000000000000000000)}}, 10000000)
+	defer backend.Close()
+
+	_, _, lib, libs, err := DeployUseLibraryWithLibraries(auth, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if sum, err := lib.Add(nil, big.NewInt(1), big.NewInt(2)); err != nil || sum.Int64() != 3 {
+		t.Fatalf("unexpected sum: %v, %v", sum, err)
+	}
+	// calldata helpers of both contracts are told apart by their type
+	if _, err := PackMathAdd(big.NewInt(1), big.NewInt(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	// reuse the already deployed library
+	_, _, again, err := DeployUseLibrary(auth, backend, libs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if sum, err := again.Add(nil, big.NewInt(4), big.NewInt(5)); err != nil || sum.Int64() != 9 {
+		t.Fatalf("unexpected sum: %v, %v", sum, err)
+	}
+}
+`,
+	},
+}
+
+// TestBindings writes each binding case into its own package of a throwaway
+// directory inside the module, and ensures they all compile, pass go vet and
+// pass their tests. The go tool runs once over all of them.
+func TestBindings(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping compilation of generated bindings in short mode")
+	}
+	dir, err := ioutil.TempDir(".", "_bindtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, c := range bindingCases {
+		files, err := c.bind()
+		if err != nil {
+			t.Fatalf("%s: %v", c.dir, err)
+		}
+		var code strings.Builder
+		for _, generated := range files {
+			code.WriteString(generated)
+		}
+		for _, want := range c.want {
+			if !strings.Contains(code.String(), want) {
+				t.Errorf("%s: generated code is missing %q", c.dir, want)
+			}
+		}
+		for _, unwanted := range c.unwanted {
+			if strings.Contains(code.String(), unwanted) {
+				t.Errorf("%s: generated code should not contain %q", c.dir, unwanted)
+			}
+		}
+		if c.tests != "" {
+			files["bindings_test.go"] = c.tests
+		}
+		if err := os.Mkdir(filepath.Join(dir, c.dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+		for name, content := range files {
+			if err := ioutil.WriteFile(filepath.Join(dir, c.dir, name), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	// patterns skip directories starting with an underscore, so list them all
+	var pkgs []string
+	for _, c := range bindingCases {
+		pkgs = append(pkgs, "./"+filepath.Join(filepath.Base(dir), c.dir))
+	}
+	if out, err := exec.Command("go", append([]string{"vet"}, pkgs...)...).CombinedOutput(); err != nil {
+		t.Fatalf("generated bindings do not compile: %v\n%s", err, out)
+	}
+	if out, err := exec.Command("go", append([]string{"test"}, pkgs...)...).CombinedOutput(); err != nil {
+		t.Fatalf("generated bindings fail their tests: %v\n%s", err, out)
+	}
+}
+
+// containsString reports whether a list holds a string.
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
 }
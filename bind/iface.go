@@ -0,0 +1,151 @@
+package bind
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// BindInterface generates name, the Go interface of the calls and transactions
+// shared by every contract passed. Its methods have the signatures of the
+// interfaces generated for each contract, so every binding satisfies it. Event
+// types and structs are specific to each binding, so shared events are listed in
+// the doc comment of the interface and members using structs are left out.
+// The members missing from each contract, which others declare, are returned
+// along with the code, keyed by contract type.
+func BindInterface(name string, opts Options) (string, map[string][]string, error) {
+	if len(opts.Contracts) == 0 {
+		return "", nil, fmt.Errorf("no contracts to extract interface %s from", name)
+	}
+	data, err := parse(opts)
+	if err != nil {
+		return "", nil, err
+	}
+	var (
+		members = make([]map[string]string, len(opts.Contracts)) // Member key -> description, per contract
+		all     = make(map[string]string)                        // Member key -> description, across contracts
+		types   = make([]string, len(opts.Contracts))
+		shared  = &tmplInterface{Name: name}
+	)
+	for i, c := range opts.Contracts {
+		contract := data.Contracts[c.Type]
+		types[i] = c.Type
+		members[i] = make(map[string]string)
+		for _, key := range sortedKeys(contract.Calls) {
+			if method := contract.Calls[key]; !methodHasStruct(method) {
+				members[i][methodKey(method, true)] = formatMethod(method.Original, data.Structs)
+			}
+		}
+		for _, key := range sortedKeys(contract.Transacts) {
+			if method := contract.Transacts[key]; !methodHasStruct(method) {
+				members[i][methodKey(method, false)] = formatMethod(method.Original, data.Structs)
+			}
+		}
+		for _, key := range sortedKeys(contract.Events) {
+			event := contract.Events[key]
+			members[i][eventKey(event)] = formatEvent(event.Original, data.Structs)
+		}
+		for key, desc := range members[i] {
+			if _, ok := all[key]; !ok {
+				all[key] = desc
+			}
+		}
+	}
+	// the members of the first contract, in the order they are generated, that
+	// every other contract declares too
+	isShared := func(key string) bool {
+		for _, m := range members {
+			if _, ok := m[key]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+	first := data.Contracts[opts.Contracts[0].Type]
+	for _, key := range sortedKeys(first.Calls) {
+		if method := first.Calls[key]; !methodHasStruct(method) && isShared(methodKey(method, true)) {
+			shared.Calls = append(shared.Calls, method)
+		}
+	}
+	for _, key := range sortedKeys(first.Transacts) {
+		if method := first.Transacts[key]; !methodHasStruct(method) && isShared(methodKey(method, false)) {
+			shared.Transacts = append(shared.Transacts, method)
+		}
+	}
+	for _, key := range sortedKeys(first.Events) {
+		if event := first.Events[key]; isShared(eventKey(event)) {
+			shared.Events = append(shared.Events, event)
+		}
+	}
+	missing := make(map[string][]string)
+	for i, c := range opts.Contracts {
+		for key, desc := range all {
+			if _, ok := members[i][key]; !ok {
+				missing[c.Type] = append(missing[c.Type], desc)
+			}
+		}
+		sort.Strings(missing[c.Type])
+	}
+	shared.Doc = fmt.Sprintf("lists the calls and transactions shared by %s.", strings.Join(types, ", "))
+	code, err := render(tmplInterfaceGo, &tmplInterfaces{
+		Package:    opts.Package,
+		Interfaces: []*tmplInterface{shared},
+		Structs:    make(map[string]*tmplStruct),
+	}, LangGo)
+	if err != nil {
+		return "", nil, err
+	}
+	return code, missing, nil
+}
+
+// methodKey identifies a method of the generated interfaces by its Go name and
+// the types of its signature, which is all Go needs for a binding to satisfy
+// an interface. The field names of structured outputs are part of their type.
+func methodKey(method *tmplMethod, call bool) string {
+	inputs := make([]string, len(method.Normalized.Inputs))
+	for i, input := range method.Normalized.Inputs {
+		inputs[i] = bindTypeGo(input.Type, nil)
+	}
+	if !call {
+		return fmt.Sprintf("%s(*bind.TransactOpts,%s)", method.Normalized.Name, strings.Join(inputs, ","))
+	}
+	outputs := make([]string, len(method.Normalized.Outputs))
+	for i, output := range method.Normalized.Outputs {
+		outputs[i] = bindTypeGo(output.Type, nil)
+		if method.Structured {
+			outputs[i] = output.Name + " " + outputs[i]
+		}
+	}
+	if method.Structured {
+		return fmt.Sprintf("%s(*bind.CallOpts,%s)(struct{%s})", method.Normalized.Name, strings.Join(inputs, ","), strings.Join(outputs, ";"))
+	}
+	return fmt.Sprintf("%s(*bind.CallOpts,%s)(%s)", method.Normalized.Name, strings.Join(inputs, ","), strings.Join(outputs, ","))
+}
+
+// eventKey identifies an event by its Go name and the Solidity types of its
+// arguments, indexed or not.
+func eventKey(event *tmplEvent) string {
+	inputs := make([]string, len(event.Normalized.Inputs))
+	for i, input := range event.Normalized.Inputs {
+		inputs[i] = input.Type.String()
+		if input.Indexed {
+			inputs[i] = "indexed " + inputs[i]
+		}
+	}
+	return fmt.Sprintf("event %s(%s)", event.Normalized.Name, strings.Join(inputs, ","))
+}
+
+// methodHasStruct reports whether a method takes or returns structs, which are
+// declared by each binding.
+func methodHasStruct(method *tmplMethod) bool {
+	for _, args := range []abi.Arguments{method.Normalized.Inputs, method.Normalized.Outputs} {
+		for _, arg := range args {
+			if hasStruct(arg.Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseSolcOutput reads the contracts out of the output of solc, either from
+// --combined-json (with any of abi, bin, bin-runtime, srcmap, srcmap-runtime,
+// userdoc, devdoc and hashes) or from --standard-json. Contracts are named after
+// their Solidity name, sorted by their fully qualified name and selected by
+// filters as in ReadArtifacts. The returned libraries map the link placeholder
+// of every contract to its type, so contracts linking against libraries
+// compiled alongside them are recognized.
+func ParseSolcOutput(data []byte, filters []string) ([]Contract, map[string]string, error) {
+	if err := checkFilters(filters); err != nil {
+		return nil, nil, err
+	}
+	var output struct {
+		Contracts map[string]json.RawMessage
+		Errors    []struct {
+			Severity         string
+			FormattedMessage string `json:"formattedMessage"`
+			Message          string
+		}
+	}
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, nil, fmt.Errorf("invalid solc output: %v", err)
+	}
+	var errs []string
+	for _, e := range output.Errors {
+		if e.Severity != "error" {
+			continue
+		}
+		msg := e.FormattedMessage
+		if msg == "" {
+			msg = e.Message
+		}
+		errs = append(errs, strings.TrimSpace(msg))
+	}
+	if len(errs) > 0 {
+		return nil, nil, fmt.Errorf("solc reported errors:\n%s", strings.Join(errs, "\n"))
+	}
+	if len(output.Contracts) == 0 {
+		return nil, nil, fmt.Errorf("solc output contains no contracts")
+	}
+	// Combined JSON is keyed by fully qualified name, standard JSON by source
+	// file and then by contract name
+	compiled := make(map[string]solcContract)
+	for key, raw := range output.Contracts {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, nil, fmt.Errorf("invalid solc output for %s: %v", key, err)
+		}
+		if isSolcContract(fields) {
+			var contract solcContract
+			if err := json.Unmarshal(raw, &contract); err != nil {
+				return nil, nil, fmt.Errorf("invalid solc output for %s: %v", key, err)
+			}
+			compiled[key] = contract
+			continue
+		}
+		for name, raw := range fields {
+			var contract solcContract
+			if err := json.Unmarshal(raw, &contract); err != nil {
+				return nil, nil, fmt.Errorf("invalid solc output for %s:%s: %v", key, name, err)
+			}
+			compiled[key+":"+name] = contract
+		}
+	}
+	return solcContracts(compiled, filters)
+}
+
+// solcContract is a single contract of either solc output format.
+type solcContract struct {
+	ABI           json.RawMessage
+	Bin           string
+	BinRuntime    string `json:"bin-runtime"`
+	SrcMap        string `json:"srcmap"`
+	SrcMapRuntime string `json:"srcmap-runtime"`
+	Hashes        map[string]string
+	UserDoc       json.RawMessage `json:"userdoc"`
+	DevDoc        json.RawMessage `json:"devdoc"`
+	EVM           struct {
+		Bytecode          solcBytecode
+		DeployedBytecode  solcBytecode      `json:"deployedBytecode"`
+		MethodIdentifiers map[string]string `json:"methodIdentifiers"`
+	} `json:"evm"`
+}
+
+// solcBytecode is the bytecode of a contract in standard JSON output.
+type solcBytecode struct {
+	Object    string
+	SourceMap string `json:"sourceMap"`
+}
+
+// isSolcContract tells a contract apart from a source file of standard JSON
+// output, which nests contracts by name.
+func isSolcContract(fields map[string]json.RawMessage) bool {
+	for _, key := range []string{"abi", "bin", "evm", "userdoc", "devdoc", "hashes"} {
+		if _, ok := fields[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// solcContracts converts compiled contracts, keyed by fully qualified name, into
+// the contracts to bind and the library placeholders they may link against.
+func solcContracts(compiled map[string]solcContract, filters []string) ([]Contract, map[string]string, error) {
+	names := make([]string, 0, len(compiled))
+	for name := range compiled {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var (
+		contracts = make([]Contract, 0, len(names))
+		libs      = make(map[string]string)
+		declared  = make(map[string]string)
+	)
+	for _, name := range names {
+		compiled := compiled[name]
+		typ := name[strings.LastIndex(name, ":")+1:]
+		libs[LibraryPattern(name)] = typ
+		if !matchContract(typ, name, filters) {
+			continue
+		}
+		if other, ok := declared[typ]; ok {
+			return nil, nil, fmt.Errorf("contract %s is declared by both %s and %s", typ, other, name)
+		}
+		declared[typ] = name
+		contract := Contract{
+			Type:             typ,
+			ABI:              solcString(compiled.ABI),
+			Bytecode:         compiled.Bin,
+			RuntimeBytecode:  compiled.BinRuntime,
+			SourceMap:        compiled.SrcMap,
+			RuntimeSourceMap: compiled.SrcMapRuntime,
+			FuncSigs:         compiled.Hashes,
+			UserDoc:          solcString(compiled.UserDoc),
+			DevDoc:           solcString(compiled.DevDoc),
+		}
+		if evm := compiled.EVM; contract.Bytecode == "" {
+			contract.Bytecode, contract.SourceMap = evm.Bytecode.Object, evm.Bytecode.SourceMap
+			contract.RuntimeBytecode, contract.RuntimeSourceMap = evm.DeployedBytecode.Object, evm.DeployedBytecode.SourceMap
+			contract.FuncSigs = evm.MethodIdentifiers
+		}
+		if contract.ABI == "" {
+			contract.ABI = "[]"
+		}
+		contracts = append(contracts, contract)
+	}
+	if len(contracts) == 0 {
+		return nil, nil, fmt.Errorf("solc output contains no contracts matching %s", strings.Join(filters, " "))
+	}
+	return contracts, libs, nil
+}
+
+// solcString returns JSON solc may have encoded as a string (older versions of
+// --combined-json do so for the abi and the docs) as raw JSON.
+func solcString(raw json.RawMessage) string {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	return string(raw)
+}
@@ -0,0 +1,236 @@
+package bind
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// standards are the canonical ABIs of the token standards, keyed by name. Only
+// the members the EIPs require are listed, so optional ones such as an ERC20's
+// name or an ERC721's tokenURI are left out.
+var standards = map[string]string{
+	"erc20":   `[{"name":"totalSupply","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},{"name":"balanceOf","type":"function","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},{"name":"transfer","type":"function","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},{"name":"allowance","type":"function","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},{"name":"approve","type":"function","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},{"name":"transferFrom","type":"function","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},{"name":"Transfer","type":"event","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},{"name":"Approval","type":"event","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},{"name":"spender","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}]`,
+	"erc721":  `[{"name":"balanceOf","type":"function","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},{"name":"ownerOf","type":"function","stateMutability":"view","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},{"name":"safeTransferFrom","type":"function","stateMutability":"payable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"},{"name":"data","type":"bytes"}],"outputs":[]},{"name":"safeTransferFrom","type":"function","stateMutability":"payable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]},{"name":"transferFrom","type":"function","stateMutability":"payable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]},{"name":"approve","type":"function","stateMutability":"payable","inputs":[{"name":"approved","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]},{"name":"setApprovalForAll","type":"function","stateMutability":"nonpayable","inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],"outputs":[]},{"name":"getApproved","type":"function","stateMutability":"view","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},{"name":"isApprovedForAll","type":"function","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"operator","type":"address"}],"outputs":[{"name":"","type":"bool"}]},{"name":"supportsInterface","type":"function","stateMutability":"view","inputs":[{"name":"interfaceId","type":"bytes4"}],"outputs":[{"name":"","type":"bool"}]},{"name":"Transfer","type":"event","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]},{"name":"Approval","type":"event","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},{"name":"approved","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]},{"name":"ApprovalForAll","type":"event","anonymous":false,"inputs":[{"name":"owner","type":"address","indexed":true},{"name":"operator","type":"address","indexed":true},{"name":"approved","type":"bool","indexed":false}]}]`,
+	"erc1155": `[{"name":"safeTransferFrom","type":"function","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"id","type":"uint256"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"}],"outputs":[]},{"name":"safeBatchTransferFrom","type":"function","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"ids","type":"uint256[]"},{"name":"values","type":"uint256[]"},{"name":"data","type":"bytes"}],"outputs":[]},{"name":"balanceOf","type":"function","stateMutability":"view","inputs":[{"name":"account","type":"address"},{"name":"id","type":"uint256"}],"outputs":[{"name":"","type":"uint256"}]},{"name":"balanceOfBatch","type":"function","stateMutability":"view","inputs":[{"name":"accounts","type":"address[]"},{"name":"ids","type":"uint256[]"}],"outputs":[{"name":"","type":"uint256[]"}]},{"name":"setApprovalForAll","type":"function","stateMutability":"nonpayable","inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],"outputs":[]},{"name":"isApprovedForAll","type":"function","stateMutability":"view","inputs":[{"name":"account","type":"address"},{"name":"operator","type":"address"}],"outputs":[{"name":"","type":"bool"}]},{"name":"supportsInterface","type":"function","stateMutability":"view","inputs":[{"name":"interfaceId","type":"bytes4"}],"outputs":[{"name":"","type":"bool"}]},{"name":"TransferSingle","type":"event","anonymous":false,"inputs":[{"name":"operator","type":"address","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"id","type":"uint256","indexed":false},{"name":"value","type":"uint256","indexed":false}]},{"name":"TransferBatch","type":"event","anonymous":false,"inputs":[{"name":"operator","type":"address","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"ids","type":"uint256[]","indexed":false},{"name":"values","type":"uint256[]","indexed":false}]},{"name":"ApprovalForAll","type":"event","anonymous":false,"inputs":[{"name":"account","type":"address","indexed":true},{"name":"operator","type":"address","indexed":true},{"name":"approved","type":"bool","indexed":false}]},{"name":"URI","type":"event","anonymous":false,"inputs":[{"name":"value","type":"string","indexed":false},{"name":"id","type":"uint256","indexed":true}]}]`,
+}
+
+// overloadAliases name the overloaded methods of the standards, both in their
+// interfaces and in the bindings of contracts checked against them.
+var overloadAliases = map[string]map[string]string{
+	"erc721": {"safeTransferFrom(address,address,uint256,bytes)": "safeTransferFromWithData"},
+}
+
+// standardAliases returns the aliases along with the overload aliases of the
+// standards, which never override an alias given explicitly.
+func standardAliases(aliases map[string]string, standards []string) map[string]string {
+	merged := make(map[string]string, len(aliases))
+	for name, alias := range aliases {
+		merged[name] = alias
+	}
+	for _, standard := range standards {
+		for sig, alias := range overloadAliases[strings.ToLower(standard)] {
+			if _, ok := merged[sig]; !ok {
+				merged[sig] = alias
+			}
+		}
+	}
+	return merged
+}
+
+// Standards returns the names of the standards ABIs can be checked against.
+func Standards() []string {
+	names := make([]string, 0, len(standards))
+	for name := range standards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// StandardABI returns the canonical ABI of a standard.
+func StandardABI(standard string) (string, error) {
+	standardABI, ok := standards[strings.ToLower(standard)]
+	if !ok {
+		return "", fmt.Errorf("unknown standard %s, expected one of %s", standard, strings.Join(Standards(), ", "))
+	}
+	return standardABI, nil
+}
+
+// Conformance is the result of checking an ABI against a standard.
+type Conformance struct {
+	Standard   string   // Name of the standard, e.g. erc20
+	Missing    []string // Methods and events of the standard the ABI lacks
+	Mismatched []string // Methods and events the ABI declares differently than the standard
+}
+
+// Conforms reports whether the ABI declares every method and event of the
+// standard as the standard does.
+func (c *Conformance) Conforms() bool {
+	return len(c.Missing) == 0 && len(c.Mismatched) == 0
+}
+
+// String reports what is missing or mismatched, one entry per line.
+func (c *Conformance) String() string {
+	if c.Conforms() {
+		return fmt.Sprintf("conforms to %s", c.Standard)
+	}
+	lines := []string{fmt.Sprintf("does not conform to %s:", c.Standard)}
+	for _, missing := range c.Missing {
+		lines = append(lines, "\tmissing "+missing)
+	}
+	for _, mismatched := range c.Mismatched {
+		lines = append(lines, "\tmismatched "+mismatched)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CheckStandard checks a JSON ABI against the canonical methods and events of a
+// standard. Methods are matched by selector and events by topic, then their
+// outputs, mutability and indexed arguments are compared.
+func CheckStandard(contractABI, standard string) (*Conformance, error) {
+	evmABI, err := abi.JSON(strings.NewReader(contractABI))
+	if err != nil {
+		return nil, err
+	}
+	return checkStandard(evmABI, standard)
+}
+
+// checkStandard checks a parsed ABI against a standard.
+func checkStandard(evmABI abi.ABI, standard string) (*Conformance, error) {
+	standardABI, err := StandardABI(standard)
+	if err != nil {
+		return nil, err
+	}
+	want, err := abi.JSON(strings.NewReader(standardABI))
+	if err != nil {
+		return nil, err
+	}
+	conformance := &Conformance{Standard: strings.ToLower(standard)}
+	methods := make(map[string]abi.Method)
+	for _, method := range evmABI.Methods {
+		methods[method.Sig()] = method
+	}
+	for _, key := range sortedKeys(want.Methods) {
+		expected := want.Methods[key]
+		desc := fmt.Sprintf("%s [%x]", expected.Sig(), expected.ID())
+		method, ok := methods[expected.Sig()]
+		if !ok {
+			conformance.Missing = append(conformance.Missing, desc)
+			continue
+		}
+		if got, exp := argTypes(method.Outputs, false), argTypes(expected.Outputs, false); got != exp {
+			conformance.Mismatched = append(conformance.Mismatched, fmt.Sprintf("%s returns (%s) instead of (%s)", desc, got, exp))
+		}
+		if method.Const != expected.Const {
+			view := map[bool]string{true: "a view", false: "a transaction"}
+			conformance.Mismatched = append(conformance.Mismatched, fmt.Sprintf("%s is %s instead of %s", desc, view[method.Const], view[expected.Const]))
+		}
+	}
+	events := make(map[string]abi.Event)
+	for _, event := range evmABI.Events {
+		events[event.Sig()] = event
+	}
+	for _, key := range sortedKeys(want.Events) {
+		expected := want.Events[key]
+		desc := fmt.Sprintf("event %s [%s]", expected.Sig(), expected.ID().Hex())
+		event, ok := events[expected.Sig()]
+		if !ok || event.Anonymous {
+			conformance.Missing = append(conformance.Missing, desc)
+			continue
+		}
+		if got, exp := argTypes(event.Inputs, true), argTypes(expected.Inputs, true); got != exp {
+			conformance.Mismatched = append(conformance.Mismatched, fmt.Sprintf("%s is declared (%s) instead of (%s)", desc, got, exp))
+		}
+	}
+	return conformance, nil
+}
+
+// argTypes lists the Solidity types of arguments, along with whether they are
+// indexed when indexed is set.
+func argTypes(args abi.Arguments, indexed bool) string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = arg.Type.String()
+		if indexed && arg.Indexed {
+			types[i] += " indexed"
+		}
+	}
+	return strings.Join(types, ",")
+}
+
+// BindStandards generates the Go interfaces of the calls and transactions of
+// every standard, each named after its standard in upper case (ERC20). Bindings
+// of ABIs conforming to a standard they are checked against assert that they
+// implement its interface.
+func BindStandards(pkg string) (string, error) {
+	data := &tmplInterfaces{
+		Package: pkg,
+		Structs: make(map[string]*tmplStruct),
+	}
+	for _, name := range Standards() {
+		contract, err := parseStandard(name)
+		if err != nil {
+			return "", err
+		}
+		iface := &tmplInterface{
+			Name: contract.Type,
+			Doc:  fmt.Sprintf("lists the calls and transactions of the %s standard (EIP-%s).", contract.Type, strings.TrimPrefix(name, "erc")),
+		}
+		for _, key := range sortedKeys(contract.Calls) {
+			iface.Calls = append(iface.Calls, contract.Calls[key])
+		}
+		for _, key := range sortedKeys(contract.Transacts) {
+			iface.Transacts = append(iface.Transacts, contract.Transacts[key])
+		}
+		for _, key := range sortedKeys(contract.Events) {
+			iface.Events = append(iface.Events, contract.Events[key])
+		}
+		data.Interfaces = append(data.Interfaces, iface)
+	}
+	return render(tmplInterfaceGo, data, LangGo)
+}
+
+// parseStandard digests the ABI of a standard like the ABI of a contract.
+func parseStandard(standard string) (*tmplContract, error) {
+	standardABI, err := StandardABI(standard)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.ToUpper(standard)
+	data, err := parse(Options{Contracts: []Contract{{Type: name, ABI: standardABI}}, Aliases: overloadAliases[strings.ToLower(standard)]})
+	if err != nil {
+		return nil, err
+	}
+	return data.Contracts[name], nil
+}
+
+// implements reports whether the binding of a contract has every method of the
+// interface generated for a standard, with the same Go signature. Aliases may
+// rename methods of conforming ABIs.
+func implements(contract, standard *tmplContract) bool {
+	keys := make(map[string]bool)
+	for _, method := range contract.Calls {
+		if !methodHasStruct(method) {
+			keys[methodKey(method, true)] = true
+		}
+	}
+	for _, method := range contract.Transacts {
+		if !methodHasStruct(method) {
+			keys[methodKey(method, false)] = true
+		}
+	}
+	for _, method := range standard.Calls {
+		if !keys[methodKey(method, true)] {
+			return false
+		}
+	}
+	for _, method := range standard.Transacts {
+		if !keys[methodKey(method, false)] {
+			return false
+		}
+	}
+	return true
+}
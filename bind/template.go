@@ -24,20 +24,56 @@ type tmplData struct {
 	Contracts map[string]*tmplContract // List of contracts to generate into this file
 	Libraries map[string]string        // Map the bytecode's link pattern to the library name
 	Structs   map[string]*tmplStruct   // Contract struct type definitions
+	// SharedStructs is set when the structs are declared in a file of their own,
+	// shared by the contracts of a package
+	SharedStructs bool
+}
+
+// Conforms reports whether any of the contracts conforms to a standard, so the
+// package declaring their interfaces is imported.
+func (d *tmplData) Conforms() bool {
+	for _, contract := range d.Contracts {
+		if len(contract.Standards) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // tmplContract contains the data needed to generate an individual contract binding.
 type tmplContract struct {
-	Type        string                 // Type name of the main contract binding
-	InputABI    string                 // JSON ABI used as the input to generate the binding from
-	InputBin    string                 // Optional EVM bytecode used to denetare deploy code from
-	FuncSigs    map[string]string      // Optional map: string signature -> 4-byte signature
-	Constructor abi.Method             // Contract constructor for deploy parametrization
-	Calls       map[string]*tmplMethod // Contract calls that only read state data
-	Transacts   map[string]*tmplMethod // Contract calls that write state data
-	Events      map[string]*tmplEvent  // Contract events accessors
-	Libraries   map[string]string      // Same as tmplData, but filtered to only keep what the contract needs
-	Library     bool                   // Indicator whether the contract is a library
+	Type             string                 // Type name of the main contract binding
+	InterfaceName    string                 // Name of the generated interface listing the binding's methods
+	InputABI         string                 // JSON ABI used as the input to generate the binding from
+	InputBin         string                 // Optional EVM bytecode used to denetare deploy code from
+	FuncSigs         map[string]string      // Optional map: string signature -> 4-byte signature
+	Constructor      abi.Method             // Contract constructor for deploy parametrization
+	Calls            map[string]*tmplMethod // Contract calls that only read state data
+	Transacts        map[string]*tmplMethod // Contract calls that write state data
+	Events           map[string]*tmplEvent  // Contract events accessors
+	JSONFields       bool                   // Whether event fields are converted to JSON at runtime, see jsonType
+	Libraries        map[string]string      // Same as tmplData, but filtered to only keep what the contract needs
+	DeployLibraries  bool                   // Whether every linked library is bound alongside the contract and can be deployed first
+	Errors           map[string]*tmplError  // Custom errors the contract can revert with
+	ErrorsABI        string                 // JSON ABI describing the errors as functions, used to decode revert data
+	Doc              string                 // NatSpec documentation of the contract, as comment lines
+	ConstructorDoc   string                 // NatSpec documentation of the constructor, as comment lines
+	RuntimeBin       string                 // Optional runtime bytecode deployed by InputBin
+	SourceMap        string                 // Optional solc source map of InputBin
+	RuntimeSourceMap string                 // Optional solc source map of RuntimeBin
+	Standards        []string               // Interfaces of the standards the ABI conforms to, declared in buddy/std
+}
+
+// methods returns both the calls and transacts of the contract.
+func (c *tmplContract) methods() []*tmplMethod {
+	methods := make([]*tmplMethod, 0, len(c.Calls)+len(c.Transacts))
+	for _, method := range c.Calls {
+		methods = append(methods, method)
+	}
+	for _, method := range c.Transacts {
+		methods = append(methods, method)
+	}
+	return methods
 }
 
 // tmplMethod is a wrapper around an abi.Method that contains a few preprocessed
@@ -46,6 +82,8 @@ type tmplMethod struct {
 	Original   abi.Method // Original method as parsed by the abi package
 	Normalized abi.Method // Normalized version of the parsed method (capitalized names, non-anonymous args/returns)
 	Structured bool       // Whether the returns should be accumulated into a struct
+	Calldata   string     // Name of the calldata helpers, prefixed by the contract type
+	Doc        string     // NatSpec documentation of the method, as comment lines
 }
 
 // tmplEvent is a wrapper around an a
@@ -53,6 +91,19 @@ type tmplEvent struct {
 	Original   abi.Event // Original event as parsed by the abi package
 	Normalized abi.Event // Normalized version of the parsed fields
 	Topic      string
+	Log        string // Name of the event type, prefixed by the contract type when clashing with another contract
+	Doc        string // NatSpec documentation of the event, as comment lines
+}
+
+// tmplError is a Solidity custom error. abi.JSON skips error entries, so these
+// are parsed separately from the rest of the ABI.
+type tmplError struct {
+	Name     string         // Normalized name of the error
+	RawName  string         // Name of the error as declared in Solidity
+	Sig      string         // Canonical signature, e.g. InsufficientBalance(uint256,uint256)
+	Selector string         // Hex encoded 4-byte selector, without the 0x prefix
+	Inputs   []abi.Argument // Error arguments, named after the fields of the generated type
+	Doc      string         // NatSpec documentation of the error, as comment lines
 }
 
 // tmplField is a wrapper around a struct field with binding language
@@ -60,6 +111,7 @@ type tmplEvent struct {
 type tmplField struct {
 	Type    string   // Field type representation depends on target binding language
 	Name    string   // Field name converted from the raw user-defined field name
+	RawName string   // Raw user-defined field name, used as its JSON key
 	SolKind abi.Type // Raw abi type information
 }
 
@@ -68,60 +120,365 @@ type tmplField struct {
 type tmplStruct struct {
 	Name   string       // Auto-generated struct name(before solidity v0.5.11) or raw name.
 	Fields []*tmplField // Struct fields definition depends on the binding language.
+	Owner  string       // Type of the first contract using the struct, prefixed to clashing names
+}
+
+// tmplInterfaces is the data structure required to fill the common interface
+// template.
+type tmplInterfaces struct {
+	Package    string                 // Name of the package to place the generated file in
+	Interfaces []*tmplInterface       // Interfaces to generate
+	Structs    map[string]*tmplStruct // Always empty, structs are specific to each binding
 }
 
+// tmplInterface is a Go interface listing calls and transactions of bindings.
+type tmplInterface struct {
+	Name      string        // Name of the generated interface
+	Doc       string        // First sentence of the interface doc, following its name
+	Calls     []*tmplMethod // Calls listed by the interface
+	Transacts []*tmplMethod // Transactions listed by the interface
+	Events    []*tmplEvent  // Events of the bindings, only documented
+}
+
+// tmplCallSigGo and tmplTransactSigGo are the signatures of the calls and
+// transactions listed by generated interfaces, shared with tmplInterfaceGo so
+// common interfaces match the bindings exactly.
+const (
+	tmplCallSigGo     = `{{.Normalized.Name}}(opts *bind.CallOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) ({{if .Structured}}struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} },{{else}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}},{{end}}{{end}} error)`
+	tmplTransactSigGo = `{{.Normalized.Name}}(opts *bind.TransactOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) (*types.Transaction, error)`
+)
+
 // tmplSourceGo is the Go source template use to generate the contract binding
 // based on.
 const tmplSourceGo = `
+// Code generated by buddy. DO NOT EDIT.
+
 {{$pkg := .Package}}
 package {{$pkg}}
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"{{if .Conforms}}
+	"github.com/evan-forbes/buddy/std"{{end}}
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = context.Background
+	_ = errors.New
+	_ = json.Marshal
+	_ = fmt.Errorf
+	_ = big.NewInt
+	_ = reflect.ValueOf
+	_ = strings.NewReader
+	_ = sync.NewCond
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = hexutil.Encode
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
 {{$structs := .Structs}}
+{{if and $structs (not .SharedStructs)}}
+//////////////////////////////////////////////////////
+//		Structs
+////////////////////////////////////////////////////
+{{range $structs}}
+// {{.Name}} is an auto generated low-level Go binding around an user-defined struct.
+type {{.Name}} struct {
+	{{range $field := .Fields}}
+	{{$field.Name}} {{$field.Type}} ` + "`json:\"{{$field.RawName}}\"`" + `{{end}}
+}
+{{end}}
+{{end}}
 {{range $contract := .Contracts}}
 
 // {{.Type}} is a wrapper around BoundContract, enforcing type checking and including
 // QoL helper methods
-type {{.Type}} bind.BoundContract
+{{.Doc}}type {{.Type}} struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+	abi      abi.ABI             // Parsed ABI, used to replay failed transactions
+	address  common.Address      // Address the contract is deployed at
+	backend  bind.ContractBackend
+}
 
 // New{{.Type}} creates a new instance of {{.Type}}, bound to a specific deployed contract.
 func New{{.Type}}(address common.Address, backend bind.ContractBackend) (*{{.Type}}, error) {
-	a, err := abi.JSON(strings.NewReader({{.Type}}ABI))
+	a, err := parse{{.Type}}ABI()
 	if err != nil {
 		return nil, err
 	}
 	contract := bind.NewBoundContract(address, a, backend, backend, backend)
-	return &{{.Type}}(*contract), nil
+	return &{{.Type}}{contract: contract, abi: a, address: address, backend: backend}, nil
+}
+
+// parse{{.Type}}ABI parses {{.Type}}ABI, keying overloaded methods by their full
+// signature so that every binding calls the exact overload it was generated for.
+func parse{{.Type}}ABI() (abi.ABI, error) {
+	parsed, err := abi.JSON(strings.NewReader({{.Type}}ABI))
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	overloads := make(map[string]int)
+	for _, method := range parsed.Methods {
+		overloads[method.RawName]++
+	}
+	methods := make(map[string]abi.Method, len(parsed.Methods))
+	for name, method := range parsed.Methods {
+		if overloads[method.RawName] > 1 {
+			name = method.Sig()
+		}
+		methods[name] = method
+	}
+	parsed.Methods = methods
+	return parsed, nil
+}
+
+// bound exposes the underlying BoundContract used for the low level calls
+func (_{{.Type}} *{{.Type}}) bound() *bind.BoundContract {
+	return _{{.Type}}.contract
+}
+
+// transact invokes method. Should that fail while estimating gas, the invocation
+// is replayed as a call to recover the revert data behind the failure.
+func (_{{.Type}} *{{.Type}}) transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	tx, err := _{{.Type}}.contract.Transact(opts, method, params...)
+	if err == nil || opts.GasLimit != 0 {
+		return tx, err
+	}
+	msg, perr := _{{.Type}}.message(opts, method, params...)
+	if perr != nil {
+		return nil, err
+	}
+	if _, cerr := _{{.Type}}.backend.CallContract(contextOf{{.Type}}(opts), msg, nil); cerr != nil {
+		if decoded := unpack{{.Type}}Error(cerr); decoded != cerr {
+			return nil, decoded
+		}
+	}
+	return nil, err
+}
+
+// estimate estimates the gas needed to invoke method with the given options.
+func (_{{.Type}} *{{.Type}}) estimate(opts *bind.TransactOpts, method string, params ...interface{}) (uint64, error) {
+	msg, err := _{{.Type}}.message(opts, method, params...)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := _{{.Type}}.backend.EstimateGas(contextOf{{.Type}}(opts), msg)
+	return gas, unpack{{.Type}}Error(err)
 }
 
+// simulate runs method as an eth_call from opts.From against the pending state,
+// unpacking its outputs into out, if any.
+func (_{{.Type}} *{{.Type}}) simulate(opts *bind.TransactOpts, out interface{}, method string, params ...interface{}) error {
+	msg, err := _{{.Type}}.message(opts, method, params...)
+	if err != nil {
+		return err
+	}
+	var output []byte
+	if pending, ok := _{{.Type}}.backend.(bind.PendingContractCaller); ok {
+		output, err = pending.PendingCallContract(contextOf{{.Type}}(opts), msg)
+	} else {
+		output, err = _{{.Type}}.backend.CallContract(contextOf{{.Type}}(opts), msg, nil)
+	}
+	if err != nil {
+		return unpack{{.Type}}Error(err)
+	}
+	if out == nil {
+		return nil
+	}
+	return _{{.Type}}.abi.Unpack(out, method, output)
+}
+
+// message packs an invocation of method into a call message sent with opts.
+func (_{{.Type}} *{{.Type}}) message(opts *bind.TransactOpts, method string, params ...interface{}) (ethereum.CallMsg, error) {
+	if opts == nil {
+		return ethereum.CallMsg{}, errors.New("no transact options to invoke {{.Type}}." + method + " with")
+	}
+	input, err := _{{.Type}}.abi.Pack(method, params...)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	return ethereum.CallMsg{
+		From:     opts.From,
+		To:       &_{{.Type}}.address,
+		Gas:      opts.GasLimit,
+		GasPrice: opts.GasPrice,
+		Value:    opts.Value,
+		Data:     input,
+	}, nil
+}
+
+// contextOf{{.Type}} returns the context of opts, defaulting to the background one.
+func contextOf{{.Type}}(opts *bind.TransactOpts) context.Context {
+	if opts.Context == nil {
+		return context.Background()
+	}
+	return opts.Context
+}
+
+//////////////////////////////////////////////////////
+//		Interface
+////////////////////////////////////////////////////
+
+// {{.InterfaceName}} lists every call, transaction and log unpacker of {{.Type}}.
+// It is regenerated along with the binding, so it can't drift from the ABI.
+type {{.InterfaceName}} interface { {{range .Calls}}
+	` + tmplCallSigGo + `{{end}}
+	{{range .Transacts}}
+	` + tmplTransactSigGo + `{{end}}
+	{{range .Events}}
+	Unpack{{.Normalized.Name}}Log(log types.Log) (*{{.Log}}, error){{end}}
+}
+
+// This nil assignment ensures at compile time that {{.Type}} implements {{.InterfaceName}}.
+var _ {{.InterfaceName}} = (*{{.Type}})(nil)
+{{range .Standards}}
+// This nil assignment ensures at compile time that {{$contract.Type}} implements std.{{.}}, the
+// standard its ABI conforms to.
+var _ std.{{.}} = (*{{$contract.Type}})(nil)
+{{end}}
 {{if .InputBin}}
 //////////////////////////////////////////////////////
 //		Deployment
 ////////////////////////////////////////////////////
 
+{{if .Libraries}}
+// {{.Type}}Libraries holds the addresses of the libraries {{.Type}} is linked against.
+type {{.Type}}Libraries struct { {{range $pattern, $name := .Libraries}}
+	{{capitalise $name}} common.Address // Replaces the __${{$pattern}}$__ placeholder{{end}}
+}
+
+// Link{{.Type}}Bin splices the library addresses into {{.Type}}Bin, returning
+// bytecode ready to be deployed.
+func Link{{.Type}}Bin(libs {{.Type}}Libraries) string {
+	bin := {{.Type}}Bin
+	{{range $pattern, $name := .Libraries}}bin = strings.Replace(bin, "__${{$pattern}}$__", strings.ToLower(libs.{{capitalise $name}}.Hex()[2:]), -1)
+	{{end}}
+	return bin
+}
+{{end}}
+
 // Deploy{{.Type}} deploys a new Ethereum contract, binding an instance of {{.Type}} to it.
-func Deploy{{.Type}}(auth *bind.TransactOpts, backend bind.ContractBackend {{range .Constructor.Inputs}}, {{.Name}} {{bindtype .Type $structs}}{{end}}) (common.Address, *types.Transaction, *{{.Type}}, error) {
-  parsed, err := abi.JSON(strings.NewReader({{.Type}}ABI))
+{{.ConstructorDoc}}func Deploy{{.Type}}(auth *bind.TransactOpts, backend bind.ContractBackend {{if .Libraries}}, libs {{.Type}}Libraries{{end}} {{range .Constructor.Inputs}}, {{.Name}} {{bindtype .Type $structs}}{{end}}) (common.Address, *types.Transaction, *{{.Type}}, error) {
+  parsed, err := parse{{.Type}}ABI()
   if err != nil {
 	return common.Address{}, nil, nil, err
   }
-  address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex({{.Type}}Bin), backend {{range .Constructor.Inputs}}, {{.Name}}{{end}})
+  address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex({{if .Libraries}}Link{{.Type}}Bin(libs){{else}}{{.Type}}Bin{{end}}), backend {{range .Constructor.Inputs}}, {{.Name}}{{end}})
   if err != nil {
 	return common.Address{}, nil, nil, err
   }
-  return address, tx, &{{.Type}}(*contract), nil
+  return address, tx, &{{.Type}}{contract: contract, abi: parsed, address: address, backend: backend}, nil
 }
-{{end}}
 
-//////////////////////////////////////////////////////
-//		Structs
-////////////////////////////////////////////////////
-{{range $structs}}
-// {{.Name}} is an auto generated low-level Go binding around an user-defined struct.
-type {{.Name}} struct {
-	{{range $field := .Fields}}
-	{{$field.Name}} {{$field.Type}}{{end}}
+// Err{{.Type}}CodeMismatch is returned by New{{.Type}}Verified when the code deployed
+// at an address isn't the one of {{.Type}}.
+var Err{{.Type}}CodeMismatch = errors.New("code does not match {{.Type}}Bin")
+
+// New{{.Type}}Verified binds an instance of {{.Type}}, after checking that the code
+// deployed at address is the runtime code of {{.Type}}Bin.
+func New{{.Type}}Verified(ctx context.Context, address common.Address, backend bind.ContractBackend) (*{{.Type}}, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w: %s", bind.ErrNoCode, address.Hex())
+	}
+	if !match{{.Type}}Code(code) {
+		return nil, fmt.Errorf("%w: %s", Err{{.Type}}CodeMismatch, address.Hex())
+	}
+	return New{{.Type}}(address, backend)
+}
+
+// match{{.Type}}Code reports whether code is the runtime code embedded in {{.Type}}Bin.
+// The metadata hash is ignored, as are the zeroed regions the bytecode reserves
+// for immutables and linked library addresses.
+func match{{.Type}}Code(code []byte) bool {
+	template := {{.Type}}Bin
+	{{range $pattern, $_ := .Libraries}}template = strings.Replace(template, "__${{$pattern}}$__", strings.Repeat("0", 40), -1)
+	{{end}}
+	bin := common.FromHex(template)
+
+	// Strip the CBOR encoded metadata, its length being in the last two bytes
+	if n := len(code); n > 2 {
+		if meta := int(code[n-2])<<8 | int(code[n-1]); meta+2 < n && code[n-2-meta]&0xe0 == 0xa0 {
+			code = code[:n-2-meta]
+		}
+	}
+	// Mask the runs of zeros long enough to hold an address or an immutable
+	masked := make([]bool, len(bin))
+	for start := 0; start < len(bin); {
+		end := start
+		for end < len(bin) && bin[end] == 0 {
+			end++
+		}
+		if end-start >= common.AddressLength {
+			for i := start; i < end; i++ {
+				masked[i] = true
+			}
+		}
+		start = end + 1
+	}
+	// Look for the runtime code within the creation code
+	for offset := 0; len(code) > 0 && offset+len(code) <= len(bin); offset++ {
+		matched := true
+		for i := range code {
+			if code[i] != bin[offset+i] && !masked[offset+i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+{{if .DeployLibraries}}
+// Deploy{{.Type}}WithLibraries deploys each of the libraries {{.Type}} is linked
+// against before deploying {{.Type}} itself, returning the library addresses used.
+func Deploy{{.Type}}WithLibraries(auth *bind.TransactOpts, backend bind.ContractBackend {{range .Constructor.Inputs}}, {{.Name}} {{bindtype .Type $structs}}{{end}}) (common.Address, *types.Transaction, *{{.Type}}, {{.Type}}Libraries, error) {
+	var (
+		libs {{.Type}}Libraries
+		err  error
+	)
+	opts := *auth
+	{{range $pattern, $name := .Libraries}}
+	libs.{{capitalise $name}}, _, _, err = Deploy{{capitalise $name}}(&opts, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, libs, err
+	}
+	// keep any manually managed nonce in step with the sent transactions
+	if opts.Nonce != nil {
+		opts.Nonce = new(big.Int).Add(opts.Nonce, common.Big1)
+	}
+	{{end}}
+	address, tx, contract, err := Deploy{{.Type}}(&opts, backend, libs {{range .Constructor.Inputs}}, {{.Name}}{{end}})
+	return address, tx, contract, libs, err
 }
 {{end}}
+{{end}}
+
 
 //////////////////////////////////////////////////////
 //		Data Calls
@@ -130,7 +487,7 @@ type {{.Name}} struct {
 {{range .Calls}}
 // {{.Normalized.Name}} is a free data retrieval call binding the contract method 0x{{printf "%x" .Original.ID}}.
 // - Solidity: {{formatmethod .Original $structs}}
-func (_{{$contract.Type}} *{{$contract.Type}}) {{.Normalized.Name}}(opts *bind.CallOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) ({{if .Structured}}struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} },{{else}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}},{{end}}{{end}} error) {
+{{.Doc}}func (_{{$contract.Type}} *{{$contract.Type}}) {{.Normalized.Name}}(opts *bind.CallOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) ({{if .Structured}}struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} },{{else}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}},{{end}}{{end}} error) {
 	{{if .Structured}}ret := new(struct{
 		{{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}}
 		{{end}}
@@ -142,8 +499,8 @@ func (_{{$contract.Type}} *{{$contract.Type}}) {{.Normalized.Name}}(opts *bind.C
 		{{range $i, $_ := .Normalized.Outputs}}ret{{$i}},
 		{{end}}
 	}{{end}}{{end}}
-	err := _{{$contract.Type}}.Call(opts, out, "{{.Original.Name}}" {{range .Normalized.Inputs}}, {{.Name}}{{end}})
-	return {{if .Structured}}*ret,{{else}}{{range $i, $_ := .Normalized.Outputs}}*ret{{$i}},{{end}}{{end}} err
+	err := _{{$contract.Type}}.bound().Call(opts, out, "{{.Original.Name}}" {{range .Normalized.Inputs}}, {{.Name}}{{end}})
+	return {{if .Structured}}*ret,{{else}}{{range $i, $_ := .Normalized.Outputs}}*ret{{$i}},{{end}}{{end}} unpack{{$contract.Type}}Error(err)
 }
 {{end}}
 
@@ -154,53 +511,758 @@ func (_{{$contract.Type}} *{{$contract.Type}}) {{.Normalized.Name}}(opts *bind.C
 {{range .Transacts}}
 // {{.Normalized.Name}} is a paid mutator transaction binding the contract method 0x{{printf "%x" .Original.ID}}.
 // - Solidity: {{formatmethod .Original $structs}}
-func (_{{$contract.Type}} *{{$contract.Type}}) {{.Normalized.Name}}(opts *bind.TransactOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) (*types.Transaction, error) {
-	return _{{$contract.Type}}.Transact(opts, "{{.Original.Name}}" {{range .Normalized.Inputs}}, {{.Name}}{{end}})
+{{.Doc}}func (_{{$contract.Type}} *{{$contract.Type}}) {{.Normalized.Name}}(opts *bind.TransactOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) (*types.Transaction, error) {
+	return _{{$contract.Type}}.transact(opts, "{{.Original.Name}}" {{range .Normalized.Inputs}}, {{.Name}}{{end}})
+}
+
+// Estimate{{.Normalized.Name}} estimates the gas needed to invoke the contract method 0x{{printf "%x" .Original.ID}}.
+func (_{{$contract.Type}} *{{$contract.Type}}) Estimate{{.Normalized.Name}}(opts *bind.TransactOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) (uint64, error) {
+	return _{{$contract.Type}}.estimate(opts, "{{.Original.Name}}" {{range .Normalized.Inputs}}, {{.Name}}{{end}})
+}
+
+// Simulate{{.Normalized.Name}} runs the contract method 0x{{printf "%x" .Original.ID}} as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_{{$contract.Type}} *{{$contract.Type}}) Simulate{{.Normalized.Name}}(opts *bind.TransactOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) ({{if .Structured}}struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} },{{else}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}},{{end}}{{end}} error) {
+	{{if .Normalized.Outputs}}{{if .Structured}}ret := new(struct{
+		{{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}}
+		{{end}}
+	}){{else}}var (
+		{{range $i, $_ := .Normalized.Outputs}}ret{{$i}} = new({{bindtype .Type $structs}})
+		{{end}}
+	){{end}}
+	out := {{if .Structured}}ret{{else}}{{if eq (len .Normalized.Outputs) 1}}ret0{{else}}&[]interface{}{
+		{{range $i, $_ := .Normalized.Outputs}}ret{{$i}},
+		{{end}}
+	}{{end}}{{end}}
+	err := _{{$contract.Type}}.simulate(opts, out, "{{.Original.Name}}" {{range .Normalized.Inputs}}, {{.Name}}{{end}})
+	return {{if .Structured}}*ret,{{else}}{{range $i, $_ := .Normalized.Outputs}}*ret{{$i}},{{end}}{{end}} err{{else}}return _{{$contract.Type}}.simulate(opts, nil, "{{.Original.Name}}" {{range .Normalized.Inputs}}, {{.Name}}{{end}}){{end}}
+}
+{{end}}
+
+//////////////////////////////////////////////////////
+//		Sessions
+////////////////////////////////////////////////////
+
+// {{.Type}}Session binds {{.Type}} to default call and transact options, exposing
+// every method without the opts argument.
+type {{.Type}}Session struct {
+	Contract     *{{.Type}}        // Contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// {{.Type}}CallerSession binds {{.Type}} to default call options, exposing every
+// call without the opts argument.
+type {{.Type}}CallerSession struct {
+	Contract *{{.Type}}    // Contract binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// {{.Type}}TransactorSession binds {{.Type}} to default transact options, exposing
+// every transaction without the opts argument.
+type {{.Type}}TransactorSession struct {
+	Contract     *{{.Type}}        // Contract binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// New{{.Type}}Session opens a session on contract, sending transactions with auth
+// and making calls from the same account.
+func New{{.Type}}Session(contract *{{.Type}}, auth *bind.TransactOpts) *{{.Type}}Session {
+	return &{{.Type}}Session{
+		Contract:     contract,
+		CallOpts:     bind.CallOpts{From: auth.From, Context: auth.Context},
+		TransactOpts: *auth,
+	}
+}
+
+// {{.Type}}SessionInterface is the opts-free counterpart of {{.InterfaceName}}.
+type {{.Type}}SessionInterface interface { {{range .Calls}}
+	{{.Normalized.Name}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) ({{if .Structured}}struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} },{{else}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}},{{end}}{{end}} error){{end}}
+	{{range .Transacts}}
+	{{.Normalized.Name}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) (*types.Transaction, error){{end}}
+}
+
+// This nil assignment ensures at compile time that {{.Type}}Session implements {{.Type}}SessionInterface.
+var _ {{.Type}}SessionInterface = (*{{.Type}}Session)(nil)
+
+{{range .Calls}}
+// {{.Normalized.Name}} is a free data retrieval call binding the contract method 0x{{printf "%x" .Original.ID}}.
+func (_{{$contract.Type}} *{{$contract.Type}}Session) {{.Normalized.Name}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) ({{if .Structured}}struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} },{{else}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}},{{end}}{{end}} error) {
+	return _{{$contract.Type}}.Contract.{{.Normalized.Name}}(&_{{$contract.Type}}.CallOpts{{range .Normalized.Inputs}}, {{.Name}}{{end}})
+}
+
+// {{.Normalized.Name}} is a free data retrieval call binding the contract method 0x{{printf "%x" .Original.ID}}.
+func (_{{$contract.Type}} *{{$contract.Type}}CallerSession) {{.Normalized.Name}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) ({{if .Structured}}struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} },{{else}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}},{{end}}{{end}} error) {
+	return _{{$contract.Type}}.Contract.{{.Normalized.Name}}(&_{{$contract.Type}}.CallOpts{{range .Normalized.Inputs}}, {{.Name}}{{end}})
+}
+{{end}}
+{{range .Transacts}}
+// {{.Normalized.Name}} is a paid mutator transaction binding the contract method 0x{{printf "%x" .Original.ID}}.
+func (_{{$contract.Type}} *{{$contract.Type}}Session) {{.Normalized.Name}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) (*types.Transaction, error) {
+	return _{{$contract.Type}}.Contract.{{.Normalized.Name}}(&_{{$contract.Type}}.TransactOpts{{range .Normalized.Inputs}}, {{.Name}}{{end}})
+}
+
+// {{.Normalized.Name}} is a paid mutator transaction binding the contract method 0x{{printf "%x" .Original.ID}}.
+func (_{{$contract.Type}} *{{$contract.Type}}TransactorSession) {{.Normalized.Name}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) (*types.Transaction, error) {
+	return _{{$contract.Type}}.Contract.{{.Normalized.Name}}(&_{{$contract.Type}}.TransactOpts{{range .Normalized.Inputs}}, {{.Name}}{{end}})
+}
+{{end}}
+
+//////////////////////////////////////////////////////
+//		Calldata
+////////////////////////////////////////////////////
+
+var (
+	parsed{{.Type}}ABIOnce sync.Once
+	parsed{{.Type}}ABI     abi.ABI
+	parsed{{.Type}}ABIErr  error
+)
+
+// load{{.Type}}ABI parses {{.Type}}ABI once, sharing it between the calldata helpers.
+func load{{.Type}}ABI() (abi.ABI, error) {
+	parsed{{.Type}}ABIOnce.Do(func() {
+		parsed{{.Type}}ABI, parsed{{.Type}}ABIErr = parse{{.Type}}ABI()
+	})
+	return parsed{{.Type}}ABI, parsed{{.Type}}ABIErr
+}
+
+// unnamed{{.Type}}Args names unnamed arguments the way the input structs do, so
+// they can be unpacked into them.
+func unnamed{{.Type}}Args(args abi.Arguments) abi.Arguments {
+	named := make(abi.Arguments, len(args))
+	for i, arg := range args {
+		if arg.Name == "" {
+			arg.Name = fmt.Sprintf("arg%d", i)
+		}
+		named[i] = arg
+	}
+	return named
+}
+{{range .Calls}}
+// {{.Calldata}}Input holds the arguments of a {{$contract.Type}}.{{.Normalized.Name}} invocation.
+type {{.Calldata}}Input struct { {{range .Normalized.Inputs}}
+	{{capitalise .Name}} {{bindtype .Type $structs}}; {{end}}{{if .Normalized.Inputs}}
+{{end}}}
+
+// Pack{{.Calldata}} packs the calldata invoking the contract method 0x{{printf "%x" .Original.ID}}.
+func Pack{{.Calldata}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) ([]byte, error) {
+	parsed, err := load{{$contract.Type}}ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("{{.Original.Name}}"{{range .Normalized.Inputs}}, {{.Name}}{{end}})
+}
+
+// Unpack{{.Calldata}}Input unpacks the calldata of a transaction invoking the
+// contract method 0x{{printf "%x" .Original.ID}}, selector included.
+func Unpack{{.Calldata}}Input(data []byte) (*{{.Calldata}}Input, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "{{printf "%x" .Original.ID}}" {
+		return nil, errors.New("calldata does not invoke {{.Original.Sig}}")
+	}
+	input := new({{.Calldata}}Input)
+	{{if .Normalized.Inputs}}parsed, err := load{{$contract.Type}}ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamed{{$contract.Type}}Args(parsed.Methods["{{.Original.Name}}"].Inputs)
+	if err := args.Unpack({{if eq (len .Normalized.Inputs) 1}}&input.{{range .Normalized.Inputs}}{{capitalise .Name}}{{end}}{{else}}input{{end}}, data[4:]); err != nil {
+		return nil, err
+	}{{end}}
+	return input, nil
+}
+
+// Unpack{{.Calldata}}Output unpacks the data returned by the contract method 0x{{printf "%x" .Original.ID}},
+// as {{$contract.Type}}.{{.Normalized.Name}} returns it.
+func Unpack{{.Calldata}}Output(data []byte) ({{if .Structured}}struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} },{{else}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}},{{end}}{{end}} error) {
+	{{if .Structured}}ret := new(struct{
+		{{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}}
+		{{end}}
+	}){{else}}var (
+		{{range $i, $_ := .Normalized.Outputs}}ret{{$i}} = new({{bindtype .Type $structs}})
+		{{end}}
+	){{end}}
+	out := {{if .Structured}}ret{{else}}{{if eq (len .Normalized.Outputs) 1}}ret0{{else}}&[]interface{}{
+		{{range $i, $_ := .Normalized.Outputs}}ret{{$i}},
+		{{end}}
+	}{{end}}{{end}}
+	parsed, err := load{{$contract.Type}}ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "{{.Original.Name}}", data)
+	}
+	return {{if .Structured}}*ret,{{else}}{{range $i, $_ := .Normalized.Outputs}}*ret{{$i}},{{end}}{{end}} err
+}
+{{end}}
+{{range .Transacts}}
+// {{.Calldata}}Input holds the arguments of a {{$contract.Type}}.{{.Normalized.Name}} invocation.
+type {{.Calldata}}Input struct { {{range .Normalized.Inputs}}
+	{{capitalise .Name}} {{bindtype .Type $structs}}; {{end}}{{if .Normalized.Inputs}}
+{{end}}}
+
+// Pack{{.Calldata}} packs the calldata invoking the contract method 0x{{printf "%x" .Original.ID}}.
+func Pack{{.Calldata}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) ([]byte, error) {
+	parsed, err := load{{$contract.Type}}ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("{{.Original.Name}}"{{range .Normalized.Inputs}}, {{.Name}}{{end}})
+}
+
+// Unpack{{.Calldata}}Input unpacks the calldata of a transaction invoking the
+// contract method 0x{{printf "%x" .Original.ID}}, selector included.
+func Unpack{{.Calldata}}Input(data []byte) (*{{.Calldata}}Input, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "{{printf "%x" .Original.ID}}" {
+		return nil, errors.New("calldata does not invoke {{.Original.Sig}}")
+	}
+	input := new({{.Calldata}}Input)
+	{{if .Normalized.Inputs}}parsed, err := load{{$contract.Type}}ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamed{{$contract.Type}}Args(parsed.Methods["{{.Original.Name}}"].Inputs)
+	if err := args.Unpack({{if eq (len .Normalized.Inputs) 1}}&input.{{range .Normalized.Inputs}}{{capitalise .Name}}{{end}}{{else}}input{{end}}, data[4:]); err != nil {
+		return nil, err
+	}{{end}}
+	return input, nil
 }
 {{end}}
+// Err{{.Type}}UnknownMethod is returned by Decode{{.Type}}Call for calldata that doesn't invoke a {{.Type}} method.
+var Err{{.Type}}UnknownMethod = errors.New("unknown {{.Type}} method")
+
+// Decode{{.Type}}Call identifies the method invoked by calldata through its 4-byte
+// selector, returning the matching *MethodInput holding its arguments.
+func Decode{{.Type}}Call(data []byte) (interface{}, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("%w: calldata too short", Err{{.Type}}UnknownMethod)
+	}
+	switch common.Bytes2Hex(data[:4]) { {{range .Calls}}
+	case "{{printf "%x" .Original.ID}}":
+		input, err := Unpack{{.Calldata}}Input(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil{{end}}{{range .Transacts}}
+	case "{{printf "%x" .Original.ID}}":
+		input, err := Unpack{{.Calldata}}Input(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil{{end}}
+	}
+	return nil, fmt.Errorf("%w: selector %x", Err{{.Type}}UnknownMethod, data[:4])
+}
+
+//////////////////////////////////////////////////////
+//		Errors
+////////////////////////////////////////////////////
+
+// {{.Type}}Revert is a revert with a reason string, as raised by require and revert.
+type {{.Type}}Revert struct {
+	Reason string
+}
+
+func (e {{.Type}}Revert) Error() string {
+	return "{{.Type}}: execution reverted: " + e.Reason
+}
+
+// {{.Type}}Panic is raised by failed assertions, arithmetic overflows and the like.
+type {{.Type}}Panic struct {
+	Code *big.Int
+}
+
+func (e {{.Type}}Panic) Error() string {
+	return fmt.Sprintf("{{.Type}}: panic code %#x", e.Code)
+}
+{{range .Errors}}
+// {{$contract.Type}}{{.Name}} is the custom error {{.Sig}}, selector 0x{{.Selector}}.
+{{.Doc}}type {{$contract.Type}}{{.Name}} struct{ {{range .Inputs}}
+	{{.Name}} {{bindtype .Type $structs}}; {{end}}{{if .Inputs}}
+{{end}}}
+
+func (e {{$contract.Type}}{{.Name}}) Error() string {
+	{{if .Inputs}}return fmt.Sprintf("{{$contract.Type}}: {{.RawName}}({{range $i, $_ := .Inputs}}{{if $i}}, {{end}}%v{{end}})"{{range .Inputs}}, e.{{.Name}}{{end}}){{else}}return "{{$contract.Type}}: {{.RawName}}()"{{end}}
+}
+{{end}}
+// {{.Type}}ErrorsABI describes the errors {{.Type}} can revert with, each as a function
+// returning the error arguments.
+const {{.Type}}ErrorsABI = "{{.ErrorsABI}}"
+
+// Decode{{.Type}}Revert decodes revert data into a {{.Type}}Revert, a {{.Type}}Panic
+// or one of the custom errors of {{.Type}}. It returns nil for unknown data.
+func Decode{{.Type}}Revert(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	parsed, err := abi.JSON(strings.NewReader({{.Type}}ErrorsABI))
+	if err != nil {
+		return nil
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "08c379a0":
+		var e {{.Type}}Revert
+		if parsed.Unpack(&e, "Error", data[4:]) == nil {
+			return e
+		}
+	case "4e487b71":
+		var e {{.Type}}Panic
+		if parsed.Unpack(&e, "Panic", data[4:]) == nil {
+			return e
+		}
+	{{range .Errors}}case "{{.Selector}}":
+		{{if .Inputs}}var e {{$contract.Type}}{{.Name}}
+		if parsed.Unpack(&e, "{{.Name}}", data[4:]) == nil {
+			return e
+		}{{else}}return {{$contract.Type}}{{.Name}}{}{{end}}
+	{{end -}}
+	}
+	return nil
+}
+
+// unpack{{.Type}}Error replaces an error carrying revert data with the typed error
+// decoded from it, leaving any other error untouched. Only errors exposing the
+// data through ErrorData, like sim.RevertError, carry it; RPC errors don't.
+func unpack{{.Type}}Error(err error) error {
+	var reverted interface{ ErrorData() interface{} }
+	if !errors.As(err, &reverted) {
+		return err
+	}
+	var data []byte
+	switch d := reverted.ErrorData().(type) {
+	case string:
+		data = common.FromHex(d)
+	case []byte:
+		data = d
+	}
+	if decoded := Decode{{.Type}}Revert(data); decoded != nil {
+		return decoded
+	}
+	return err
+}
 
 //////////////////////////////////////////////////////
 //		Events
 ////////////////////////////////////////////////////
+{{if .Events}}
+// log{{.Type}}JSON is the JSON encoding shared by the events of {{.Type}}.
+type log{{.Type}}JSON struct {
+	Event       string          ` + "`json:\"event\"`" + `
+	Contract    string          ` + "`json:\"contract\"`" + `
+	Fields      json.RawMessage ` + "`json:\"fields\"`" + `
+	Address     common.Address  ` + "`json:\"address\"`" + `
+	Topics      []common.Hash   ` + "`json:\"topics\"`" + `
+	Data        hexutil.Bytes   ` + "`json:\"data\"`" + `
+	BlockNumber uint64          ` + "`json:\"blockNumber\"`" + `
+	BlockHash   common.Hash     ` + "`json:\"blockHash\"`" + `
+	TxHash      common.Hash     ` + "`json:\"transactionHash\"`" + `
+	TxIndex     uint            ` + "`json:\"transactionIndex\"`" + `
+	LogIndex    uint            ` + "`json:\"logIndex\"`" + `
+	Removed     bool            ` + "`json:\"removed\"`" + `
+}
+
+// marshalLog{{.Type}} encodes the decoded fields of an event along with its log.
+func marshalLog{{.Type}}(name string, fields interface{}, log types.Log) ([]byte, error) {
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(log{{.Type}}JSON{
+		Event:       name,
+		Contract:    "{{.Type}}",
+		Fields:      encoded,
+		Address:     log.Address,
+		Topics:      log.Topics,
+		Data:        log.Data,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Removed:     log.Removed,
+	})
+}
+
+{{if .JSONFields}}// encodeJSON{{.Type}} encodes an event field as JSON, writing big numbers as
+// decimal strings and byte arrays as hex down through slices, arrays and
+// structs. Struct fields are keyed by their json tag.
+func encodeJSON{{.Type}}(v reflect.Value) (json.RawMessage, error) {
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		if value == nil {
+			return json.RawMessage("null"), nil
+		}
+		return json.Marshal(value.String())
+	case []byte:
+		return json.Marshal(hexutil.Bytes(value))
+	case common.Address, common.Hash:
+		return json.Marshal(value)
+	}
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		if v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8 {
+			encoded := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(encoded), v)
+			return json.Marshal(hexutil.Bytes(encoded))
+		}
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		items := make([]json.RawMessage, v.Len())
+		for i := range items {
+			item, err := encodeJSON{{.Type}}(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return json.Marshal(items)
+	case reflect.Struct:
+		encoded := []byte{'{'}
+		for i := 0; i < v.NumField(); i++ {
+			key, err := json.Marshal(jsonKey{{.Type}}(v.Type().Field(i)))
+			if err != nil {
+				return nil, err
+			}
+			value, err := encodeJSON{{.Type}}(v.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			if i > 0 {
+				encoded = append(encoded, ',')
+			}
+			encoded = append(append(append(encoded, key...), ':'), value...)
+		}
+		return append(encoded, '}'), nil
+	}
+	return json.Marshal(v.Interface())
+}
+
+// decodeJSON{{.Type}} decodes an event field encoded by encodeJSON{{.Type}} into v.
+func decodeJSON{{.Type}}(input json.RawMessage, v reflect.Value) error {
+	switch v.Interface().(type) {
+	case *big.Int:
+		var dec *string
+		if err := json.Unmarshal(input, &dec); err != nil {
+			return err
+		}
+		if dec == nil {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		value, ok := new(big.Int).SetString(*dec, 10)
+		if !ok {
+			return fmt.Errorf("invalid number %q", *dec)
+		}
+		v.Set(reflect.ValueOf(value))
+		return nil
+	case []byte:
+		var dec hexutil.Bytes
+		if err := json.Unmarshal(input, &dec); err != nil {
+			return err
+		}
+		v.SetBytes(dec)
+		return nil
+	case common.Address, common.Hash:
+		return json.Unmarshal(input, v.Addr().Interface())
+	}
+	switch v.Kind() {
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			var dec hexutil.Bytes
+			if err := json.Unmarshal(input, &dec); err != nil {
+				return err
+			}
+			if len(dec) != v.Len() {
+				return fmt.Errorf("invalid length %d, want %d", len(dec), v.Len())
+			}
+			reflect.Copy(v, reflect.ValueOf([]byte(dec)))
+			return nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(input, &items); err != nil {
+			return err
+		}
+		if len(items) != v.Len() {
+			return fmt.Errorf("invalid length %d, want %d", len(items), v.Len())
+		}
+		for i, item := range items {
+			if err := decodeJSON{{.Type}}(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		var items []json.RawMessage
+		if err := json.Unmarshal(input, &items); err != nil {
+			return err
+		}
+		if items == nil {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		v.Set(reflect.MakeSlice(v.Type(), len(items), len(items)))
+		for i, item := range items {
+			if err := decodeJSON{{.Type}}(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(input, &fields); err != nil {
+			return err
+		}
+		for i := 0; i < v.NumField(); i++ {
+			key := jsonKey{{.Type}}(v.Type().Field(i))
+			if field, ok := fields[key]; ok {
+				if err := decodeJSON{{.Type}}(field, v.Field(i)); err != nil {
+					return fmt.Errorf("invalid %s: %v", key, err)
+				}
+			}
+		}
+		return nil
+	}
+	return json.Unmarshal(input, v.Addr().Interface())
+}
 
+// jsonKey{{.Type}} returns the JSON key of a struct field.
+func jsonKey{{.Type}}(field reflect.StructField) string {
+	if key := field.Tag.Get("json"); key != "" {
+		return key
+	}
+	return field.Name
+}
+{{end}}
+// unmarshalLog{{.Type}} decodes the JSON encoding of an event, checking its name
+// and returning the raw log.
+func unmarshalLog{{.Type}}(input []byte, name string, fields interface{}) (types.Log, error) {
+	var dec log{{.Type}}JSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return types.Log{}, err
+	}
+	if dec.Event != name || dec.Contract != "{{.Type}}" {
+		return types.Log{}, fmt.Errorf("cannot unmarshal %s.%s into a {{.Type}}.%s event", dec.Contract, dec.Event, name)
+	}
+	if err := json.Unmarshal(dec.Fields, fields); err != nil {
+		return types.Log{}, err
+	}
+	return types.Log{
+		Address:     dec.Address,
+		Topics:      dec.Topics,
+		Data:        dec.Data,
+		BlockNumber: dec.BlockNumber,
+		BlockHash:   dec.BlockHash,
+		TxHash:      dec.TxHash,
+		TxIndex:     dec.TxIndex,
+		Index:       dec.LogIndex,
+		Removed:     dec.Removed,
+	}, nil
+}
+{{end}}
 {{range .Events}}
 //////// {{.Normalized.Name}} ////////
 
 // {{.Normalized.Name}}ID is the hex of the Topic Hash
 const {{$contract.Type}}{{.Normalized.Name}}ID = "{{.Topic}}"
 
-// {{.Normalized.Name}}Log represents a {{.Normalized.Name}} event raised by the {{$contract.Type}} contract.
-type {{.Normalized.Name}}Log struct { {{range .Normalized.Inputs}}
+// {{.Log}} represents a {{.Normalized.Name}} event raised by the {{$contract.Type}} contract.
+{{.Doc}}type {{.Log}} struct { {{range .Normalized.Inputs}}
 	{{capitalise .Name}} {{if .Indexed}}{{bindtopictype .Type $structs}}{{else}}{{bindtype .Type $structs}}{{end}}; {{end}}
 	Raw types.Log // Blockchain specific contextual infos
 }
 
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e {{.Log}}) MarshalJSON() ([]byte, error) {
+	var fields struct { {{range .Normalized.Inputs}}{{$t := bindtype .Type $structs}}{{if .Indexed}}{{$t = bindtopictype .Type $structs}}{{end}}
+		{{capitalise .Name}} {{jsontype $t}} ` + "`json:\"{{.Name}}\"`" + `{{end}}
+	}{{range .Normalized.Inputs}}{{$t := bindtype .Type $structs}}{{if .Indexed}}{{$t = bindtopictype .Type $structs}}{{end}}
+	{{if eq $t "*big.Int"}}if e.{{capitalise .Name}} != nil {
+		fields.{{capitalise .Name}} = e.{{capitalise .Name}}.String()
+	}{{else if eq (jsontype $t) "json.RawMessage"}}encoded{{capitalise .Name}}, err := encodeJSON{{$contract.Type}}(reflect.ValueOf(e.{{capitalise .Name}}))
+	if err != nil {
+		return nil, err
+	}
+	fields.{{capitalise .Name}} = encoded{{capitalise .Name}}{{else if eq $t "[]byte"}}fields.{{capitalise .Name}} = e.{{capitalise .Name}}{{else if eq (jsontype $t) "hexutil.Bytes"}}fields.{{capitalise .Name}} = e.{{capitalise .Name}}[:]{{else}}fields.{{capitalise .Name}} = e.{{capitalise .Name}}{{end}}{{end}}
+	return marshalLog{{$contract.Type}}("{{.Normalized.Name}}", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *{{.Log}}) UnmarshalJSON(input []byte) error {
+	var fields struct { {{range .Normalized.Inputs}}{{$t := bindtype .Type $structs}}{{if .Indexed}}{{$t = bindtopictype .Type $structs}}{{end}}
+		{{capitalise .Name}} {{jsontype $t}} ` + "`json:\"{{.Name}}\"`" + `{{end}}
+	}
+	raw, err := unmarshalLog{{$contract.Type}}(input, "{{.Normalized.Name}}", &fields)
+	if err != nil {
+		return err
+	}{{range .Normalized.Inputs}}{{$t := bindtype .Type $structs}}{{if .Indexed}}{{$t = bindtopictype .Type $structs}}{{end}}
+	{{if eq $t "*big.Int"}}if fields.{{capitalise .Name}} != "" {
+		value, ok := new(big.Int).SetString(fields.{{capitalise .Name}}, 10)
+		if !ok {
+			return fmt.Errorf("invalid {{.Name}} %q", fields.{{capitalise .Name}})
+		}
+		e.{{capitalise .Name}} = value
+	}{{else if eq (jsontype $t) "json.RawMessage"}}if len(fields.{{capitalise .Name}}) > 0 {
+		if err := decodeJSON{{$contract.Type}}(fields.{{capitalise .Name}}, reflect.ValueOf(&e.{{capitalise .Name}}).Elem()); err != nil {
+			return fmt.Errorf("invalid {{.Name}}: %v", err)
+		}
+	}{{else if eq $t "[]byte"}}e.{{capitalise .Name}} = fields.{{capitalise .Name}}{{else if eq (jsontype $t) "hexutil.Bytes"}}if len(fields.{{capitalise .Name}}) != len(e.{{capitalise .Name}}) {
+		return fmt.Errorf("invalid {{.Name}} length %d", len(fields.{{capitalise .Name}}))
+	}
+	copy(e.{{capitalise .Name}}[:], fields.{{capitalise .Name}}){{else}}e.{{capitalise .Name}} = fields.{{capitalise .Name}}{{end}}{{end}}
+	e.Raw = raw
+	return nil
+}
+
 // Unpack{{.Normalized.Name}}Log is a log parse operation binding the contract event {{.Topic}}
 // Solidity: {{formatevent .Original $structs}}
-func (_{{$contract.Type}} *{{$contract.Type}}) Unpack{{.Normalized.Name}}Log(log types.Log) (*{{.Normalized.Name}}Log, error) {
-	event := new({{.Normalized.Name}}Log)
-	if err := _{{$contract.Type}}.UnpackLog(event, "{{.Original.Name}}", log); err != nil {
+func (_{{$contract.Type}} *{{$contract.Type}}) Unpack{{.Normalized.Name}}Log(log types.Log) (*{{.Log}}, error) {
+	event := new({{.Log}})
+	if err := _{{$contract.Type}}.bound().UnpackLog(event, "{{.Original.Name}}", log); err != nil {
 		return nil, err
 	}
+	event.Raw = log
 	return event, nil
 }
 
+// {{.Log}}Iterator is returned from Filter{{.Normalized.Name}} and is used to iterate over
+// the raw logs and unpacked data for {{.Normalized.Name}} events raised by the {{$contract.Type}} contract.
+type {{.Log}}Iterator struct {
+	Event *{{.Log}} // Event containing the contract specifics and raw log
+
+	contract *{{$contract.Type}} // Contract used to unpack the raw logs
+	logs     chan types.Log     // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *{{.Log}}Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.Unpack{{.Normalized.Name}}Log(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.Unpack{{.Normalized.Name}}Log(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *{{.Log}}Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *{{.Log}}Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Filter{{.Normalized.Name}} is a free log retrieval operation binding the contract event {{.Topic}}.
+// Solidity: {{formatevent .Original $structs}}
+func (_{{$contract.Type}} *{{$contract.Type}}) Filter{{.Normalized.Name}}(opts *bind.FilterOpts{{range .Normalized.Inputs}}{{if .Indexed}}, {{.Name}} []{{bindtype .Type $structs}}{{end}}{{end}}) (*{{.Log}}Iterator, error) {
+	{{range .Normalized.Inputs}}{{if .Indexed}}var {{.Name}}Rule []interface{}
+	for _, {{.Name}}Item := range {{.Name}} {
+		{{.Name}}Rule = append({{.Name}}Rule, {{.Name}}Item)
+	}
+	{{end}}{{end}}
+	logs, sub, err := _{{$contract.Type}}.bound().FilterLogs(opts, "{{.Original.Name}}"{{range .Normalized.Inputs}}{{if .Indexed}}, {{.Name}}Rule{{end}}{{end}})
+	if err != nil {
+		return nil, err
+	}
+	return &{{.Log}}Iterator{contract: _{{$contract.Type}}, logs: logs, sub: sub}, nil
+}
+
+// Watch{{.Normalized.Name}} is a free log subscription operation binding the contract event {{.Topic}}.
+// Solidity: {{formatevent .Original $structs}}
+func (_{{$contract.Type}} *{{$contract.Type}}) Watch{{.Normalized.Name}}(opts *bind.WatchOpts, sink chan<- *{{.Log}}{{range .Normalized.Inputs}}{{if .Indexed}}, {{.Name}} []{{bindtype .Type $structs}}{{end}}{{end}}) (event.Subscription, error) {
+	{{range .Normalized.Inputs}}{{if .Indexed}}var {{.Name}}Rule []interface{}
+	for _, {{.Name}}Item := range {{.Name}} {
+		{{.Name}}Rule = append({{.Name}}Rule, {{.Name}}Item)
+	}
+	{{end}}{{end}}
+	logs, sub, err := _{{$contract.Type}}.bound().WatchLogs(opts, "{{.Original.Name}}"{{range .Normalized.Inputs}}{{if .Indexed}}, {{.Name}}Rule{{end}}{{end}})
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _{{$contract.Type}}.Unpack{{.Normalized.Name}}Log(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
 {{end}}
 
-/*
-Mux can be copied and pasted to save ya a quick minute when distinguishing between log data
-func Mux(c *{{$contract.Type}}, log types.Log) error {
+{{if .Events}}
+//////////////////////////////////////////////////////
+//		Event Dispatch
+////////////////////////////////////////////////////
+
+// {{.Type}}EventHandler handles each of the events raised by the {{.Type}} contract.
+type {{.Type}}EventHandler interface { {{range .Events}}
+	Handle{{.Normalized.Name}}(event *{{.Log}}) error{{end}}
+}
+
+// Err{{.Type}}UnknownEvent is returned by Dispatch for logs that weren't raised by a {{.Type}} event.
+var Err{{.Type}}UnknownEvent = errors.New("unknown {{.Type}} event")
+
+// Dispatch unpacks the log according to its first topic and passes it on to the
+// matching method of the handler.
+func (_{{$contract.Type}} *{{$contract.Type}}) Dispatch(log types.Log, h {{$contract.Type}}EventHandler) error {
+	if len(log.Topics) == 0 {
+		return fmt.Errorf("%w: log has no topics", Err{{$contract.Type}}UnknownEvent)
+	}
 	switch log.Topics[0].Hex() { {{range .Events}}
-	case {{$pkg}}.{{$contract.Type}}{{.Normalized.Name}}ID:
-		ulog, err := c.Unpack{{.Normalized.Name}}Log(log)
+	case {{$contract.Type}}{{.Normalized.Name}}ID:
+		event, err := _{{$contract.Type}}.Unpack{{.Normalized.Name}}Log(log)
 		if err != nil {
 			return err
 		}
-		// insert additional code here
+		return h.Handle{{.Normalized.Name}}(event)
 	{{end}}
 	}
+	return fmt.Errorf("%w: topic %s", Err{{$contract.Type}}UnknownEvent, log.Topics[0].Hex())
 }
-*/
+{{end}}
 
 //////////////////////////////////////////////////////
 //		Bin and ABI
@@ -211,6 +1273,22 @@ const {{.Type}}Bin = "0x{{.InputBin}}"
 
 // {{.Type}}ABI is used to communicate with the compiled solidity code of the generated contract
 const {{.Type}}ABI = "{{.InputABI}}"
+{{if .RuntimeBin}}
+// {{.Type}}RuntimeBin is the code deployed by {{.Type}}Bin, with libraries left unlinked
+const {{.Type}}RuntimeBin = "0x{{.RuntimeBin}}"
+{{end}}{{if .SourceMap}}
+// {{.Type}}SourceMap maps the instructions of {{.Type}}Bin to the Solidity sources
+const {{.Type}}SourceMap = "{{.SourceMap}}"
+{{end}}{{if .RuntimeSourceMap}}
+// {{.Type}}RuntimeSourceMap maps the instructions of {{.Type}}RuntimeBin to the Solidity sources
+const {{.Type}}RuntimeSourceMap = "{{.RuntimeSourceMap}}"
+{{end}}{{if .FuncSigs}}
+// {{.Type}}FuncSigs maps the 4-byte function signature to its string representation.
+var {{.Type}}FuncSigs = map[string]string{
+	{{range $strsig, $binsig := .FuncSigs}}"{{$binsig}}": "{{$strsig}}",
+	{{end}}
+}
+{{end}}
 {{end}}
 `
 
@@ -221,3 +1299,348 @@ const {{.Type}}ABI = "{{.InputABI}}"
 // 	Raw types.Log // Blockchain specific contextual infos
 // }
 // func (_{{$contract.Type}}) *{{contract.Type}} {{}}
+
+// tmplStructsGo is the Go source template used to declare the structs shared by
+// the contracts of a package in a file of their own.
+const tmplStructsGo = `
+// Code generated by buddy. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+)
+//////////////////////////////////////////////////////
+//		Structs
+////////////////////////////////////////////////////
+{{range .Structs}}
+// {{.Name}} is an auto generated low-level Go binding around an user-defined struct.
+type {{.Name}} struct {
+	{{range $field := .Fields}}
+	{{$field.Name}} {{$field.Type}} ` + "`json:\"{{$field.RawName}}\"`" + `{{end}}
+}
+{{end}}
+`
+
+// tmplMockGo is the Go source template used to generate scriptable mocks of the
+// contract bindings. It is meant to be rendered next to tmplSourceGo.
+const tmplMockGo = `
+// Code generated by buddy. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+)
+
+{{$structs := .Structs}}
+{{range $contract := .Contracts}}
+
+// {{.Type}}Mock is a scriptable implementation of {{.InterfaceName}} for unit tests.
+// Results are scripted per method and arguments, for example
+//	mock.OnMethod(args...).Return(results..., err)
+// Calls that were not scripted return zero values and an error.
+type {{.Type}}Mock struct {
+	mu    sync.Mutex
+	calls []{{.Type}}MockCall
+	{{range .Calls}}
+	on{{.Normalized.Name}} []*{{$contract.Type}}{{.Normalized.Name}}Mock{{end}}
+	{{range .Transacts}}
+	on{{.Normalized.Name}} []*{{$contract.Type}}{{.Normalized.Name}}Mock{{end}}
+	{{range .Events}}
+	onUnpack{{.Normalized.Name}}Log []*{{$contract.Type}}Unpack{{.Normalized.Name}}LogMock{{end}}
+}
+
+// This nil assignment ensures at compile time that {{.Type}}Mock implements {{.InterfaceName}}.
+var _ {{.InterfaceName}} = (*{{.Type}}Mock)(nil)
+
+// {{.Type}}MockCall records a single call made to a {{.Type}}Mock.
+type {{.Type}}MockCall struct {
+	Method string        // Name of the called method
+	Args   []interface{} // Arguments passed to the method, excluding opts
+}
+
+// MockCalls returns every call made to the mock so far, in order.
+func (_m *{{.Type}}Mock) MockCalls() []{{.Type}}MockCall {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	return append([]{{.Type}}MockCall(nil), _m.calls...)
+}
+
+// match{{.Type}}MockArgs compares scripted and received arguments, treating big
+// integers as equal whenever they hold the same value.
+func match{{.Type}}MockArgs(want, got []interface{}) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		w, wok := want[i].(*big.Int)
+		g, gok := got[i].(*big.Int)
+		if wok && gok && w != nil && g != nil {
+			if w.Cmp(g) != 0 {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(want[i], got[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+{{range .Calls}}
+// {{$contract.Type}}{{.Normalized.Name}}Mock scripts the results of {{$contract.Type}}Mock.{{.Normalized.Name}}.
+type {{$contract.Type}}{{.Normalized.Name}}Mock struct {
+	mock *{{$contract.Type}}Mock
+	args []interface{}{{if .Structured}}
+	ret  struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} }{{else}}{{range $i, $_ := .Normalized.Outputs}}
+	ret{{$i}} {{bindtype .Type $structs}}{{end}}{{end}}
+	err error
+}
+
+// On{{.Normalized.Name}} scripts the results of {{.Normalized.Name}} calls made with the given arguments.
+func (_m *{{$contract.Type}}Mock) On{{.Normalized.Name}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) *{{$contract.Type}}{{.Normalized.Name}}Mock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &{{$contract.Type}}{{.Normalized.Name}}Mock{mock: _m, args: []interface{}{ {{range .Normalized.Inputs}}{{.Name}}, {{end}} }}
+	_m.on{{.Normalized.Name}} = append(_m.on{{.Normalized.Name}}, _e)
+	return _e
+}
+
+// Return sets the results of the {{.Normalized.Name}} calls matching the scripted arguments.
+func (_e *{{$contract.Type}}{{.Normalized.Name}}Mock) Return({{if .Structured}}ret struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} }, {{else}}{{range $i, $_ := .Normalized.Outputs}}ret{{$i}} {{bindtype .Type $structs}}, {{end}}{{end}}err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	{{if .Structured}}_e.ret = ret{{else}}{{range $i, $_ := .Normalized.Outputs}}_e.ret{{$i}} = ret{{$i}}
+	{{end}}{{end}}
+	_e.err = err
+}
+
+// {{.Normalized.Name}} records the call and returns the results scripted for its arguments.
+func (_m *{{$contract.Type}}Mock) {{.Normalized.Name}}(opts *bind.CallOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) ({{if .Structured}}struct{ {{range .Normalized.Outputs}}{{.Name}} {{bindtype .Type $structs}};{{end}} },{{else}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}},{{end}}{{end}} error) {
+	_args := []interface{}{ {{range .Normalized.Inputs}}{{.Name}}, {{end}} }
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, {{$contract.Type}}MockCall{Method: "{{.Normalized.Name}}", Args: _args})
+	for _i := len(_m.on{{.Normalized.Name}}) - 1; _i >= 0; _i-- {
+		if _e := _m.on{{.Normalized.Name}}[_i]; match{{$contract.Type}}MockArgs(_e.args, _args) {
+			return {{if .Structured}}_e.ret,{{else}}{{range $i, $_ := .Normalized.Outputs}}_e.ret{{$i}},{{end}}{{end}} _e.err
+		}
+	}
+	var _e {{$contract.Type}}{{.Normalized.Name}}Mock
+	return {{if .Structured}}_e.ret,{{else}}{{range $i, $_ := .Normalized.Outputs}}_e.ret{{$i}},{{end}}{{end}} fmt.Errorf("{{$contract.Type}}Mock: no results scripted for {{.Normalized.Name}}%v", _args)
+}
+{{end}}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+{{range .Transacts}}
+// {{$contract.Type}}{{.Normalized.Name}}Mock scripts the results of {{$contract.Type}}Mock.{{.Normalized.Name}}.
+type {{$contract.Type}}{{.Normalized.Name}}Mock struct {
+	mock *{{$contract.Type}}Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// On{{.Normalized.Name}} scripts the results of {{.Normalized.Name}} transactions made with the given arguments.
+func (_m *{{$contract.Type}}Mock) On{{.Normalized.Name}}({{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}} {{bindtype .Type $structs}}{{end}}) *{{$contract.Type}}{{.Normalized.Name}}Mock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &{{$contract.Type}}{{.Normalized.Name}}Mock{mock: _m, args: []interface{}{ {{range .Normalized.Inputs}}{{.Name}}, {{end}} }}
+	_m.on{{.Normalized.Name}} = append(_m.on{{.Normalized.Name}}, _e)
+	return _e
+}
+
+// Return sets the results of the {{.Normalized.Name}} transactions matching the scripted arguments.
+func (_e *{{$contract.Type}}{{.Normalized.Name}}Mock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// {{.Normalized.Name}} records the transaction and returns the results scripted for its arguments.
+func (_m *{{$contract.Type}}Mock) {{.Normalized.Name}}(opts *bind.TransactOpts {{range .Normalized.Inputs}}, {{.Name}} {{bindtype .Type $structs}} {{end}}) (*types.Transaction, error) {
+	_args := []interface{}{ {{range .Normalized.Inputs}}{{.Name}}, {{end}} }
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, {{$contract.Type}}MockCall{Method: "{{.Normalized.Name}}", Args: _args})
+	for _i := len(_m.on{{.Normalized.Name}}) - 1; _i >= 0; _i-- {
+		if _e := _m.on{{.Normalized.Name}}[_i]; match{{$contract.Type}}MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("{{$contract.Type}}Mock: no results scripted for {{.Normalized.Name}}%v", _args)
+}
+{{end}}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+{{range .Events}}
+// {{$contract.Type}}Unpack{{.Normalized.Name}}LogMock scripts the results of {{$contract.Type}}Mock.Unpack{{.Normalized.Name}}Log.
+type {{$contract.Type}}Unpack{{.Normalized.Name}}LogMock struct {
+	mock  *{{$contract.Type}}Mock
+	args  []interface{}
+	event *{{.Log}}
+	err   error
+}
+
+// OnUnpack{{.Normalized.Name}}Log scripts the results of unpacking the given log.
+func (_m *{{$contract.Type}}Mock) OnUnpack{{.Normalized.Name}}Log(log types.Log) *{{$contract.Type}}Unpack{{.Normalized.Name}}LogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &{{$contract.Type}}Unpack{{.Normalized.Name}}LogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpack{{.Normalized.Name}}Log = append(_m.onUnpack{{.Normalized.Name}}Log, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *{{$contract.Type}}Unpack{{.Normalized.Name}}LogMock) Return(event *{{.Log}}, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// Unpack{{.Normalized.Name}}Log records the call and returns the results scripted for the log.
+func (_m *{{$contract.Type}}Mock) Unpack{{.Normalized.Name}}Log(log types.Log) (*{{.Log}}, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, {{$contract.Type}}MockCall{Method: "Unpack{{.Normalized.Name}}Log", Args: _args})
+	for _i := len(_m.onUnpack{{.Normalized.Name}}Log) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpack{{.Normalized.Name}}Log[_i]; match{{$contract.Type}}MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("{{$contract.Type}}Mock: no results scripted for Unpack{{.Normalized.Name}}Log")
+}
+{{end}}
+{{end}}
+`
+
+// tmplTestGo is the Go source template used to generate test skeletons, which
+// deploy each contract onto a simulated backend and stub a subtest per
+// transaction.
+const tmplTestGo = `
+package {{.Package}}
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evan-forbes/buddy/sim"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+	_ = sim.NewSimulatedBackend
+)
+
+{{$structs := .Structs}}
+{{range $contract := .Contracts}}{{if .InputBin}}
+// deploy{{.Type}} funds a pair of accounts on a simulated backend and deploys
+// {{.Type}} from alice with zero valued constructor arguments.
+func deploy{{.Type}}(t *testing.T) (*sim.SimulatedBackend, sim.Accounts, *{{.Type}}) {
+	t.Helper()
+	accounts := sim.NewAccounts("alice", "bob")
+	backend := sim.NewSimulatedBackend(accounts.Genesis(), 10000000)
+	{{if .DeployLibraries}}_, _, contract, _, err := Deploy{{.Type}}WithLibraries(accounts["alice"].TxOpts, backend{{else}}_, _, contract, err := Deploy{{.Type}}(accounts["alice"].TxOpts, backend{{if .Libraries}}, {{.Type}}Libraries{}{{end}}{{end}}{{range .Constructor.Inputs}}, {{zerovalue .Type $structs}}{{end}})
+	if err != nil {
+		backend.Close()
+		t.Fatal(err)
+	}
+	backend.Commit()
+	return backend, accounts, contract
+}
+
+func Test{{.Type}}(t *testing.T) {
+	backend, accounts, contract := deploy{{.Type}}(t)
+	defer backend.Close()
+	{{if not .Transacts}}_, _ = accounts, contract
+	{{end}}{{range .Transacts}}
+	t.Run("{{.Normalized.Name}}", func(t *testing.T) {
+		t.Skip("TODO: test {{.Normalized.Name}}")
+		if _, err := contract.{{.Normalized.Name}}(accounts["alice"].TxOpts{{range .Normalized.Inputs}}, {{zerovalue .Type $structs}}{{end}}); err != nil {
+			t.Fatal(err)
+		}
+		backend.Commit()
+	})
+{{end}}}
+{{end}}{{end}}
+`
+
+// tmplInterfaceGo is the Go source template used to generate the common
+// interface of several contracts.
+const tmplInterfaceGo = `
+// Code generated by buddy. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+)
+
+{{$structs := .Structs}}
+{{range .Interfaces}}
+// {{.Name}} {{.Doc}}
+{{- if .Events}}
+//
+// Events:
+{{- range .Events}}
+//   - {{formatevent .Original $structs}}
+{{- end}}
+{{- end}}
+type {{.Name}} interface { {{range .Calls}}
+	` + tmplCallSigGo + `{{end}}
+	{{range .Transacts}}
+	` + tmplTransactSigGo + `{{end}}
+}
+{{end}}
+`
@@ -2,6 +2,7 @@ package book
 
 import (
 	"encoding/json"
+	"io"
 	"os"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -13,6 +14,42 @@ import (
 
 type Book map[string]common.Address
 
+// Read loads a book from filename. Write appends to the file, so every book
+// written to it is merged, later names overriding earlier ones.
+func Read(filename string) (Book, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not open address book file: %s", filename)
+	}
+	defer file.Close()
+	out := make(Book)
+	dec := json.NewDecoder(file)
+	for {
+		var b Book
+		err := dec.Decode(&b)
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read book %s", filename)
+		}
+		for name, addr := range b {
+			out[name] = addr
+		}
+	}
+}
+
+// Resolve returns the address of a name in the book, or parses a hex address.
+func (b Book) Resolve(nameOrHex string) (common.Address, error) {
+	if addr, ok := b[nameOrHex]; ok {
+		return addr, nil
+	}
+	if !common.IsHexAddress(nameOrHex) {
+		return common.Address{}, errors.Errorf("%q is neither a hex address nor a name in the book", nameOrHex)
+	}
+	return common.HexToAddress(nameOrHex), nil
+}
+
 func (b *Book) Write(filename string) error {
 	file, err := os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
 	if err != nil {
@@ -1 +1,41 @@
 package book
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestReadResolve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "book")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "book.json")
+	dai, usdc := common.HexToAddress("0xda1"), common.HexToAddress("0x05dc")
+	for _, b := range []Book{{"dai": common.HexToAddress("0x1"), "usdc": usdc}, {"dai": dai}} {
+		if err := b.Write(filename); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b, err := Read(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != 2 || b["dai"] != dai || b["usdc"] != usdc {
+		t.Errorf("unexpected book %v", b)
+	}
+	if addr, err := b.Resolve("dai"); err != nil || addr != dai {
+		t.Errorf("dai resolved to %x, %v", addr, err)
+	}
+	if addr, err := b.Resolve("0x00000000000000000000000000000000000000ff"); err != nil || addr != common.HexToAddress("0xff") {
+		t.Errorf("hex address resolved to %x, %v", addr, err)
+	}
+	if _, err := b.Resolve("weth"); err == nil {
+		t.Error("resolving an unknown name succeeded")
+	}
+}
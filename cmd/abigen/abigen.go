@@ -1,8 +1,14 @@
 package abigen
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/evan-forbes/buddy/bind"
@@ -18,8 +24,8 @@ func Cast(ctx *cli.Context) error {
 		return errors.New("No package declared. Use flag --pkg or -p")
 	}
 	// set value of type (default is whatever pkg is set to)
-	var tp string
-	if ctx.String("type") == "" {
+	tp := ctx.String("type")
+	if tp == "" {
 		tp = ctx.String("pkg")
 	}
 	// use defaults for abi and bin file locations (".")
@@ -27,7 +33,16 @@ func Cast(ctx *cli.Context) error {
 	binPath := ctx.String("bin")
 	path := "."
 	if ctx.NArg() > 0 {
-		path = ctx.Args().Get(1)
+		path = ctx.Args().First()
+	}
+	if ctx.String("json") != "" {
+		return castSolcOutput(ctx, ctx.String("json"))
+	}
+	if ctx.String("artifacts") != "" {
+		return castArtifacts(ctx, ctx.String("artifacts"))
+	}
+	if ctx.Bool("all") {
+		return castPackage(ctx, path)
 	}
 	// try to find paths to abi and bin files if not specified.
 	if abiPath == "." {
@@ -35,23 +50,53 @@ func Cast(ctx *cli.Context) error {
 		if !has {
 			return errors.Errorf("Could not find a .abi file in %s", path)
 		}
-		abiPath = newABIPath
+		abiPath = filepath.Join(path, newABIPath)
+	}
+	// solc output passed in place of an abi holds every contract compiled
+	if isSolcOutput(abiPath) {
+		return castSolcOutput(ctx, abiPath)
 	}
 	if binPath == "." {
-		binPath, _ = findFile(path, ".bin")
+		if newBinPath, has := findFile(path, ".bin"); has {
+			binPath = filepath.Join(path, newBinPath)
+		} else {
+			binPath = ""
+		}
 	}
 	// load the files
 	jsonABI, hexBin, err := openFiles(abiPath, binPath)
 	if err != nil {
 		return errors.Wrapf(err, "Problem loading files in abi path: %s bin path: %s", abiPath, binPath)
 	}
+	userDoc, err := loadDoc(ctx.String("userdoc"), abiPath, ".docuser")
+	if err != nil {
+		return err
+	}
+	devDoc, err := loadDoc(ctx.String("devdoc"), abiPath, ".docdev")
+	if err != nil {
+		return err
+	}
+	runtimeBin, err := loadDoc("", abiPath, ".bin-runtime")
+	if err != nil {
+		return err
+	}
+	opts, err := bindOptions(ctx, bind.Contract{
+		Type:            tp,
+		ABI:             jsonABI,
+		Bytecode:        hexBin,
+		Interface:       ctx.String("iface"),
+		UserDoc:         userDoc,
+		DevDoc:          devDoc,
+		RuntimeBytecode: runtimeBin,
+	})
+	if err != nil {
+		return err
+	}
 	// generate bindings
-	code, err := bind.Bind(
-		[]string{tp},
-		[]string{jsonABI},
-		[]string{hexBin},
-		ctx.String("pkg"),
-	)
+	code, err := bind.Bind(opts)
+	if err != nil {
+		return errors.Wrap(err, "Could not generate bindings")
+	}
 	// write to file
 	filename := fmt.Sprintf("%s_gen.go", tp)
 	if ctx.String("out") != "" {
@@ -61,10 +106,276 @@ func Cast(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if ctx.Bool("mock") {
+		// generate the mock next to the bindings
+		mock, err := bind.BindMock(opts)
+		if err != nil {
+			return errors.Wrap(err, "Could not generate mock")
+		}
+		err = ioutil.WriteFile(siblingFilename(tp, ctx.String("out"), "_mock.go"), []byte(mock), 0644)
+		if err != nil {
+			return err
+		}
+	}
+	if !ctx.Bool("tests") || hexBin == "" {
+		return nil
+	}
+	// generate the test skeleton, unless it was already written and filled in
+	testFile := siblingFilename(tp, ctx.String("out"), "_test.go")
+	if _, err := os.Stat(testFile); err == nil {
+		return nil
+	}
+	tests, err := bind.BindTests(opts)
+	if err != nil {
+		return errors.Wrap(err, "Could not generate tests")
+	}
+	return ioutil.WriteFile(testFile, []byte(tests), 0644)
+}
+
+// castPackage binds every .abi file found in path, along with the .bin and
+// NatSpec files next to them, into a package.
+func castPackage(ctx *cli.Context, path string) error {
+	items, err := ioutil.ReadDir(path)
+	if err != nil {
+		return errors.Wrapf(err, "Could not read directory %s", path)
+	}
+	var contracts []bind.Contract
+	for _, item := range items {
+		if item.IsDir() || filepath.Ext(item.Name()) != ".abi" {
+			continue
+		}
+		abiPath := filepath.Join(path, item.Name())
+		binPath := strings.TrimSuffix(abiPath, ".abi") + ".bin"
+		if _, err := os.Stat(binPath); err != nil {
+			binPath = ""
+		}
+		contract, err := ReadContract(strings.TrimSuffix(item.Name(), ".abi"), abiPath, binPath)
+		if err != nil {
+			return err
+		}
+		contracts = append(contracts, contract)
+	}
+	if len(contracts) == 0 {
+		return errors.Errorf("Could not find a .abi file in %s", path)
+	}
+	return Generate(ctx, contracts, nil)
+}
+
+// castSolcOutput binds every contract found in the output of solc, either
+// --combined-json or --standard-json, into a package written to --dir.
+func castSolcOutput(ctx *cli.Context, path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "Could not read solc output: %s", path)
+	}
+	contracts, libs, err := bind.ParseSolcOutput(data, ctx.StringSlice("filter"))
+	if err != nil {
+		return errors.Wrapf(err, "Could not parse solc output: %s", path)
+	}
+	return Generate(ctx, contracts, libs)
+}
+
+// castArtifacts binds the contracts selected by --filter out of a directory of
+// Hardhat, Truffle or Foundry artifacts into a package written to --dir.
+func castArtifacts(ctx *cli.Context, dir string) error {
+	contracts, libs, err := bind.ReadArtifacts(dir, ctx.StringSlice("filter"))
+	if err != nil {
+		return errors.Wrapf(err, "Could not read artifacts in %s", dir)
+	}
+	return Generate(ctx, contracts, libs)
+}
+
+// isSolcOutput reports whether the file holds solc output rather than a plain
+// abi, which is a JSON array.
+func isSolcOutput(path string) bool {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	data = bytes.TrimSpace(data)
+	return len(data) > 0 && data[0] == '{'
+}
+
+// Generate binds several contracts into a package written to --dir, honoring
+// the flags shared by the commands generating bindings. Libraries found
+// alongside the contracts are linked unless --lib says otherwise.
+func Generate(ctx *cli.Context, contracts []bind.Contract, libs map[string]string) error {
+	opts, err := bindOptions(ctx, contracts...)
+	if err != nil {
+		return err
+	}
+	for pattern, name := range libs {
+		if _, ok := opts.Libraries[pattern]; !ok {
+			opts.Libraries[pattern] = name
+		}
+	}
+	files, err := bind.BindPackage(opts, ctx.Bool("mock"), ctx.Bool("tests"))
+	if err != nil {
+		return errors.Wrap(err, "Could not generate bindings")
+	}
+	return WriteFiles(ctx.String("dir"), files)
+}
 
+// WriteFiles writes generated files to dir, creating it if needed. Test files
+// are only scaffolding, so existing ones are left alone.
+func WriteFiles(dir string, files map[string]string) error {
+	if dir == "" {
+		dir = "."
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil && strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if err := ioutil.WriteFile(path, []byte(files[name]), 0644); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// ReadContract loads a contract from its abi and optional bin, along with the
+// NatSpec and runtime bytecode files solc -o writes next to the abi.
+func ReadContract(tp, abiPath, binPath string) (bind.Contract, error) {
+	jsonABI, hexBin, err := openFiles(abiPath, binPath)
+	if err != nil {
+		return bind.Contract{}, errors.Wrapf(err, "Problem loading files in abi path: %s bin path: %s", abiPath, binPath)
+	}
+	userDoc, err := loadDoc("", abiPath, ".docuser")
+	if err != nil {
+		return bind.Contract{}, err
+	}
+	devDoc, err := loadDoc("", abiPath, ".docdev")
+	if err != nil {
+		return bind.Contract{}, err
+	}
+	runtimeBin, err := loadDoc("", abiPath, ".bin-runtime")
+	if err != nil {
+		return bind.Contract{}, err
+	}
+	return bind.Contract{
+		Type:            tp,
+		ABI:             jsonABI,
+		Bytecode:        hexBin,
+		UserDoc:         userDoc,
+		DevDoc:          devDoc,
+		RuntimeBytecode: runtimeBin,
+	}, nil
+}
+
+// Libraries keys library links by the placeholder left in bytecode, accepting
+// fully qualified library names in place of the raw placeholder
+func Libraries(links map[string]string) map[string]string {
+	libs := make(map[string]string)
+	for lib, name := range links {
+		if !libPattern.MatchString(lib) {
+			lib = bind.LibraryPattern(lib)
+		}
+		libs[lib] = name
+	}
+	return libs
+}
+
+// bindOptions collects the aliases, library links and function signatures
+// passed as flags into the options used to generate the contracts' bindings
+func bindOptions(ctx *cli.Context, contracts ...bind.Contract) (bind.Options, error) {
+	aliases, err := ParsePairs("alias", ctx.StringSlice("alias"))
+	if err != nil {
+		return bind.Options{}, err
+	}
+	links, err := ParsePairs("lib", ctx.StringSlice("lib"))
+	if err != nil {
+		return bind.Options{}, err
+	}
+	fsigs, err := ParsePairs("fsig", ctx.StringSlice("fsig"))
+	if err != nil {
+		return bind.Options{}, err
+	}
+	for i := range contracts {
+		if contracts[i].FuncSigs == nil {
+			contracts[i].FuncSigs = make(map[string]string)
+		}
+		for sig, id := range fsigs {
+			contracts[i].FuncSigs[sig] = id
+		}
+	}
+	if standards := splitList(ctx.String("conforms")); len(standards) > 0 {
+		if err := Conform(ctx.App.Writer, contracts, standards); err != nil {
+			return bind.Options{}, err
+		}
+	}
+	return bind.Options{
+		Package:   ctx.String("pkg"),
+		Contracts: contracts,
+		Aliases:   aliases,
+		Libraries: Libraries(links),
+	}, nil
+}
+
+// Conform checks the contracts against the standards, reporting how each of them
+// conforms to w. The bindings of the contracts conforming to a standard assert
+// they implement its interface. Without standards the contracts are left alone.
+func Conform(w io.Writer, contracts []bind.Contract, standards []string) error {
+	if len(standards) == 0 {
+		return nil
+	}
+	for i := range contracts {
+		for _, standard := range standards {
+			conformance, err := bind.CheckStandard(contracts[i].ABI, standard)
+			if err != nil {
+				return errors.Wrapf(err, "Could not check %s against %s", contracts[i].Type, standard)
+			}
+			fmt.Fprintf(w, "%s %s\n", contracts[i].Type, conformance)
+		}
+		contracts[i].Standards = standards
+	}
+	return nil
+}
+
+// splitList splits a comma separated flag value
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// libPattern matches the raw library placeholders left in bytecode by solc
+var libPattern = regexp.MustCompile("^[0-9a-f]{34}$")
+
+// ParsePairs splits key=value flag values into a map
+func ParsePairs(flag string, values []string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("Invalid --%s value %q, expected key=value", flag, value)
+		}
+		out[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return out, nil
+}
+
+// siblingFilename derives the name of a file generated next to the bindings,
+// such as the mock or the tests, from the bindings output file
+func siblingFilename(tp, out, suffix string) string {
+	if out == "" {
+		return tp + suffix
+	}
+	return strings.TrimSuffix(out, ".go") + suffix
+}
+
 // open files
 func openFiles(abiPath, binPath string) (a, b string, err error) {
 	b, err = loadBin(binPath)
@@ -104,6 +415,22 @@ func loadABI(path string) (string, error) {
 	return string(rawABI), nil
 }
 
+// loadDoc loads solc NatSpec or runtime bytecode output. If no path is
+// specified, the file solc -o writes next to the abi is used when present.
+func loadDoc(path, abiPath, ext string) (string, error) {
+	if path == "" {
+		path = strings.TrimSuffix(abiPath, filepath.Ext(abiPath)) + ext
+		if _, err := os.Stat(path); err != nil {
+			return "", nil
+		}
+	}
+	rawDoc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "Could read doc file: %s", path)
+	}
+	return string(rawDoc), nil
+}
+
 // findFile returns the first file found with the provided type
 func findFile(path, ext string) (string, bool) {
 	if path == "" {
@@ -1,6 +1,15 @@
 package abigen
 
-import "testing"
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/evan-forbes/buddy/bind"
+)
 
 func TestFindFile(t *testing.T) {
 	path := "/etc"
@@ -10,3 +19,60 @@ func TestFindFile(t *testing.T) {
 		t.Error("could not find file name 'group' in /etc/")
 	}
 }
+
+func TestParsePairs(t *testing.T) {
+	pairs, err := ParsePairs("fsig", []string{"transfer(address,uint256)=a9059cbb", "name = symbol"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pairs["transfer(address,uint256)"] != "a9059cbb" || pairs["name"] != "symbol" {
+		t.Errorf("unexpected pairs: %v", pairs)
+	}
+	if _, err := ParsePairs("alias", []string{"missing"}); err == nil {
+		t.Error("expected an error for a value without a key")
+	}
+}
+
+func TestLoadDoc(t *testing.T) {
+	dir, err := ioutil.TempDir("", "abigen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	abiPath := filepath.Join(dir, "Token.abi")
+	if err := ioutil.WriteFile(filepath.Join(dir, "Token.docuser"), []byte(`{"notice":"A token"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	doc, err := loadDoc("", abiPath, ".docuser")
+	if err != nil || doc != `{"notice":"A token"}` {
+		t.Errorf("unexpected userdoc %q: %v", doc, err)
+	}
+	// a missing default is ignored, a missing explicit path is not
+	if doc, err := loadDoc("", abiPath, ".docdev"); err != nil || doc != "" {
+		t.Errorf("unexpected devdoc %q: %v", doc, err)
+	}
+	if _, err := loadDoc(filepath.Join(dir, "missing.docdev"), abiPath, ".docdev"); err == nil {
+		t.Error("expected an error loading a missing doc file")
+	}
+}
+
+func TestConform(t *testing.T) {
+	erc20, err := bind.StandardABI("erc20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	contracts := []bind.Contract{{Type: "Coin", ABI: erc20}, {Type: "Empty", ABI: "[]"}}
+	var report bytes.Buffer
+	if err := Conform(&report, contracts, nil); err != nil || report.Len() != 0 || contracts[0].Standards != nil {
+		t.Fatalf("checking no standards reported %q, set %v: %v", report.String(), contracts[0].Standards, err)
+	}
+	if err := Conform(&report, contracts, []string{"erc20"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(report.String(), "Coin conforms to erc20\nEmpty does not conform to erc20:\n") {
+		t.Errorf("unexpected report:\n%s", report.String())
+	}
+	if len(contracts[1].Standards) != 1 || contracts[1].Standards[0] != "erc20" {
+		t.Errorf("standards = %v, want [erc20]", contracts[1].Standards)
+	}
+}
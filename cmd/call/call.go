@@ -0,0 +1,679 @@
+package call
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	auth "github.com/evan-forbes/buddy/auth"
+	"github.com/evan-forbes/buddy/book"
+	"github.com/pkg/errors"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// Cast runs the call command, calling a contract method read from an ABI file
+// without sending a transaction and printing what it returns
+func Cast(ctx *cli.Context) error {
+	target, err := read(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := ethclient.Dial(ctx.String("rpc"))
+	if err != nil {
+		return errors.Wrapf(err, "Could not connect to %s", ctx.String("rpc"))
+	}
+	defer client.Close()
+	opts := &bind.CallOpts{Context: context.Background()}
+	if from := ctx.String("from"); from != "" {
+		if opts.From, err = target.book.Resolve(from); err != nil {
+			return err
+		}
+	}
+	if block := ctx.String("block"); block != "" {
+		number, ok := new(big.Int).SetString(block, 10)
+		if !ok {
+			return errors.Errorf("invalid block number %q", block)
+		}
+		opts.BlockNumber = number
+	}
+	outputs, err := target.call(opts, client)
+	if err != nil {
+		return err
+	}
+	printOutputs(os.Stdout, target.method.Outputs, outputs)
+	return nil
+}
+
+// Send runs the send command, signing and sending a transaction invoking a
+// contract method read from an ABI file
+func Send(ctx *cli.Context) error {
+	target, err := read(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := ethclient.Dial(ctx.String("rpc"))
+	if err != nil {
+		return errors.Wrapf(err, "Could not connect to %s", ctx.String("rpc"))
+	}
+	defer client.Close()
+	opts, err := signer(ctx, client)
+	if err != nil {
+		return err
+	}
+	opts.GasLimit = ctx.Uint64("gas")
+	if value := ctx.String("value"); value != "" {
+		wei, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return errors.Errorf("invalid value %q, expected an amount of wei", value)
+		}
+		opts.Value = wei
+	}
+	tx, err := target.send(opts, client)
+	if err != nil {
+		return err
+	}
+	fmt.Println(tx.Hash().Hex())
+	if !ctx.Bool("wait") {
+		return nil
+	}
+	receipt, err := bind.WaitMined(context.Background(), client, tx)
+	if err != nil {
+		return errors.Wrap(err, "Could not wait for the transaction to be mined")
+	}
+	return target.printReceipt(os.Stdout, receipt)
+}
+
+// signer builds the transactor signing with --key or the keystore --account
+func signer(ctx *cli.Context, client bind.ContractBackend) (*bind.TransactOpts, error) {
+	key, account := ctx.String("key"), ctx.String("account")
+	switch {
+	case key != "" && account != "":
+		return nil, errors.New("Both --key and --account given, use only one")
+	case key != "":
+		opts, err := auth.NewAuth(client, strings.TrimPrefix(key, "0x"))
+		return opts, errors.Wrap(err, "Could not use --key")
+	case account != "":
+		file, err := os.Open(account)
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not open keystore")
+		}
+		defer file.Close()
+		return auth.NewKeystoreAuth(client, file, ctx.String("password"))
+	}
+	return nil, errors.New("No signer given. Use flag --key or --account")
+}
+
+// target is a contract method invocation parsed from the command line
+type target struct {
+	abi     abi.ABI
+	errors  map[[4]byte]customError // Custom errors of the ABI by selector
+	address common.Address
+	method  abi.Method
+	args    []interface{}
+	book    book.Book
+}
+
+// read parses the ABI, address, method and arguments given to call and send
+func read(ctx *cli.Context) (*target, error) {
+	if ctx.String("abi") == "" {
+		return nil, errors.New("No abi declared. Use flag --abi or -a")
+	}
+	if ctx.String("to") == "" {
+		return nil, errors.New("No contract address declared. Use flag --to")
+	}
+	if ctx.NArg() == 0 {
+		return nil, errors.New("No method given, e.g. buddy call --abi erc20.abi --to dai balanceOf 0x...")
+	}
+	data, err := ioutil.ReadFile(ctx.String("abi"))
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not read abi")
+	}
+	b := make(book.Book)
+	if filename := ctx.String("book"); filename != "" {
+		if b, err = book.Read(filename); err != nil {
+			return nil, err
+		}
+	}
+	args := ctx.Args()
+	return newTarget(string(data), b, ctx.String("to"), args[0], args[1:])
+}
+
+// newTarget parses the arguments of method, given as strings, against the ABI
+func newTarget(abiJSON string, b book.Book, to, method string, args []string) (*target, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not parse abi")
+	}
+	errs, err := parseErrors(abiJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not parse abi")
+	}
+	address, err := b.Resolve(to)
+	if err != nil {
+		return nil, err
+	}
+	m, err := findMethod(parsed, method, len(args))
+	if err != nil {
+		return nil, err
+	}
+	if len(args) != len(m.Inputs) {
+		return nil, errors.Errorf("%s takes %d arguments, %d given", m.Sig(), len(m.Inputs), len(args))
+	}
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		v, err := parseValue(m.Inputs[i].Type, arg, b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "argument %d (%s) of %s", i, m.Inputs[i].Name, m.Sig())
+		}
+		values[i] = v.Interface()
+	}
+	return &target{abi: parsed, errors: errs, address: address, method: m, args: values, book: b}, nil
+}
+
+// findMethod looks a method up by name or signature. Overloads sharing a name
+// are told apart by their number of arguments.
+func findMethod(parsed abi.ABI, name string, nargs int) (abi.Method, error) {
+	if strings.Contains(name, "(") {
+		sig := strings.Replace(name, " ", "", -1)
+		for _, m := range parsed.Methods {
+			if m.Sig() == sig {
+				return m, nil
+			}
+		}
+		return abi.Method{}, errors.Errorf("no method %s in the abi", sig)
+	}
+	var named, fitting []abi.Method
+	for _, m := range parsed.Methods {
+		if m.RawName == name {
+			named = append(named, m)
+			if len(m.Inputs) == nargs {
+				fitting = append(fitting, m)
+			}
+		}
+	}
+	switch {
+	case len(named) == 0:
+		return abi.Method{}, errors.Errorf("no method %s in the abi", name)
+	case len(named) == 1:
+		return named[0], nil
+	case len(fitting) == 1:
+		return fitting[0], nil
+	}
+	sigs := make([]string, len(named))
+	for i, m := range named {
+		sigs[i] = m.Sig()
+	}
+	return abi.Method{}, errors.Errorf("%s is overloaded, give one of %s", name, strings.Join(sigs, ", "))
+}
+
+// call calls the method, unpacking what it returns
+func (t *target) call(opts *bind.CallOpts, backend bind.ContractCaller) ([]interface{}, error) {
+	input, err := t.abi.Pack(t.method.Name, t.args...)
+	if err != nil {
+		return nil, err
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	msg := ethereum.CallMsg{From: opts.From, To: &t.address, Data: input}
+	output, err := backend.CallContract(ctx, msg, opts.BlockNumber)
+	if err != nil {
+		return nil, t.unpackError(err)
+	}
+	if len(output) == 0 && len(t.method.Outputs) > 0 {
+		if code, err := backend.CodeAt(ctx, t.address, opts.BlockNumber); err == nil && len(code) == 0 {
+			return nil, bind.ErrNoCode
+		}
+	}
+	values, err := t.method.Outputs.UnpackValues(output)
+	if err != nil {
+		// older nodes return the revert data of failed calls as their output
+		if reverted := t.revert(output); reverted != nil {
+			return nil, reverted
+		}
+	}
+	return values, err
+}
+
+// send sends a transaction invoking the method. When its gas estimation fails,
+// the transaction is replayed as a call to find out what it reverts with.
+func (t *target) send(opts *bind.TransactOpts, backend bind.ContractBackend) (*types.Transaction, error) {
+	contract := bind.NewBoundContract(t.address, t.abi, backend, backend, backend)
+	tx, err := contract.Transact(opts, t.method.Name, t.args...)
+	if err == nil {
+		return tx, nil
+	}
+	if reverted := t.unpackError(err); reverted != err {
+		return nil, reverted
+	}
+	input, perr := t.abi.Pack(t.method.Name, t.args...)
+	if perr != nil {
+		return nil, err
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	msg := ethereum.CallMsg{From: opts.From, To: &t.address, Value: opts.Value, Data: input}
+	output, cerr := backend.CallContract(ctx, msg, nil)
+	if cerr != nil {
+		if reverted := t.unpackError(cerr); reverted != cerr {
+			return nil, reverted
+		}
+		return nil, err
+	}
+	if reverted := t.revert(output); reverted != nil {
+		return nil, reverted
+	}
+	return nil, err
+}
+
+// customError is an error declared in the ABI, which abi.JSON skips
+type customError struct {
+	name   string
+	inputs abi.Arguments
+}
+
+// builtin selectors of the Error(string) and Panic(uint256) reverts
+var (
+	reasonSelector = [4]byte{0x08, 0xc3, 0x79, 0xa0}
+	panicSelector  = [4]byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// parseErrors reads the custom errors declared in a JSON ABI, keyed by selector
+func parseErrors(abiJSON string) (map[[4]byte]customError, error) {
+	var fields []struct {
+		Type   string
+		Name   string
+		Inputs []abi.ArgumentMarshaling
+	}
+	if err := json.Unmarshal([]byte(abiJSON), &fields); err != nil {
+		return nil, err
+	}
+	errs := make(map[[4]byte]customError)
+	for _, field := range fields {
+		if field.Type != "error" {
+			continue
+		}
+		inputs := make(abi.Arguments, len(field.Inputs))
+		types := make([]string, len(field.Inputs))
+		for i, input := range field.Inputs {
+			typ, err := abi.NewType(input.Type, input.InternalType, input.Components)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error %s", field.Name)
+			}
+			inputs[i] = abi.Argument{Name: input.Name, Type: typ}
+			types[i] = typ.String()
+		}
+		var selector [4]byte
+		copy(selector[:], crypto.Keccak256([]byte(field.Name+"("+strings.Join(types, ",")+")")))
+		errs[selector] = customError{name: field.Name, inputs: inputs}
+	}
+	return errs, nil
+}
+
+// unpackError replaces an error carrying revert data, like the ones of the
+// simulated backend, with what the data decodes to
+func (t *target) unpackError(err error) error {
+	var reverted interface{ ErrorData() interface{} }
+	if !errors.As(err, &reverted) {
+		return err
+	}
+	var data []byte
+	switch d := reverted.ErrorData().(type) {
+	case string:
+		data = common.FromHex(d)
+	case []byte:
+		data = d
+	}
+	if decoded := t.revert(data); decoded != nil {
+		return decoded
+	}
+	return err
+}
+
+// revert decodes revert data into its reason, panic code or custom error of
+// the ABI, returning nil if it holds none of them
+func (t *target) revert(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	var selector [4]byte
+	copy(selector[:], data)
+	switch selector {
+	case reasonSelector:
+		typ, _ := abi.NewType("string", "", nil)
+		values, err := (abi.Arguments{{Type: typ}}).UnpackValues(data[4:])
+		if err != nil {
+			return nil
+		}
+		return errors.Errorf("execution reverted: %s", values[0])
+	case panicSelector:
+		typ, _ := abi.NewType("uint256", "", nil)
+		values, err := (abi.Arguments{{Type: typ}}).UnpackValues(data[4:])
+		if err != nil {
+			return nil
+		}
+		return errors.Errorf("execution reverted: panic 0x%x", values[0])
+	}
+	custom, ok := t.errors[selector]
+	if !ok {
+		return nil
+	}
+	values, err := custom.inputs.UnpackValues(data[4:])
+	if err != nil {
+		return nil
+	}
+	fields := make([]string, len(values))
+	for i, input := range custom.inputs {
+		name := input.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		fields[i] = name + ": " + formatValue(input.Type, reflect.ValueOf(values[i]))
+	}
+	return errors.Errorf("execution reverted: %s(%s)", custom.name, strings.Join(fields, ", "))
+}
+
+// printReceipt prints the outcome of a mined transaction and the events of the
+// ABI it logged
+func (t *target) printReceipt(w io.Writer, receipt *types.Receipt) error {
+	status := "succeeded"
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		status = "failed"
+	}
+	fmt.Fprintf(w, "%s in block %d using %d gas\n", status, receipt.BlockNumber, receipt.GasUsed)
+	for _, log := range receipt.Logs {
+		if log.Address != t.address || len(log.Topics) == 0 {
+			continue
+		}
+		for _, event := range t.abi.Events {
+			if event.ID() != log.Topics[0] {
+				continue
+			}
+			values, err := formatLog(event, log)
+			if err != nil {
+				return errors.Wrapf(err, "Could not unpack %s", event.Name)
+			}
+			fmt.Fprintf(w, "%s(%s)\n", event.RawName, strings.Join(values, ", "))
+		}
+	}
+	return nil
+}
+
+// formatLog formats the arguments of an event, prefixed with their name or
+// position. Indexed strings, bytes, arrays and tuples are only logged as the
+// hash of their value, which is printed as is.
+func formatLog(event abi.Event, log *types.Log) ([]string, error) {
+	data, err := event.Inputs.UnpackValues(log.Data)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(event.Inputs))
+	topics := log.Topics[1:]
+	for i, input := range event.Inputs {
+		name := input.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		if !input.Indexed {
+			values[i] = name + ": " + formatValue(input.Type, reflect.ValueOf(data[0]))
+			data = data[1:]
+			continue
+		}
+		if len(topics) == 0 {
+			return nil, errors.Errorf("missing topic of %s", name)
+		}
+		topic := topics[0]
+		topics = topics[1:]
+		switch input.Type.T {
+		case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+			values[i] = name + ": " + strconv.Quote(topic.Hex())
+			continue
+		}
+		// other types are encoded in their topic as they are in data
+		unindexed := input
+		unindexed.Indexed = false
+		value, err := abi.Arguments{unindexed}.UnpackValues(topic.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		values[i] = name + ": " + formatValue(input.Type, reflect.ValueOf(value[0]))
+	}
+	return values, nil
+}
+
+// printOutputs prints a lone output as is, and several ones a line each
+// prefixed with their name or position
+func printOutputs(w io.Writer, args abi.Arguments, values []interface{}) {
+	for i, value := range values {
+		formatted := formatOutput(args[i].Type, reflect.ValueOf(value))
+		if len(values) == 1 {
+			fmt.Fprintln(w, formatted)
+			continue
+		}
+		name := args[i].Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		fmt.Fprintf(w, "%s: %s\n", name, formatted)
+	}
+}
+
+// parseValue parses s into the Go value packing as typ. Addresses are hex or
+// names in the book, integers decimal or 0x prefixed hex, and bytes hex.
+// Arrays are JSON arrays and tuples JSON objects keyed by component name, or
+// arrays of them in order, with their elements given as JSON strings or numbers.
+func parseValue(typ abi.Type, s string, b book.Book) (reflect.Value, error) {
+	switch typ.T {
+	case abi.IntTy, abi.UintTy:
+		return parseInt(typ, s)
+	case abi.BoolTy:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, errors.Errorf("invalid bool %q", s)
+		}
+		return reflect.ValueOf(v), nil
+	case abi.StringTy:
+		return reflect.ValueOf(s), nil
+	case abi.AddressTy:
+		addr, err := b.Resolve(s)
+		return reflect.ValueOf(addr), err
+	case abi.BytesTy:
+		data, err := parseHex(s)
+		return reflect.ValueOf(data), err
+	case abi.FixedBytesTy:
+		data, err := parseHex(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(data) != typ.Size {
+			return reflect.Value{}, errors.Errorf("%s takes %d bytes, %d given", typ, typ.Size, len(data))
+		}
+		v := reflect.New(typ.Type).Elem()
+		reflect.Copy(v, reflect.ValueOf(data))
+		return v, nil
+	case abi.SliceTy, abi.ArrayTy:
+		var elems []json.RawMessage
+		if err := json.Unmarshal([]byte(s), &elems); err != nil {
+			return reflect.Value{}, errors.Errorf("%s takes a JSON array, got %q", typ, s)
+		}
+		v := reflect.New(typ.Type).Elem()
+		if typ.T == abi.SliceTy {
+			v = reflect.MakeSlice(typ.Type, len(elems), len(elems))
+		} else if len(elems) != typ.Size {
+			return reflect.Value{}, errors.Errorf("%s takes %d elements, %d given", typ, typ.Size, len(elems))
+		}
+		for i, elem := range elems {
+			value, err := parseValue(*typ.Elem, jsonString(elem), b)
+			if err != nil {
+				return reflect.Value{}, errors.Wrapf(err, "element %d", i)
+			}
+			v.Index(i).Set(value)
+		}
+		return v, nil
+	case abi.TupleTy:
+		return parseTuple(typ, s, b)
+	}
+	return reflect.Value{}, errors.Errorf("%s arguments are not supported", typ)
+}
+
+// parseInt parses a decimal or 0x prefixed hex integer, checking it fits typ
+func parseInt(typ abi.Type, s string) (reflect.Value, error) {
+	// the sign goes ahead of the 0x prefix
+	digits := strings.TrimPrefix(s, "-")
+	n, ok := new(big.Int), false
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		digits = digits[2:]
+		n, ok = n.SetString(digits, 16)
+	} else {
+		n, ok = n.SetString(digits, 10)
+	}
+	if !ok || strings.HasPrefix(digits, "-") {
+		return reflect.Value{}, errors.Errorf("invalid integer %q", s)
+	}
+	if strings.HasPrefix(s, "-") {
+		n.Neg(n)
+	}
+	min, max := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(typ.Size))
+	if typ.T == abi.IntTy {
+		max.Rsh(max, 1)
+		min.Neg(max)
+	}
+	if n.Cmp(min) < 0 || n.Cmp(max) >= 0 {
+		return reflect.Value{}, errors.Errorf("%s does not fit a %s", s, typ)
+	}
+	v := reflect.New(typ.Type).Elem()
+	switch typ.Type.Kind() {
+	case reflect.Ptr:
+		return reflect.ValueOf(n), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(n.Int64())
+	default:
+		v.SetUint(n.Uint64())
+	}
+	return v, nil
+}
+
+// parseTuple parses a JSON object keyed by component name, or a JSON array of
+// the components in order
+func parseTuple(typ abi.Type, s string, b book.Book) (reflect.Value, error) {
+	elems := make([]json.RawMessage, len(typ.TupleElems))
+	var keyed map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &keyed); err == nil {
+		for i, name := range typ.TupleRawNames {
+			elem, ok := keyed[name]
+			if !ok {
+				return reflect.Value{}, errors.Errorf("tuple is missing %s", name)
+			}
+			elems[i] = elem
+			delete(keyed, name)
+		}
+		if len(keyed) > 0 {
+			var unknown []string
+			for name := range keyed {
+				unknown = append(unknown, name)
+			}
+			sort.Strings(unknown)
+			return reflect.Value{}, errors.Errorf("tuple has no component %s", strings.Join(unknown, ", "))
+		}
+	} else {
+		var ordered []json.RawMessage
+		if err := json.Unmarshal([]byte(s), &ordered); err != nil {
+			return reflect.Value{}, errors.Errorf("%s takes a JSON object or array, got %q", typ, s)
+		}
+		if len(ordered) != len(elems) {
+			return reflect.Value{}, errors.Errorf("%s takes %d components, %d given", typ, len(elems), len(ordered))
+		}
+		elems = ordered
+	}
+	v := reflect.New(typ.Type).Elem()
+	for i, elem := range elems {
+		value, err := parseValue(*typ.TupleElems[i], jsonString(elem), b)
+		if err != nil {
+			return reflect.Value{}, errors.Wrapf(err, "component %s", typ.TupleRawNames[i])
+		}
+		v.Field(i).Set(value)
+	}
+	return v, nil
+}
+
+// jsonString unquotes JSON strings, leaving numbers, arrays and objects as is
+func jsonString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+// parseHex decodes hex, with or without a 0x prefix
+func parseHex(s string) ([]byte, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+	if err != nil {
+		return nil, errors.Errorf("invalid hex %q", s)
+	}
+	return data, nil
+}
+
+// formatOutput formats a value like formatValue, leaving strings, addresses
+// and bytes unquoted
+func formatOutput(typ abi.Type, v reflect.Value) string {
+	switch typ.T {
+	case abi.StringTy:
+		return v.String()
+	case abi.AddressTy, abi.BytesTy, abi.FixedBytesTy, abi.HashTy:
+		formatted, _ := strconv.Unquote(formatValue(typ, v))
+		return formatted
+	}
+	return formatValue(typ, v)
+}
+
+// formatValue formats a value as JSON, with integers in decimal, addresses
+// and bytes in hex, and tuples as objects keyed by component name
+func formatValue(typ abi.Type, v reflect.Value) string {
+	switch typ.T {
+	case abi.StringTy:
+		quoted, _ := json.Marshal(v.String())
+		return string(quoted)
+	case abi.AddressTy:
+		return strconv.Quote(v.Interface().(common.Address).Hex())
+	case abi.BytesTy:
+		return strconv.Quote(hexutil.Encode(v.Bytes()))
+	case abi.FixedBytesTy, abi.HashTy:
+		data := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(data), v)
+		return strconv.Quote(hexutil.Encode(data))
+	case abi.SliceTy, abi.ArrayTy:
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = formatValue(*typ.Elem, v.Index(i))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case abi.TupleTy:
+		fields := make([]string, len(typ.TupleElems))
+		for i, elem := range typ.TupleElems {
+			fields[i] = strconv.Quote(typ.TupleRawNames[i]) + ": " + formatValue(*elem, v.Field(i))
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,318 @@
+package call
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	auth "github.com/evan-forbes/buddy/auth"
+	"github.com/evan-forbes/buddy/book"
+	"github.com/evan-forbes/buddy/sim"
+	"github.com/evan-forbes/buddy/std/erc20"
+	"github.com/evan-forbes/buddy/std/erc721"
+	"github.com/evan-forbes/buddy/std/multicall"
+)
+
+func mustType(t *testing.T, typ string, components ...abi.ArgumentMarshaling) abi.Type {
+	t.Helper()
+	parsed, err := abi.NewType(typ, "", components)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestParseValue(t *testing.T) {
+	dai := common.HexToAddress("0xda1")
+	b := book.Book{"dai": dai}
+	call := []abi.ArgumentMarshaling{{Name: "target", Type: "address"}, {Name: "callData", Type: "bytes"}}
+	for _, test := range []struct {
+		typ   abi.Type
+		input string
+		want  interface{}
+	}{
+		{mustType(t, "uint8"), "255", uint8(255)},
+		{mustType(t, "int16"), "-32768", int16(-32768)},
+		{mustType(t, "uint256"), "0xff", big.NewInt(255)},
+		{mustType(t, "int256"), "-1", big.NewInt(-1)},
+		{mustType(t, "int64"), "-0x10", int64(-16)},
+		{mustType(t, "bool"), "true", true},
+		{mustType(t, "string"), "buddy", "buddy"},
+		{mustType(t, "address"), "dai", dai},
+		{mustType(t, "bytes"), "0xdead", []byte{0xde, 0xad}},
+		{mustType(t, "bytes4"), "a9059cbb", [4]byte{0xa9, 0x05, 0x9c, 0xbb}},
+		{mustType(t, "address[]"), `["dai", "0x0000000000000000000000000000000000000001"]`, []common.Address{dai, common.HexToAddress("0x1")}},
+		{mustType(t, "uint256[2]"), `[1, "0x2"]`, [2]*big.Int{big.NewInt(1), big.NewInt(2)}},
+		{mustType(t, "uint8[][]"), `[[1, 2], []]`, [][]uint8{{1, 2}, {}}},
+	} {
+		got, err := parseValue(test.typ, test.input, b)
+		if err != nil {
+			t.Errorf("parsing %s %q: %v", test.typ, test.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(got.Interface(), test.want) {
+			t.Errorf("parsing %s %q gave %#v, want %#v", test.typ, test.input, got.Interface(), test.want)
+		}
+	}
+
+	// tuples are keyed by component name or given in order
+	tuple := mustType(t, "tuple[]", call...)
+	for _, input := range []string{`[{"target": "dai", "callData": "0x01"}]`, `[["dai", "0x01"]]`} {
+		got, err := parseValue(tuple, input, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Len() != 1 || got.Index(0).Field(0).Interface() != dai || !bytes.Equal(got.Index(0).Field(1).Bytes(), []byte{1}) {
+			t.Errorf("parsing %q gave %#v", input, got.Interface())
+		}
+		if _, err := (abi.Arguments{{Type: tuple}}).Pack(got.Interface()); err != nil {
+			t.Errorf("packing %q: %v", input, err)
+		}
+	}
+
+	for _, test := range []struct {
+		typ   abi.Type
+		input string
+	}{
+		{mustType(t, "uint8"), "256"},
+		{mustType(t, "uint256"), "-1"},
+		{mustType(t, "int8"), "128"},
+		{mustType(t, "uint256"), "010x"},
+		{mustType(t, "int256"), "--1"},
+		{mustType(t, "int256"), "-0x-1"},
+		{mustType(t, "bool"), "yes"},
+		{mustType(t, "address"), "usdc"},
+		{mustType(t, "bytes4"), "0xa9059c"},
+		{mustType(t, "uint256[2]"), "[1]"},
+		{mustType(t, "uint256[]"), "1,2"},
+		{mustType(t, "tuple", call...), `{"target": "dai"}`},
+		{mustType(t, "tuple", call...), `{"target": "dai", "callData": "0x", "value": 1}`},
+	} {
+		if got, err := parseValue(test.typ, test.input, b); err == nil {
+			t.Errorf("parsing %s %q gave %#v, want an error", test.typ, test.input, got.Interface())
+		}
+	}
+}
+
+func TestFindMethod(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(erc721.ERC721ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name  string
+		nargs int
+		want  string
+	}{
+		{"ownerOf", 1, "ownerOf(uint256)"},
+		{"safeTransferFrom", 3, "safeTransferFrom(address,address,uint256)"},
+		{"safeTransferFrom", 4, "safeTransferFrom(address,address,uint256,bytes)"},
+		{"safeTransferFrom(address, address, uint256, bytes)", 4, "safeTransferFrom(address,address,uint256,bytes)"},
+	} {
+		if m, err := findMethod(parsed, test.name, test.nargs); err != nil || m.Sig() != test.want {
+			t.Errorf("found %s for %s with %d arguments, %v", m.Sig(), test.name, test.nargs, err)
+		}
+	}
+	if _, err := findMethod(parsed, "safeTransferFrom", 2); err == nil || !strings.Contains(err.Error(), "overloaded") {
+		t.Errorf("finding an ambiguous overload failed with %v", err)
+	}
+	if _, err := findMethod(parsed, "burn", 1); err == nil {
+		t.Error("found a missing method")
+	}
+}
+
+func TestCallSend(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployer := bind.NewKeyedTransactor(key)
+	backend := sim.NewSimulatedBackend(sim.WithMulticall(core.GenesisAlloc{deployer.From: {Balance: big.NewInt(1000000000000000000)}}), 10000000)
+	defer backend.Close()
+	token, _, _, err := erc20.DeployERC20(deployer, backend, "Buddy", "BUD", 18, big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	bob := common.HexToAddress("0xb0b")
+	b := book.Book{"token": token, "bob": bob}
+	transfer, err := newTarget(erc20.ERC20ABI, b, "token", "transfer", []string{"bob", "300"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := auth.NewAuth(backend, hex.EncodeToString(crypto.FromECDSA(key)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := transfer.send(opts, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	receipt, err := backend.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var printed bytes.Buffer
+	if err := transfer.printReceipt(&printed, receipt); err != nil {
+		t.Fatal(err)
+	}
+	want := "succeeded in block 2 using " + big.NewInt(int64(receipt.GasUsed)).String() + " gas\n" +
+		`Transfer(from: "` + deployer.From.Hex() + `", to: "` + bob.Hex() + "\", value: 300)\n"
+	if printed.String() != want {
+		t.Errorf("printed receipt\n%s\nwant\n%s", printed.String(), want)
+	}
+
+	// failed gas estimations report what the transaction reverts with
+	overdraw, err := newTarget(erc20.ERC20ABI, b, "token", "transfer", []string{"bob", "1000"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	estimated := *opts
+	estimated.Nonce, estimated.GasLimit = nil, 0
+	if _, err := overdraw.send(&estimated, backend); err == nil || err.Error() != "execution reverted: ERC20: transfer amount exceeds balance" {
+		t.Errorf("overdrawing failed with %v", err)
+	}
+
+	balance, err := newTarget(erc20.ERC20ABI, b, "token", "balanceOf", []string{"bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs, err := balance.call(&bind.CallOpts{}, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	printed.Reset()
+	printOutputs(&printed, balance.method.Outputs, outputs)
+	if printed.String() != "300\n" {
+		t.Errorf("printed balance %q, want 300", printed.String())
+	}
+
+	// composite outputs are printed as JSON
+	data, err := erc20.PackERC20Symbol()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := `[{"target": "token", "callData": "0x` + hex.EncodeToString(data) + `"}, ["bob", "0x"]]`
+	aggregate, err := newTarget(multicall.MulticallABI, b, multicall.Address.Hex(), "tryAggregate", []string{"false", calls})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if outputs, err = aggregate.call(&bind.CallOpts{}, backend); err != nil {
+		t.Fatal(err)
+	}
+	printed.Reset()
+	printOutputs(&printed, aggregate.method.Outputs, outputs)
+	symbol := "0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000003" +
+		"4255440000000000000000000000000000000000000000000000000000000000"
+	if want := `[{"success": true, "returnData": "0x` + symbol + `"}, {"success": true, "returnData": "0x"}]` + "\n"; printed.String() != want {
+		t.Errorf("printed results\n%s\nwant\n%s", printed.String(), want)
+	}
+
+	// several outputs are printed a line each
+	if aggregate, err = newTarget(multicall.MulticallABI, b, multicall.Address.Hex(), "aggregate", []string{calls}); err != nil {
+		t.Fatal(err)
+	}
+	if outputs, err = aggregate.call(&bind.CallOpts{}, backend); err != nil {
+		t.Fatal(err)
+	}
+	printed.Reset()
+	printOutputs(&printed, aggregate.method.Outputs, outputs)
+	if want := `blockNumber: 2` + "\n" + `returnData: ["0x` + symbol + `", "0x"]` + "\n"; printed.String() != want {
+		t.Errorf("printed aggregate\n%s\nwant\n%s", printed.String(), want)
+	}
+}
+
+func TestPrintReceipt(t *testing.T) {
+	const eventsABI = `[{"type":"function","name":"f","inputs":[],"outputs":[]},` +
+		`{"type":"event","name":"Tagged","inputs":[{"name":"label","type":"string","indexed":true},{"name":"blob","type":"bytes","indexed":true},{"name":"who","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},` +
+		`{"type":"event","name":"Unnamed","inputs":[{"name":"","type":"uint256","indexed":true},{"name":"","type":"uint256","indexed":false},{"name":"","type":"bool","indexed":false}]}]`
+	target, err := newTarget(eventsABI, book.Book{}, "0x0000000000000000000000000000000000000001", "f", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	label, blob := crypto.Keccak256Hash([]byte("buddy")), crypto.Keccak256Hash([]byte{0xde, 0xad})
+	who := common.HexToAddress("0xb0b")
+	value, err := (abi.Arguments{{Type: mustType(t, "uint256")}}).Pack(big.NewInt(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unnamed, err := (abi.Arguments{{Type: mustType(t, "uint256")}, {Type: mustType(t, "bool")}}).Pack(big.NewInt(2), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(1),
+		GasUsed:     21000,
+		Logs: []*types.Log{
+			{Address: target.address, Topics: []common.Hash{target.abi.Events["Tagged"].ID(), label, blob, common.BytesToHash(who.Bytes())}, Data: value},
+			{Address: target.address, Topics: []common.Hash{target.abi.Events["Unnamed"].ID(), common.BigToHash(big.NewInt(1))}, Data: unnamed},
+			// logs of other contracts are skipped
+			{Address: who, Topics: []common.Hash{target.abi.Events["Tagged"].ID()}},
+		},
+	}
+	var printed bytes.Buffer
+	if err := target.printReceipt(&printed, receipt); err != nil {
+		t.Fatal(err)
+	}
+	want := "succeeded in block 1 using 21000 gas\n" +
+		`Tagged(label: "` + label.Hex() + `", blob: "` + blob.Hex() + `", who: "` + who.Hex() + "\", value: 7)\n" +
+		"Unnamed(0: 1, 1: 2, 2: true)\n"
+	if printed.String() != want {
+		t.Errorf("printed receipt\n%s\nwant\n%s", printed.String(), want)
+	}
+}
+
+func TestRevert(t *testing.T) {
+	const errorsABI = `[{"type":"function","name":"f","inputs":[],"outputs":[]},` +
+		`{"type":"error","name":"InsufficientBalance","inputs":[{"name":"have","type":"uint256"},{"name":"","type":"address"}]}]`
+	target, err := newTarget(errorsABI, book.Book{}, "0x0000000000000000000000000000000000000001", "f", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := common.HexToAddress("0xb0b")
+	custom, err := (abi.Arguments{{Type: mustType(t, "uint256")}, {Type: mustType(t, "address")}}).Pack(big.NewInt(3), owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reason, err := (abi.Arguments{{Type: mustType(t, "string")}}).Pack("nope")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := (abi.Arguments{{Type: mustType(t, "uint256")}}).Pack(big.NewInt(0x11))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		data []byte
+		want string
+	}{
+		{append(crypto.Keccak256([]byte("InsufficientBalance(uint256,address)"))[:4], custom...), `execution reverted: InsufficientBalance(have: 3, 1: "` + owner.Hex() + `")`},
+		{append([]byte{0x08, 0xc3, 0x79, 0xa0}, reason...), "execution reverted: nope"},
+		{append([]byte{0x4e, 0x48, 0x7b, 0x71}, code...), "execution reverted: panic 0x11"},
+	} {
+		if err := target.unpackError(&sim.RevertError{Data: test.data}); err.Error() != test.want {
+			t.Errorf("decoded %x as %q, want %q", test.data, err, test.want)
+		}
+	}
+	// unknown selectors and other errors are left alone
+	if err := target.revert([]byte{1, 2, 3, 4}); err != nil {
+		t.Errorf("decoded an unknown selector as %v", err)
+	}
+	if err := target.unpackError(bind.ErrNoCode); err != bind.ErrNoCode {
+		t.Errorf("unexpected %v", err)
+	}
+}
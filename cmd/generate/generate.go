@@ -0,0 +1,387 @@
+package generate
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/evan-forbes/buddy/bind"
+	"github.com/evan-forbes/buddy/cmd/abigen"
+	"github.com/evan-forbes/buddy/cmd/solc"
+	"github.com/naoina/toml"
+	"github.com/pkg/errors"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// manifestNames are the manifests looked for in the working directory
+var manifestNames = []string{"buddy.toml", "buddy.json"}
+
+// Manifest declares the packages of bindings generated by buddy generate, and
+// the contract sources each of them is generated from. Paths are relative to
+// the manifest.
+type Manifest struct {
+	Packages []Package `json:"packages" toml:"packages"`
+}
+
+// Package is a package of bindings
+type Package struct {
+	Name      string            `json:"name" toml:"name"`           // Package name
+	Dir       string            `json:"dir" toml:"dir"`             // Output directory (default = the package name)
+	Mock      bool              `json:"mock" toml:"mock"`           // Also generate scriptable mocks
+	Tests     bool              `json:"tests" toml:"tests"`         // Also generate test skeletons for deployable contracts
+	Types     map[string]string `json:"types" toml:"types"`         // Contract name -> type name of the binding
+	Aliases   map[string]string `json:"aliases" toml:"aliases"`     // Method or event name -> alias
+	Libraries map[string]string `json:"libraries" toml:"libraries"` // Library placeholder or fully qualified name -> type
+	Conforms  []string          `json:"conforms" toml:"conforms"`   // Standards to check the contracts against, e.g. erc20
+	Contracts []ABISource       `json:"contracts" toml:"contracts"` // Contracts read from abi and bin files
+	Artifacts []ArtifactsSource `json:"artifacts" toml:"artifacts"` // Hardhat, Truffle or Foundry artifact directories
+	JSON      []JSONSource      `json:"json" toml:"json"`           // solc --combined-json or --standard-json output
+	Solc      []SolcSource      `json:"solc" toml:"solc"`           // Solidity sources compiled with a local solc
+}
+
+// ABISource is a contract read from an abi and an optional bin, along with the
+// NatSpec files solc -o writes next to them
+type ABISource struct {
+	Type  string `json:"type" toml:"type"` // Type name (default = the abi file name)
+	ABI   string `json:"abi" toml:"abi"`
+	Bin   string `json:"bin" toml:"bin"`
+	Iface string `json:"iface" toml:"iface"` // Generated interface name (default = TypeInterface)
+}
+
+// ArtifactsSource selects contracts out of an artifacts directory
+type ArtifactsSource struct {
+	Dir    string   `json:"dir" toml:"dir"`
+	Filter []string `json:"filter" toml:"filter"`
+}
+
+// JSONSource selects contracts out of the output of solc
+type JSONSource struct {
+	Path   string   `json:"path" toml:"path"`
+	Filter []string `json:"filter" toml:"filter"`
+}
+
+// SolcSource compiles Solidity sources, binding the contracts declared in them
+// unless filtered otherwise
+type SolcSource struct {
+	Sources    []string `json:"sources" toml:"sources"`
+	Remappings []string `json:"remappings" toml:"remappings"` // default = remappings.txt next to the manifest
+	Optimize   bool     `json:"optimize" toml:"optimize"`
+	Runs       int      `json:"runs" toml:"runs"` // default = 200
+	EVMVersion string   `json:"evm_version" toml:"evm_version"`
+	Solc       string   `json:"solc" toml:"solc"` // path to solc (default = solc)
+	Filter     []string `json:"filter" toml:"filter"`
+}
+
+// Cast runs the generate command, regenerating every package declared in the
+// manifest, or with --check, failing if the bindings on disk are stale
+func Cast(ctx *cli.Context) error {
+	path := ctx.String("manifest")
+	if path == "" && ctx.NArg() > 0 {
+		path = ctx.Args().First()
+	}
+	if path == "" {
+		var ok bool
+		if path, ok = findManifest("."); !ok {
+			return errors.Errorf("No manifest found. Add a %s or use flag --manifest", strings.Join(manifestNames, " or "))
+		}
+	}
+	manifest, err := LoadManifest(path)
+	if err != nil {
+		return err
+	}
+	base := filepath.Dir(path)
+	// the conformance of the contracts is only reported when regenerating
+	report := ctx.App.Writer
+	if ctx.Bool("check") {
+		report = ioutil.Discard
+	}
+	// packages may share a directory, so their files are written and checked
+	// together
+	generated := make(map[string]map[string]string)
+	var dirs []string
+	for _, pkg := range manifest.Packages {
+		files, err := pkg.Generate(base, report)
+		if err != nil {
+			return errors.Wrapf(err, "Could not generate package %s", pkg.Name)
+		}
+		dir := filepath.Join(base, pkg.dir())
+		if generated[dir] == nil {
+			generated[dir] = make(map[string]string)
+			dirs = append(dirs, dir)
+		}
+		for name, code := range files {
+			generated[dir][name] = code
+		}
+	}
+	var stale []string
+	for _, dir := range dirs {
+		leftovers, err := leftoverFiles(dir, generated[dir])
+		if err != nil {
+			return err
+		}
+		if !ctx.Bool("check") {
+			if err := abigen.WriteFiles(dir, generated[dir]); err != nil {
+				return err
+			}
+			for _, path := range leftovers {
+				if err := os.Remove(path); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		outdated, err := staleFiles(dir, generated[dir])
+		if err != nil {
+			return err
+		}
+		for _, path := range leftovers {
+			outdated = append(outdated, path+" (no longer generated)")
+		}
+		stale = append(stale, outdated...)
+	}
+	sort.Strings(stale)
+	if len(stale) > 0 {
+		return errors.Errorf("Generated bindings are stale, run buddy generate:\n\t%s", strings.Join(stale, "\n\t"))
+	}
+	return nil
+}
+
+// findManifest returns the manifest found in dir
+func findManifest(dir string) (string, bool) {
+	for _, name := range manifestNames {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// LoadManifest reads a manifest, written in TOML or JSON depending on its
+// extension
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not read manifest: %s", path)
+	}
+	manifest := new(Manifest)
+	switch filepath.Ext(path) {
+	case ".toml":
+		err = toml.Unmarshal(data, manifest)
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(manifest)
+	default:
+		return nil, errors.Errorf("Unknown manifest format %s, expected .toml or .json", path)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid manifest: %s", path)
+	}
+	if len(manifest.Packages) == 0 {
+		return nil, errors.Errorf("Manifest declares no packages: %s", path)
+	}
+	for i, pkg := range manifest.Packages {
+		if pkg.Name == "" {
+			return nil, errors.Errorf("Package %d of manifest %s has no name", i+1, path)
+		}
+	}
+	return manifest, nil
+}
+
+// Generate reads the contracts of the package, resolving paths relative to
+// base, and generates its files. How the contracts conform to the standards of
+// the package is reported to w.
+func (p *Package) Generate(base string, w io.Writer) (map[string]string, error) {
+	var (
+		contracts []bind.Contract
+		libs      = make(map[string]string)
+	)
+	// collect adds contracts read by a source, along with the libraries they may
+	// link against
+	collect := func(found []bind.Contract, linked map[string]string) {
+		contracts = append(contracts, found...)
+		for pattern, name := range linked {
+			libs[pattern] = name
+		}
+	}
+	for _, src := range p.Contracts {
+		if src.ABI == "" {
+			return nil, errors.New("Contract declared without an abi")
+		}
+		tp := src.Type
+		if tp == "" {
+			tp = strings.TrimSuffix(filepath.Base(src.ABI), filepath.Ext(src.ABI))
+		}
+		binPath := ""
+		if src.Bin != "" {
+			binPath = filepath.Join(base, src.Bin)
+		}
+		contract, err := abigen.ReadContract(tp, filepath.Join(base, src.ABI), binPath)
+		if err != nil {
+			return nil, err
+		}
+		contract.Interface = src.Iface
+		collect([]bind.Contract{contract}, nil)
+	}
+	for _, src := range p.Artifacts {
+		found, linked, err := bind.ReadArtifacts(filepath.Join(base, src.Dir), src.Filter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not read artifacts in %s", src.Dir)
+		}
+		collect(found, linked)
+	}
+	for _, src := range p.JSON {
+		data, err := ioutil.ReadFile(filepath.Join(base, src.Path))
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not read solc output: %s", src.Path)
+		}
+		found, linked, err := bind.ParseSolcOutput(data, src.Filter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not parse solc output: %s", src.Path)
+		}
+		collect(found, linked)
+	}
+	for _, src := range p.Solc {
+		found, linked, err := src.compile(base)
+		if err != nil {
+			return nil, err
+		}
+		collect(found, linked)
+	}
+	if len(contracts) == 0 {
+		return nil, errors.New("No contracts declared")
+	}
+	// rename contracts along with the libraries linked against them
+	for i := range contracts {
+		if tp, ok := p.Types[contracts[i].Type]; ok {
+			contracts[i].Type = tp
+		}
+	}
+	for pattern, name := range libs {
+		if tp, ok := p.Types[name]; ok {
+			libs[pattern] = tp
+		}
+	}
+	if err := abigen.Conform(w, contracts, p.Conforms); err != nil {
+		return nil, err
+	}
+	links := abigen.Libraries(p.Libraries)
+	for pattern, name := range libs {
+		if _, ok := links[pattern]; !ok {
+			links[pattern] = name
+		}
+	}
+	aliases := p.Aliases
+	if aliases == nil {
+		aliases = make(map[string]string)
+	}
+	return bind.BindPackage(bind.Options{
+		Package:   p.Name,
+		Contracts: contracts,
+		Aliases:   aliases,
+		Libraries: links,
+	}, p.Mock, p.Tests)
+}
+
+// dir returns the output directory of the package
+func (p *Package) dir() string {
+	if p.Dir == "" {
+		return p.Name
+	}
+	return p.Dir
+}
+
+// compile compiles the sources relative to base and reads the contracts out of
+// the output
+func (s *SolcSource) compile(base string) ([]bind.Contract, map[string]string, error) {
+	if len(s.Sources) == 0 {
+		return nil, nil, errors.New("solc declared without sources")
+	}
+	remappings := s.Remappings
+	if len(remappings) == 0 {
+		var err error
+		if remappings, err = solc.LoadRemappings(filepath.Join(base, "remappings.txt")); err != nil {
+			return nil, nil, err
+		}
+	}
+	runs := s.Runs
+	if runs == 0 {
+		runs = 200
+	}
+	output, err := solc.Compile(s.Solc, base, s.Sources, remappings, solc.Settings{
+		Optimize: s.Optimize,
+		Runs:     runs,
+		EVM:      s.EVMVersion,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	filters := s.Filter
+	if len(filters) == 0 {
+		filters = solc.SourceFilters(s.Sources)
+	}
+	contracts, libs, err := bind.ParseSolcOutput(output, filters)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "Could not read contracts compiled from %s", strings.Join(s.Sources, " "))
+	}
+	return contracts, libs, nil
+}
+
+// staleFiles lists the generated files that differ from the ones in dir. Test
+// skeletons are meant to be filled in, so they only need to exist.
+func staleFiles(dir string, files map[string]string) ([]string, error) {
+	var stale []string
+	for name, code := range files {
+		path := filepath.Join(dir, name)
+		existing, err := ioutil.ReadFile(path)
+		switch {
+		case os.IsNotExist(err):
+			stale = append(stale, path+" (missing)")
+		case err != nil:
+			return nil, err
+		case strings.HasSuffix(name, "_test.go"):
+		case string(existing) != code:
+			stale = append(stale, path)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// leftoverFiles lists the bindings in dir generated by buddy, like those of
+// contracts since dropped from the manifest, that are no longer generated
+func leftoverFiles(dir string, files map[string]string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var leftovers []string
+	for _, info := range infos {
+		name := info.Name()
+		if _, ok := files[name]; ok || info.IsDir() {
+			continue
+		}
+		if !strings.HasSuffix(name, "_gen.go") && !strings.HasSuffix(name, "_mock.go") {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		code, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		// other tools name their output alike
+		if strings.HasPrefix(string(code), bind.GeneratedHeader) {
+			leftovers = append(leftovers, path)
+		}
+	}
+	return leftovers, nil
+}
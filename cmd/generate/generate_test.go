@@ -0,0 +1,163 @@
+package generate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/evan-forbes/buddy/cmd/abigen"
+)
+
+const tokenABI = `[{"type":"function","name":"balanceOf","constant":true,"inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}]`
+
+const tomlManifest = `
+[[packages]]
+name = "token"
+dir = "gen/token"
+mock = true
+types = { Token = "Coin" }
+
+  [packages.aliases]
+  balanceOf = "balance"
+
+  [[packages.contracts]]
+  abi = "build/Token.abi"
+
+  [[packages.solc]]
+  sources = ["contracts/Vault.sol"]
+  evm_version = "istanbul"
+  filter = ["Vault"]
+`
+
+const jsonManifest = `{
+	"packages": [{
+		"name": "token",
+		"dir": "gen/token",
+		"mock": true,
+		"types": {"Token": "Coin"},
+		"aliases": {"balanceOf": "balance"},
+		"contracts": [{"abi": "build/Token.abi"}],
+		"solc": [{"sources": ["contracts/Vault.sol"], "evm_version": "istanbul", "filter": ["Vault"]}]
+	}]
+}`
+
+func TestLoadManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	fromTOML, err := LoadManifest(write("buddy.toml", tomlManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromJSON, err := LoadManifest(write("buddy.json", jsonManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fromTOML, fromJSON) {
+		t.Errorf("manifests differ:\ntoml %+v\njson %+v", fromTOML, fromJSON)
+	}
+	pkg := fromTOML.Packages[0]
+	if pkg.Name != "token" || !pkg.Mock || pkg.Aliases["balanceOf"] != "balance" || pkg.Solc[0].EVMVersion != "istanbul" {
+		t.Errorf("unexpected package %+v", pkg)
+	}
+	if path, ok := findManifest(dir); !ok || filepath.Base(path) != "buddy.toml" {
+		t.Errorf("expected buddy.toml to be found first, got %s", path)
+	}
+	// typos are rejected rather than silently ignored
+	if _, err := LoadManifest(write("typo.json", `{"packages":[{"name":"token","mocks":true}]}`)); err == nil {
+		t.Error("expected an error for an unknown json field")
+	}
+	if _, err := LoadManifest(write("typo.toml", "[[packages]]\nname = \"token\"\nmocks = true\n")); err == nil {
+		t.Error("expected an error for an unknown toml field")
+	}
+	if _, err := LoadManifest(write("empty.json", `{"packages":[{"dir":"token"}]}`)); err == nil {
+		t.Error("expected an error for a package without a name")
+	}
+}
+
+func TestGenerateCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(filepath.Join(dir, "build"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "build", "Token.abi"), []byte(tokenABI), 0644); err != nil {
+		t.Fatal(err)
+	}
+	pkg := Package{
+		Name:      "token",
+		Mock:      true,
+		Types:     map[string]string{"Token": "Coin"},
+		Contracts: []ABISource{{ABI: "build/Token.abi"}},
+	}
+	files, err := pkg.Generate(dir, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Coin_gen.go", "Coin_mock.go"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("%s was not generated, got %v", name, reflect.ValueOf(files).MapKeys())
+		}
+	}
+	out := filepath.Join(dir, pkg.dir())
+	if stale, err := staleFiles(out, files); err != nil || len(stale) != 2 {
+		t.Errorf("expected both files to be missing, got %v: %v", stale, err)
+	}
+	if err := abigen.WriteFiles(out, files); err != nil {
+		t.Fatal(err)
+	}
+	// regenerating from the same inputs gives the same files
+	again, err := pkg.Generate(dir, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stale, err := staleFiles(out, again); err != nil || len(stale) != 0 {
+		t.Errorf("expected fresh bindings, got %v: %v", stale, err)
+	}
+	pkg.Aliases = map[string]string{"balanceOf": "balance"}
+	changed, err := pkg.Generate(dir, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stale, err := staleFiles(out, changed); err != nil || len(stale) != 2 {
+		t.Errorf("expected both files to be stale after an alias was added, got %v: %v", stale, err)
+	}
+	// bindings of a renamed contract are left behind, but files of other tools
+	// and hand written ones aren't
+	for name, content := range map[string]string{
+		"coin.go":       "package token\n",
+		"kind_gen.go":   "// Code generated by \"stringer -type=Kind\"; DO NOT EDIT.\n\npackage token\n",
+		"Coin_test.go":  files["Coin_gen.go"],
+		"Coin_extra.go": files["Coin_gen.go"],
+	} {
+		if err := ioutil.WriteFile(filepath.Join(out, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pkg.Types = nil
+	renamed, err := pkg.Generate(dir, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{filepath.Join(out, "Coin_gen.go"), filepath.Join(out, "Coin_mock.go")}
+	if leftovers, err := leftoverFiles(out, renamed); err != nil || !reflect.DeepEqual(leftovers, want) {
+		t.Errorf("expected the old bindings to be left over, got %v: %v", leftovers, err)
+	}
+	if leftovers, err := leftoverFiles(filepath.Join(dir, "missing"), renamed); err != nil || len(leftovers) != 0 {
+		t.Errorf("expected nothing left over in a missing directory, got %v: %v", leftovers, err)
+	}
+}
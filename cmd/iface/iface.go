@@ -0,0 +1,171 @@
+package iface
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/evan-forbes/buddy/bind"
+	"github.com/evan-forbes/buddy/cmd/abigen"
+	"github.com/pkg/errors"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// Cast runs the iface command, writing the Go interface shared by the contracts
+// found in the paths given and reporting the members each of them lacks
+func Cast(ctx *cli.Context) error {
+	pkg := ctx.String("pkg")
+	if pkg == "" {
+		return errors.New("No package declared. Use flag --pkg or -p")
+	}
+	if ctx.NArg() == 0 {
+		return errors.New("No contracts given, e.g. buddy iface -p erc20 build/Dai.abi build/Usdc.abi ./weth")
+	}
+	name := ctx.String("iface")
+	if name == "" {
+		name = strings.ToUpper(pkg[:1]) + pkg[1:]
+	}
+	var contracts []bind.Contract
+	for _, path := range ctx.Args() {
+		found, err := readContracts(path)
+		if err != nil {
+			return err
+		}
+		contracts = append(contracts, found...)
+	}
+	aliases, err := abigen.ParsePairs("alias", ctx.StringSlice("alias"))
+	if err != nil {
+		return err
+	}
+	code, missing, err := bind.BindInterface(name, bind.Options{
+		Package:   pkg,
+		Contracts: contracts,
+		Aliases:   aliases,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Could not generate interface")
+	}
+	filename := ctx.String("out")
+	if filename == "" {
+		filename = name + "_gen.go"
+	}
+	if err := ioutil.WriteFile(filename, []byte(code), 0644); err != nil {
+		return err
+	}
+	report(ctx.App.Writer, contracts, missing)
+	return nil
+}
+
+// report prints the members each contract lacks
+func report(w io.Writer, contracts []bind.Contract, missing map[string][]string) {
+	for _, contract := range contracts {
+		members := missing[contract.Type]
+		if len(members) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "%s lacks:\n", contract.Type)
+		for _, member := range members {
+			fmt.Fprintf(w, "\t%s\n", member)
+		}
+	}
+}
+
+// readContracts reads an abi file, or the contracts found in a directory, either
+// as .abi files or as the bindings of a generated package
+func readContracts(path string) ([]bind.Contract, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not read contracts in %s", path)
+	}
+	if !info.IsDir() {
+		contract, err := abigen.ReadContract(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)), path, "")
+		if err != nil {
+			return nil, err
+		}
+		return []bind.Contract{contract}, nil
+	}
+	abis, err := filepath.Glob(filepath.Join(path, "*.abi"))
+	if err != nil {
+		return nil, err
+	}
+	var contracts []bind.Contract
+	for _, abiPath := range abis {
+		contract, err := abigen.ReadContract(strings.TrimSuffix(filepath.Base(abiPath), ".abi"), abiPath, "")
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, contract)
+	}
+	if len(contracts) > 0 {
+		return contracts, nil
+	}
+	contracts, err = readPackage(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(contracts) == 0 {
+		return nil, errors.Errorf("Could not find a .abi file or generated bindings in %s", path)
+	}
+	return contracts, nil
+}
+
+// readPackage reads the contracts of a package generated by buddy out of the
+// TypeABI constants declared next to the Type bindings
+func readPackage(dir string) ([]bind.Contract, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not parse package %s", dir)
+	}
+	var (
+		types = make(map[string]bool)
+		abis  = make(map[string]string)
+	)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						types[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						if gen.Tok != token.CONST || len(spec.Names) != 1 || len(spec.Values) != 1 {
+							continue
+						}
+						lit, ok := spec.Values[0].(*ast.BasicLit)
+						if !ok || lit.Kind != token.STRING || !strings.HasSuffix(spec.Names[0].Name, "ABI") {
+							continue
+						}
+						value, err := strconv.Unquote(lit.Value)
+						if err != nil {
+							return nil, errors.Wrapf(err, "Could not read %s in %s", spec.Names[0].Name, dir)
+						}
+						abis[strings.TrimSuffix(spec.Names[0].Name, "ABI")] = value
+					}
+				}
+			}
+		}
+	}
+	var contracts []bind.Contract
+	for tp, abi := range abis {
+		// skip the ABIs of errors and others not naming a binding
+		if types[tp] {
+			contracts = append(contracts, bind.Contract{Type: tp, ABI: abi})
+		}
+	}
+	sort.Slice(contracts, func(i, j int) bool { return contracts[i].Type < contracts[j].Type })
+	return contracts, nil
+}
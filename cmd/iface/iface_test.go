@@ -0,0 +1,41 @@
+package iface
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evan-forbes/buddy/bind"
+)
+
+const coinABI = `[{"constant":true,"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"type":"function"}]`
+
+func TestReadPackage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "iface")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	code, err := bind.Bind(bind.Options{Package: "coin", Contracts: []bind.Contract{{Type: "Coin", ABI: coinABI}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "Coin_gen.go"), []byte(code), 0644); err != nil {
+		t.Fatal(err)
+	}
+	contracts, err := readContracts(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contracts) != 1 || contracts[0].Type != "Coin" || contracts[0].ABI != coinABI {
+		t.Errorf("unexpected contracts %+v", contracts)
+	}
+	// abi files take precedence over the bindings generated from them
+	if err := ioutil.WriteFile(filepath.Join(dir, "Token.abi"), []byte(coinABI), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if contracts, err := readContracts(dir); err != nil || len(contracts) != 1 || contracts[0].Type != "Token" {
+		t.Errorf("unexpected contracts %+v: %v", contracts, err)
+	}
+}
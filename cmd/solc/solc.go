@@ -0,0 +1,185 @@
+package solc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/evan-forbes/buddy/bind"
+	"github.com/evan-forbes/buddy/cmd/abigen"
+	"github.com/pkg/errors"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// outputSelection is everything bind can use from the compiler
+var outputSelection = []string{
+	"abi",
+	"evm.bytecode.object",
+	"evm.bytecode.sourceMap",
+	"evm.deployedBytecode.object",
+	"evm.deployedBytecode.sourceMap",
+	"evm.methodIdentifiers",
+	"userdoc",
+	"devdoc",
+}
+
+// Cast runs the solc command, compiling Solidity sources with a local solc and
+// binding the contracts declared in them into a package
+func Cast(ctx *cli.Context) error {
+	if ctx.String("pkg") == "" {
+		return errors.New("No package declared. Use flag --pkg or -p")
+	}
+	sources := []string(ctx.Args())
+	if len(sources) == 0 {
+		return errors.New("No Solidity sources given, e.g. buddy solc -p token contracts/Token.sol")
+	}
+	remappings := ctx.StringSlice("remap")
+	if len(remappings) == 0 {
+		var err error
+		if remappings, err = LoadRemappings("remappings.txt"); err != nil {
+			return err
+		}
+	}
+	output, err := Compile(ctx.String("solc"), "", sources, remappings, Settings{
+		Optimize: ctx.Bool("optimize"),
+		Runs:     ctx.Int("optimize-runs"),
+		EVM:      ctx.String("evm-version"),
+	})
+	if err != nil {
+		return err
+	}
+	// only bind the contracts declared in the sources unless told otherwise
+	filters := ctx.StringSlice("filter")
+	if len(filters) == 0 {
+		filters = SourceFilters(sources)
+	}
+	contracts, libs, err := bind.ParseSolcOutput(output, filters)
+	if err != nil {
+		return errors.Wrap(err, "Could not read compiled contracts")
+	}
+	return abigen.Generate(ctx, contracts, libs)
+}
+
+// Settings are the compiler settings exposed as flags
+type Settings struct {
+	Optimize bool
+	Runs     int
+	EVM      string
+}
+
+// Compile compiles Solidity sources, given relative to dir, with solc and
+// returns its standard JSON output. Contracts are named after the source paths
+// as given, so their fully qualified names don't depend on the working directory.
+func Compile(solc, dir string, sources, remappings []string, opts Settings) ([]byte, error) {
+	input, err := standardInput(dir, sources, remappings, opts)
+	if err != nil {
+		return nil, err
+	}
+	return compile(solc, dir, input, allowedPaths(remappings))
+}
+
+// SourceFilters selects the contracts declared in the sources, leaving out the
+// ones they import
+func SourceFilters(sources []string) []string {
+	filters := make([]string, 0, len(sources))
+	for _, source := range sources {
+		filters = append(filters, filepath.ToSlash(filepath.Clean(source))+":*")
+	}
+	return filters
+}
+
+// standardInput describes the compilation of the sources, read relative to dir,
+// as solc standard JSON
+func standardInput(dir string, sources, remappings []string, opts Settings) ([]byte, error) {
+	type source struct {
+		Content string `json:"content"`
+	}
+	input := struct {
+		Language string            `json:"language"`
+		Sources  map[string]source `json:"sources"`
+		Settings struct {
+			Remappings []string `json:"remappings,omitempty"`
+			Optimizer  struct {
+				Enabled bool `json:"enabled"`
+				Runs    int  `json:"runs"`
+			} `json:"optimizer"`
+			EVMVersion      string                         `json:"evmVersion,omitempty"`
+			OutputSelection map[string]map[string][]string `json:"outputSelection"`
+		} `json:"settings"`
+	}{
+		Language: "Solidity",
+		Sources:  make(map[string]source),
+	}
+	for _, path := range sources {
+		content, err := ioutil.ReadFile(filepath.Join(dir, path))
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not read source file: %s", path)
+		}
+		input.Sources[filepath.ToSlash(filepath.Clean(path))] = source{Content: string(content)}
+	}
+	input.Settings.Remappings = remappings
+	input.Settings.Optimizer.Enabled = opts.Optimize
+	input.Settings.Optimizer.Runs = opts.Runs
+	input.Settings.EVMVersion = opts.EVM
+	input.Settings.OutputSelection = map[string]map[string][]string{
+		"*": {"*": outputSelection},
+	}
+	return json.Marshal(input)
+}
+
+// compile runs solc in dir on standard JSON input, allowing it to read the
+// imports found under the given paths
+func compile(solc, dir string, input []byte, allowed []string) ([]byte, error) {
+	if solc == "" {
+		solc = "solc"
+	}
+	cmd := exec.Command(solc, "--standard-json", "--allow-paths", strings.Join(allowed, ","))
+	cmd.Dir = dir
+	cmd.Stdin = bytes.NewReader(input)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not run %s: %s", solc, strings.TrimSpace(stderr.String()))
+	}
+	return output, nil
+}
+
+// allowedPaths returns the working directory along with the targets of the
+// remappings, which solc may read imports from
+func allowedPaths(remappings []string) []string {
+	allowed := []string{"."}
+	for _, remapping := range remappings {
+		target := remapping[strings.LastIndex(remapping, "=")+1:]
+		if target != "" {
+			allowed = append(allowed, target)
+		}
+	}
+	return allowed
+}
+
+// LoadRemappings reads prefix=target remappings, one per line, ignoring the
+// file if it doesn't exist
+func LoadRemappings(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not read remappings: %s", path)
+	}
+	defer file.Close()
+	var remappings []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			remappings = append(remappings, line)
+		}
+	}
+	return remappings, scanner.Err()
+}
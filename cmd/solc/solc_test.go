@@ -0,0 +1,110 @@
+package solc
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestStandardInput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "solc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	source := filepath.Join(dir, "Token.sol")
+	if err := ioutil.WriteFile(source, []byte("contract Token {}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	input, err := standardInput("", []string{source}, []string{"@oz/=lib/oz/"}, Settings{Optimize: true, Runs: 999, EVM: "istanbul"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Sources  map[string]struct{ Content string }
+		Settings struct {
+			Remappings []string
+			Optimizer  struct {
+				Enabled bool
+				Runs    int
+			}
+			EVMVersion      string `json:"evmVersion"`
+			OutputSelection map[string]map[string][]string
+		}
+	}
+	if err := json.Unmarshal(input, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Sources[filepath.ToSlash(source)].Content != "contract Token {}" {
+		t.Errorf("unexpected sources %v", decoded.Sources)
+	}
+	got := decoded.Settings
+	if !got.Optimizer.Enabled || got.Optimizer.Runs != 999 || got.EVMVersion != "istanbul" {
+		t.Errorf("unexpected settings %+v", got)
+	}
+	if !reflect.DeepEqual(got.Remappings, []string{"@oz/=lib/oz/"}) {
+		t.Errorf("unexpected remappings %v", got.Remappings)
+	}
+	if !reflect.DeepEqual(got.OutputSelection["*"]["*"], outputSelection) {
+		t.Errorf("unexpected output selection %v", got.OutputSelection)
+	}
+	if _, err := standardInput(dir, []string{"Missing.sol"}, nil, Settings{}); err == nil {
+		t.Error("expected an error for a missing source")
+	}
+}
+
+func TestCompile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake solc is a shell script")
+	}
+	dir, err := ioutil.TempDir("", "solc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// the fake solc checks its arguments and echoes its input back
+	fake := filepath.Join(dir, "solc")
+	script := "#!/bin/sh\n[ \"$1 $2 $3\" = \"--standard-json --allow-paths .,lib/oz/\" ] || { echo \"bad arguments: $*\" >&2; exit 1; }\ncat\n"
+	if err := ioutil.WriteFile(fake, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	output, err := compile(fake, "", []byte(`{"contracts":{}}`), allowedPaths([]string{"@oz/=lib/oz/"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(output) != `{"contracts":{}}` {
+		t.Errorf("unexpected output %s", output)
+	}
+	if _, err := compile(fake, "", nil, []string{"."}); err == nil {
+		t.Error("expected an error when solc fails")
+	}
+	if _, err := compile(filepath.Join(dir, "missing"), "", nil, []string{"."}); err == nil {
+		t.Error("expected an error for a missing solc")
+	}
+}
+
+func TestLoadRemappings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "solc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "remappings.txt")
+	if err := ioutil.WriteFile(path, []byte("# libraries\nds-test/=lib/ds-test/src/\n\n@oz/=lib/oz/\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	remappings, err := LoadRemappings(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(remappings, []string{"ds-test/=lib/ds-test/src/", "@oz/=lib/oz/"}) {
+		t.Errorf("unexpected remappings %v", remappings)
+	}
+	if remappings, err := LoadRemappings(filepath.Join(dir, "missing.txt")); err != nil || remappings != nil {
+		t.Errorf("a missing remappings file should be ignored, got %v, %v", remappings, err)
+	}
+}
@@ -7,6 +7,10 @@ import (
 	cli "gopkg.in/urfave/cli.v1"
 
 	"github.com/evan-forbes/buddy/cmd/abigen"
+	"github.com/evan-forbes/buddy/cmd/call"
+	"github.com/evan-forbes/buddy/cmd/generate"
+	"github.com/evan-forbes/buddy/cmd/iface"
+	"github.com/evan-forbes/buddy/cmd/solc"
 )
 
 // TODOs:
@@ -22,8 +26,56 @@ func main() {
 	app.EnableBashCompletion = true
 	app.Name = "buddy"
 
+	// bindFlags are the flags shared by the subcommands generating bindings
+	bindFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  "pkg, p",
+			Value: "",
+			Usage: "specify the package name",
+			// Destination: &tp,
+		},
+		cli.BoolFlag{
+			Name:  "mock, m",
+			Usage: "also generate a scriptable mock of the contract in a _mock.go file",
+		},
+		cli.BoolFlag{
+			Name:  "tests",
+			Usage: "also generate a _test.go skeleton deploying the contract onto a simulated backend (existing ones are kept)",
+		},
+		cli.StringSliceFlag{
+			Name:  "alias",
+			Value: &cli.StringSlice{},
+			Usage: "rename a method or event, e.g. --alias original=alias (repeatable)",
+		},
+		cli.StringSliceFlag{
+			Name:  "lib",
+			Value: &cli.StringSlice{},
+			Usage: "link a library, by placeholder or fully qualified name, e.g. --lib contracts/Math.sol:Math=Math (repeatable)",
+		},
+		cli.StringSliceFlag{
+			Name:  "fsig",
+			Value: &cli.StringSlice{},
+			Usage: "record a function signature, e.g. --fsig 'transfer(address,uint256)=a9059cbb' (repeatable)",
+		},
+		cli.StringSliceFlag{
+			Name:  "filter",
+			Value: &cli.StringSlice{},
+			Usage: "select the contracts to bind by name or source:Name glob, or exclude them with !glob, e.g. --filter 'ERC20*' --filter '!*Mock' (repeatable)",
+		},
+		cli.StringFlag{
+			Name:  "conforms",
+			Value: "",
+			Usage: "check the contracts against standards, e.g. --conforms erc20,erc721,erc1155, asserting the conforming bindings implement the std interfaces",
+		},
+		cli.StringFlag{
+			Name:  "dir, d",
+			Value: ".",
+			Usage: "output directory of generated packages (abigen --all, --json or --artifacts, and solc)",
+		},
+	}
+
 	// abiFlags are flags for the subcommand abigen
-	abiFlags := []cli.Flag{
+	abiFlags := append([]cli.Flag{
 		cli.StringFlag{
 			Name:  "abi, a",
 			Value: ".",
@@ -42,27 +94,229 @@ func main() {
 			Usage: "specify the main type",
 			// Destination: &tp,
 		},
+		cli.StringFlag{
+			Name:  "out, o",
+			Value: "",
+			Usage: "specify the output file name (default = type_gen.go",
+			// Destination: &tp,
+		},
+		cli.StringFlag{
+			Name:  "iface, i",
+			Value: "",
+			Usage: "specify the generated interface name (default = TypeInterface)",
+		},
+		cli.StringFlag{
+			Name:  "json, j",
+			Value: "",
+			Usage: "path to solc --combined-json or --standard-json output, binding every contract in it into a package",
+		},
+		cli.StringFlag{
+			Name:  "artifacts",
+			Value: "",
+			Usage: "path to a Hardhat (artifacts/), Truffle (build/contracts/) or Foundry (out/) artifacts directory, binding its contracts into a package",
+		},
+		cli.BoolFlag{
+			Name:  "all",
+			Usage: "bind every .abi file in the path into a package, one file per contract plus a shared structs file",
+		},
+		cli.StringFlag{
+			Name:  "userdoc",
+			Value: "",
+			Usage: "path to solc --userdoc output (default = .docuser file next to the abi)",
+		},
+		cli.StringFlag{
+			Name:  "devdoc",
+			Value: "",
+			Usage: "path to solc --devdoc output (default = .docdev file next to the abi)",
+		},
+		cli.StringFlag{
+			Name:  "manifest",
+			Value: "",
+			Usage: "path to a buddy.toml or buddy.json manifest, generating every package declared in it instead",
+		},
+	}, bindFlags...)
+
+	// solcFlags are flags for the subcommand solc
+	solcFlags := append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "solc",
+			Value: "solc",
+			Usage: "path to the solc binary",
+		},
+		cli.StringSliceFlag{
+			Name:  "remap",
+			Value: &cli.StringSlice{},
+			Usage: "remap imports, e.g. --remap @openzeppelin/=node_modules/@openzeppelin/ (repeatable, default = remappings.txt)",
+		},
+		cli.BoolFlag{
+			Name:  "optimize",
+			Usage: "enable the solc optimizer",
+		},
+		cli.IntFlag{
+			Name:  "optimize-runs",
+			Value: 200,
+			Usage: "number of runs the optimizer tunes the code for",
+		},
+		cli.StringFlag{
+			Name:  "evm-version",
+			Value: "",
+			Usage: "EVM version to compile for (default = the solc default)",
+		},
+	}, bindFlags...)
+
+	// generateFlags are flags for the subcommand generate
+	generateFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  "manifest",
+			Value: "",
+			Usage: "path to the manifest (default = buddy.toml or buddy.json)",
+		},
+		cli.BoolFlag{
+			Name:  "check",
+			Usage: "fail if the generated files on disk are stale instead of writing them",
+		},
+	}
+
+	// ifaceFlags are flags for the subcommand iface
+	ifaceFlags := []cli.Flag{
 		cli.StringFlag{
 			Name:  "pkg, p",
 			Value: "",
 			Usage: "specify the package name",
-			// Destination: &tp,
+		},
+		cli.StringFlag{
+			Name:  "iface, i",
+			Value: "",
+			Usage: "specify the interface name (default = the capitalised package name)",
 		},
 		cli.StringFlag{
 			Name:  "out, o",
 			Value: "",
-			Usage: "specify the output file name (default = type_gen.go",
-			// Destination: &tp,
+			Usage: "specify the output file name (default = the interface name followed by _gen.go)",
+		},
+		cli.StringSliceFlag{
+			Name:  "alias",
+			Value: &cli.StringSlice{},
+			Usage: "rename a method or event, e.g. --alias original=alias (repeatable)",
+		},
+	}
+
+	// callFlags are the flags shared by the subcommands call and send
+	callFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  "rpc",
+			Value: "http://localhost:8545",
+			Usage: "url of the node to connect to",
+		},
+		cli.StringFlag{
+			Name:  "abi, a",
+			Value: "",
+			Usage: "path to the abi of the contract",
+		},
+		cli.StringFlag{
+			Name:  "to",
+			Value: "",
+			Usage: "address of the contract, or its name in the address book",
+		},
+		cli.StringFlag{
+			Name:  "book",
+			Value: "",
+			Usage: "path to an address book, naming the addresses given to --to, --from and address arguments",
 		},
 	}
 
+	// sendFlags are flags for the subcommand send
+	sendFlags := append([]cli.Flag{
+		cli.StringFlag{
+			Name:   "key",
+			Value:  "",
+			Usage:  "hex private key signing the transaction",
+			EnvVar: "BUDDY_KEY",
+		},
+		cli.StringFlag{
+			Name:  "account",
+			Value: "",
+			Usage: "path to an encrypted keystore file signing the transaction",
+		},
+		cli.StringFlag{
+			Name:   "password",
+			Value:  "",
+			Usage:  "password of the keystore file",
+			EnvVar: "BUDDY_PASSWORD",
+		},
+		cli.StringFlag{
+			Name:  "value",
+			Value: "",
+			Usage: "amount of wei sent along with the transaction",
+		},
+		cli.Uint64Flag{
+			Name:  "gas",
+			Usage: "gas limit of the transaction (default = estimated)",
+		},
+		cli.BoolFlag{
+			Name:  "wait",
+			Usage: "wait for the transaction to be mined, printing its status and the events it logged",
+		},
+	}, callFlags...)
+
 	// subcommands
 	app.Commands = []cli.Command{
 		{
-			Name:   "abigen",
-			Usage:  "generate interface and mock friendly go bindings",
-			Action: abigen.Cast,
-			Flags:  abiFlags,
+			Name:  "abigen",
+			Usage: "generate interface and mock friendly go bindings",
+			Action: func(ctx *cli.Context) error {
+				if ctx.String("manifest") != "" {
+					return generate.Cast(ctx)
+				}
+				return abigen.Cast(ctx)
+			},
+			Flags: abiFlags,
+		},
+		{
+			Name:      "solc",
+			Usage:     "compile solidity sources with a local solc and generate go bindings for them",
+			ArgsUsage: "<source.sol>...",
+			Action:    solc.Cast,
+			Flags:     solcFlags,
+		},
+		{
+			Name:      "generate",
+			Usage:     "regenerate every package of bindings declared in a manifest",
+			ArgsUsage: "[manifest]",
+			Action:    generate.Cast,
+			Flags:     generateFlags,
+		},
+		{
+			Name:      "iface",
+			Usage:     "generate the go interface shared by several contracts and report the members each lacks",
+			ArgsUsage: "<contract.abi | abi dir | bindings package>...",
+			Action:    iface.Cast,
+			Flags:     ifaceFlags,
+		},
+		{
+			Name:      "call",
+			Usage:     "call a contract method from its abi and print what it returns",
+			ArgsUsage: "<method | signature> [args]...",
+			Action:    call.Cast,
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  "from",
+					Value: "",
+					Usage: "address, or name in the address book, making the call",
+				},
+				cli.StringFlag{
+					Name:  "block",
+					Value: "",
+					Usage: "number of the block to make the call at (default = latest)",
+				},
+			}, callFlags...),
+		},
+		{
+			Name:      "send",
+			Usage:     "sign and send a transaction invoking a contract method from its abi",
+			ArgsUsage: "<method | signature> [args]...",
+			Action:    call.Send,
+			Flags:     sendFlags,
 		},
 	}
 
@@ -71,17 +325,3 @@ func main() {
 		log.Fatal(err)
 	}
 }
-
-/* TODO:
-
-finish event and unpackers
-save to file
-finish cli tool
-
-	ctx := wand.NewDefaultContext(context.Background())
-	spells := map[string]wand.Spell{
-		"abigen": &abigen.Spell{},
-		"solc":   &solc.Spell{},
-	}
-	wand.Run(ctx, spells, os.Args[1:])
-*/
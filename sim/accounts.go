@@ -81,11 +81,17 @@ func NewAccount(name string, bal *big.Int) (*Account, error) {
 // Accounts connects simple names (or any string) to a transactor
 type Accounts map[string]*Account
 
-// NewAccounts generates private keys and author accounts for testing
+// defaultBalance is the 100 ETH given to test accounts without a balance set
+func defaultBalance() *big.Int {
+	return new(big.Int).Mul(big.NewInt(100), big.NewInt(1000000000000000000))
+}
+
+// NewAccounts generates private keys and author accounts for testing, each
+// holding 100 ETH
 func NewAccounts(names ...string) Accounts {
 	out := make(map[string]*Account)
 	for _, name := range names {
-		acc, err := NewAccount(name, new(big.Int))
+		acc, err := NewAccount(name, defaultBalance())
 		if err != nil {
 			return nil
 		}
@@ -94,11 +100,16 @@ func NewAccounts(names ...string) Accounts {
 	return Accounts(out)
 }
 
-// Genesis converts transactors to Genesis Accounts with 100 ETH allocations
+// Genesis converts transactors to Genesis Accounts, allocating 100 ETH to those
+// with a nil balance. An explicit zero balance stays zero.
 func (ta Accounts) Genesis() core.GenesisAlloc {
 	out := make(core.GenesisAlloc)
 	for _, acc := range ta {
-		out[acc.TxOpts.From] = core.GenesisAccount{Balance: acc.Balance}
+		balance := acc.Balance
+		if balance == nil {
+			balance = defaultBalance()
+		}
+		out[acc.TxOpts.From] = core.GenesisAccount{Balance: balance}
 	}
 	return out
 }
@@ -17,6 +17,7 @@
 package sim
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -28,8 +29,10 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
@@ -57,6 +60,37 @@ var (
 	errGasEstimationFailed     = errors.New("gas required exceeds allowance or always failing transaction")
 )
 
+// RevertError is returned by calls and gas estimations that fail, carrying the
+// revert data so the reason or custom error behind the failure can be decoded.
+type RevertError struct {
+	Data []byte // Raw revert data, empty if execution failed without reverting
+}
+
+func (e *RevertError) Error() string {
+	if reason, ok := revertReason(e.Data); ok {
+		return "execution reverted: " + reason
+	}
+	return "execution reverted"
+}
+
+// ErrorData returns the revert data hex encoded, the way nodes report it over RPC.
+func (e *RevertError) ErrorData() interface{} {
+	return hexutil.Encode(e.Data)
+}
+
+// revertReason unpacks the reason string of an Error(string) revert.
+func revertReason(data []byte) (string, bool) {
+	if len(data) < 4 || !bytes.Equal(data[:4], []byte{0x08, 0xc3, 0x79, 0xa0}) {
+		return "", false
+	}
+	typ, _ := abi.NewType("string", "", nil)
+	values, err := (abi.Arguments{{Type: typ}}).UnpackValues(data[4:])
+	if err != nil {
+		return "", false
+	}
+	return values[0].(string), true
+}
+
 // SimulatedBackend implements bind.ContractBackend, simulating a blockchain in
 // the background. Its main purpose is to allow easily testing contract bindings.
 // Simulated backend implements the following interfaces:
@@ -406,7 +440,8 @@ func (b *SimulatedBackend) PendingCodeAt(ctx context.Context, contract common.Ad
 	return b.pendingState.GetCode(contract), nil
 }
 
-// CallContract executes a contract call.
+// CallContract executes a contract call. A call that reverts returns a *RevertError
+// carrying the revert data instead of returning the data as output.
 func (b *SimulatedBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -418,17 +453,24 @@ func (b *SimulatedBackend) CallContract(ctx context.Context, call ethereum.CallM
 	if err != nil {
 		return nil, err
 	}
-	rval, _, _, err := b.callContract(ctx, call, b.blockchain.CurrentBlock(), state)
+	rval, _, failed, err := b.callContract(ctx, call, b.blockchain.CurrentBlock(), state)
+	if err == nil && failed {
+		return nil, &RevertError{Data: rval}
+	}
 	return rval, err
 }
 
-// PendingCallContract executes a contract call on the pending state.
+// PendingCallContract executes a contract call on the pending state. A call that reverts returns a *RevertError
+// carrying the revert data instead of returning the data as output.
 func (b *SimulatedBackend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 	defer b.pendingState.RevertToSnapshot(b.pendingState.Snapshot())
 
-	rval, _, _, err := b.callContract(ctx, call, b.pendingBlock, b.pendingState)
+	rval, _, failed, err := b.callContract(ctx, call, b.pendingBlock, b.pendingState)
+	if err == nil && failed {
+		return nil, &RevertError{Data: rval}
+	}
 	return rval, err
 }
 
@@ -467,22 +509,23 @@ func (b *SimulatedBackend) EstimateGas(ctx context.Context, call ethereum.CallMs
 	cap = hi
 
 	// Create a helper to check if a gas allowance results in an executable transaction
-	executable := func(gas uint64) bool {
+	// returning the revert data of a failed execution
+	executable := func(gas uint64) (bool, []byte) {
 		call.Gas = gas
 
 		snapshot := b.pendingState.Snapshot()
-		_, _, failed, err := b.callContract(ctx, call, b.pendingBlock, b.pendingState)
+		rval, _, failed, err := b.callContract(ctx, call, b.pendingBlock, b.pendingState)
 		b.pendingState.RevertToSnapshot(snapshot)
 
 		if err != nil || failed {
-			return false
+			return false, rval
 		}
-		return true
+		return true, nil
 	}
 	// Execute the binary search and hone in on an executable gas limit
 	for lo+1 < hi {
 		mid := (hi + lo) / 2
-		if !executable(mid) {
+		if ok, _ := executable(mid); !ok {
 			lo = mid
 		} else {
 			hi = mid
@@ -490,7 +533,11 @@ func (b *SimulatedBackend) EstimateGas(ctx context.Context, call ethereum.CallMs
 	}
 	// Reject the transaction as invalid if it still fails at the highest allowance
 	if hi == cap {
-		if !executable(hi) {
+		if ok, data := executable(hi); !ok {
+			// surface the revert data of transactions that always fail
+			if len(data) > 0 {
+				return 0, &RevertError{Data: data}
+			}
 			return 0, errGasEstimationFailed
 		}
 	}
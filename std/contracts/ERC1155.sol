@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./interfaces/IERC1155.sol";
+import "./utils/ERC165.sol";
+import "./utils/Ownable.sol";
+
+/// @title ERC1155
+/// @notice A multi token whose tokens are minted by its owner.
+/// @dev Follows the OpenZeppelin Contracts 4.9 implementation and its revert
+/// reasons.
+contract ERC1155 is Context, ERC165, IERC1155, IERC1155MetadataURI, Ownable {
+    mapping(uint256 => mapping(address => uint256)) private _balances;
+    mapping(address => mapping(address => bool)) private _operatorApprovals;
+
+    string private _uri;
+
+    /// @notice Sets the URI of every token, in which clients substitute {id}
+    /// with the hexadecimal token id.
+    constructor(string memory uri_) {
+        _uri = uri_;
+    }
+
+    /// @notice Returns whether the contract implements interfaceId.
+    function supportsInterface(bytes4 interfaceId) public view virtual override(ERC165, IERC165) returns (bool) {
+        return
+            interfaceId == type(IERC1155).interfaceId ||
+            interfaceId == type(IERC1155MetadataURI).interfaceId ||
+            super.supportsInterface(interfaceId);
+    }
+
+    /// @notice Returns the URI of every token.
+    function uri(uint256) public view virtual override returns (string memory) {
+        return _uri;
+    }
+
+    /// @notice Returns the amount of tokens of id owned by account.
+    function balanceOf(address account, uint256 id) public view virtual override returns (uint256) {
+        require(account != address(0), "ERC1155: address zero is not a valid owner");
+        return _balances[id][account];
+    }
+
+    /// @notice Returns the balances of accounts in the tokens of ids.
+    function balanceOfBatch(address[] memory accounts, uint256[] memory ids) public view virtual override returns (uint256[] memory) {
+        require(accounts.length == ids.length, "ERC1155: accounts and ids length mismatch");
+
+        uint256[] memory batchBalances = new uint256[](accounts.length);
+        for (uint256 i = 0; i < accounts.length; ++i) {
+            batchBalances[i] = balanceOf(accounts[i], ids[i]);
+        }
+        return batchBalances;
+    }
+
+    /// @notice Approves or removes operator as an operator for the caller.
+    function setApprovalForAll(address operator, bool approved) public virtual override {
+        _setApprovalForAll(_msgSender(), operator, approved);
+    }
+
+    /// @notice Returns whether operator is allowed to manage all of the tokens
+    /// of account.
+    function isApprovedForAll(address account, address operator) public view virtual override returns (bool) {
+        return _operatorApprovals[account][operator];
+    }
+
+    /// @notice Mints amount tokens of id to to, checking a contract receiving
+    /// them implements onERC1155Received. Only the owner can mint.
+    function mint(address to, uint256 id, uint256 amount, bytes memory data) public virtual onlyOwner {
+        _mint(to, id, amount, data);
+    }
+
+    /// @notice Transfers amount tokens of id, checking a contract receiving
+    /// them implements onERC1155Received.
+    function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes memory data) public virtual override {
+        require(
+            from == _msgSender() || isApprovedForAll(from, _msgSender()),
+            "ERC1155: caller is not token owner or approved"
+        );
+        _safeTransferFrom(from, to, id, amount, data);
+    }
+
+    /// @notice Transfers amounts tokens of ids, checking a contract receiving
+    /// them implements onERC1155BatchReceived.
+    function safeBatchTransferFrom(address from, address to, uint256[] memory ids, uint256[] memory amounts, bytes memory data) public virtual override {
+        require(
+            from == _msgSender() || isApprovedForAll(from, _msgSender()),
+            "ERC1155: caller is not token owner or approved"
+        );
+        _safeBatchTransferFrom(from, to, ids, amounts, data);
+    }
+
+    function _safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes memory data) internal virtual {
+        require(to != address(0), "ERC1155: transfer to the zero address");
+
+        address operator = _msgSender();
+        uint256 fromBalance = _balances[id][from];
+        require(fromBalance >= amount, "ERC1155: insufficient balance for transfer");
+        unchecked {
+            _balances[id][from] = fromBalance - amount;
+        }
+        _balances[id][to] += amount;
+
+        emit TransferSingle(operator, from, to, id, amount);
+        _doSafeTransferAcceptanceCheck(operator, from, to, id, amount, data);
+    }
+
+    function _safeBatchTransferFrom(address from, address to, uint256[] memory ids, uint256[] memory amounts, bytes memory data) internal virtual {
+        require(ids.length == amounts.length, "ERC1155: ids and amounts length mismatch");
+        require(to != address(0), "ERC1155: transfer to the zero address");
+
+        address operator = _msgSender();
+        for (uint256 i = 0; i < ids.length; ++i) {
+            uint256 id = ids[i];
+            uint256 amount = amounts[i];
+
+            uint256 fromBalance = _balances[id][from];
+            require(fromBalance >= amount, "ERC1155: insufficient balance for transfer");
+            unchecked {
+                _balances[id][from] = fromBalance - amount;
+            }
+            _balances[id][to] += amount;
+        }
+
+        emit TransferBatch(operator, from, to, ids, amounts);
+        _doSafeBatchTransferAcceptanceCheck(operator, from, to, ids, amounts, data);
+    }
+
+    function _mint(address to, uint256 id, uint256 amount, bytes memory data) internal virtual {
+        require(to != address(0), "ERC1155: mint to the zero address");
+
+        address operator = _msgSender();
+        _balances[id][to] += amount;
+        emit TransferSingle(operator, address(0), to, id, amount);
+        _doSafeTransferAcceptanceCheck(operator, address(0), to, id, amount, data);
+    }
+
+    function _setApprovalForAll(address owner, address operator, bool approved) internal virtual {
+        require(owner != operator, "ERC1155: setting approval status for self");
+        _operatorApprovals[owner][operator] = approved;
+        emit ApprovalForAll(owner, operator, approved);
+    }
+
+    function _doSafeTransferAcceptanceCheck(address operator, address from, address to, uint256 id, uint256 amount, bytes memory data) private {
+        if (to.code.length == 0) {
+            return;
+        }
+        try IERC1155Receiver(to).onERC1155Received(operator, from, id, amount, data) returns (bytes4 response) {
+            if (response != IERC1155Receiver.onERC1155Received.selector) {
+                revert("ERC1155: ERC1155Receiver rejected tokens");
+            }
+        } catch Error(string memory reason) {
+            revert(reason);
+        } catch {
+            revert("ERC1155: transfer to non-ERC1155Receiver implementer");
+        }
+    }
+
+    function _doSafeBatchTransferAcceptanceCheck(address operator, address from, address to, uint256[] memory ids, uint256[] memory amounts, bytes memory data) private {
+        if (to.code.length == 0) {
+            return;
+        }
+        try IERC1155Receiver(to).onERC1155BatchReceived(operator, from, ids, amounts, data) returns (bytes4 response) {
+            if (response != IERC1155Receiver.onERC1155BatchReceived.selector) {
+                revert("ERC1155: ERC1155Receiver rejected tokens");
+            }
+        } catch Error(string memory reason) {
+            revert(reason);
+        } catch {
+            revert("ERC1155: transfer to non-ERC1155Receiver implementer");
+        }
+    }
+}
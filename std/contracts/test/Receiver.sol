@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "../interfaces/IERC721.sol";
+import "../interfaces/IERC1155.sol";
+
+/// @title Receiver
+/// @notice Accepts every ERC721 and ERC1155 token, logging the arguments of the
+/// hooks it is called with. Used by the tests of the std bindings.
+contract Receiver is IERC721Receiver, IERC1155Receiver {
+    event ERC721Received(address operator, address from, uint256 tokenId, bytes data);
+    event ERC1155Received(address operator, address from, uint256 id, uint256 value, bytes data);
+    event ERC1155BatchReceived(address operator, address from, uint256[] ids, uint256[] values, bytes data);
+
+    function supportsInterface(bytes4 interfaceId) external pure override returns (bool) {
+        return interfaceId == type(IERC165).interfaceId || interfaceId == type(IERC1155Receiver).interfaceId;
+    }
+
+    function onERC721Received(address operator, address from, uint256 tokenId, bytes calldata data) external override returns (bytes4) {
+        emit ERC721Received(operator, from, tokenId, data);
+        return this.onERC721Received.selector;
+    }
+
+    function onERC1155Received(address operator, address from, uint256 id, uint256 value, bytes calldata data) external override returns (bytes4) {
+        emit ERC1155Received(operator, from, id, value, data);
+        return this.onERC1155Received.selector;
+    }
+
+    function onERC1155BatchReceived(address operator, address from, uint256[] calldata ids, uint256[] calldata values, bytes calldata data) external override returns (bytes4) {
+        emit ERC1155BatchReceived(operator, from, ids, values, data);
+        return this.onERC1155BatchReceived.selector;
+    }
+}
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "../interfaces/IERC165.sol";
+
+/// @dev Implementation of ERC165, extended by overriding supportsInterface.
+abstract contract ERC165 is IERC165 {
+    /// @notice Returns whether the contract implements interfaceId.
+    function supportsInterface(bytes4 interfaceId) public view virtual override returns (bool) {
+        return interfaceId == type(IERC165).interfaceId;
+    }
+}
@@ -0,0 +1,2549 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package erc1155
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/evan-forbes/buddy/std"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = context.Background
+	_ = errors.New
+	_ = json.Marshal
+	_ = fmt.Errorf
+	_ = big.NewInt
+	_ = reflect.ValueOf
+	_ = strings.NewReader
+	_ = sync.NewCond
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = hexutil.Encode
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ERC1155 is a wrapper around BoundContract, enforcing type checking and including
+// QoL helper methods
+//
+// Title: ERC1155
+//
+// A multi token whose tokens are minted by its owner.
+//
+// Follows the OpenZeppelin Contracts 4.9 implementation and its revert reasons.
+type ERC1155 struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+	abi      abi.ABI             // Parsed ABI, used to replay failed transactions
+	address  common.Address      // Address the contract is deployed at
+	backend  bind.ContractBackend
+}
+
+// NewERC1155 creates a new instance of ERC1155, bound to a specific deployed contract.
+func NewERC1155(address common.Address, backend bind.ContractBackend) (*ERC1155, error) {
+	a, err := parseERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(address, a, backend, backend, backend)
+	return &ERC1155{contract: contract, abi: a, address: address, backend: backend}, nil
+}
+
+// parseERC1155ABI parses ERC1155ABI, keying overloaded methods by their full
+// signature so that every binding calls the exact overload it was generated for.
+func parseERC1155ABI() (abi.ABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(ERC1155ABI))
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	overloads := make(map[string]int)
+	for _, method := range parsed.Methods {
+		overloads[method.RawName]++
+	}
+	methods := make(map[string]abi.Method, len(parsed.Methods))
+	for name, method := range parsed.Methods {
+		if overloads[method.RawName] > 1 {
+			name = method.Sig()
+		}
+		methods[name] = method
+	}
+	parsed.Methods = methods
+	return parsed, nil
+}
+
+// bound exposes the underlying BoundContract used for the low level calls
+func (_ERC1155 *ERC1155) bound() *bind.BoundContract {
+	return _ERC1155.contract
+}
+
+// transact invokes method. Should that fail while estimating gas, the invocation
+// is replayed as a call to recover the revert data behind the failure.
+func (_ERC1155 *ERC1155) transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	tx, err := _ERC1155.contract.Transact(opts, method, params...)
+	if err == nil || opts.GasLimit != 0 {
+		return tx, err
+	}
+	msg, perr := _ERC1155.message(opts, method, params...)
+	if perr != nil {
+		return nil, err
+	}
+	if _, cerr := _ERC1155.backend.CallContract(contextOfERC1155(opts), msg, nil); cerr != nil {
+		if decoded := unpackERC1155Error(cerr); decoded != cerr {
+			return nil, decoded
+		}
+	}
+	return nil, err
+}
+
+// estimate estimates the gas needed to invoke method with the given options.
+func (_ERC1155 *ERC1155) estimate(opts *bind.TransactOpts, method string, params ...interface{}) (uint64, error) {
+	msg, err := _ERC1155.message(opts, method, params...)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := _ERC1155.backend.EstimateGas(contextOfERC1155(opts), msg)
+	return gas, unpackERC1155Error(err)
+}
+
+// simulate runs method as an eth_call from opts.From against the pending state,
+// unpacking its outputs into out, if any.
+func (_ERC1155 *ERC1155) simulate(opts *bind.TransactOpts, out interface{}, method string, params ...interface{}) error {
+	msg, err := _ERC1155.message(opts, method, params...)
+	if err != nil {
+		return err
+	}
+	var output []byte
+	if pending, ok := _ERC1155.backend.(bind.PendingContractCaller); ok {
+		output, err = pending.PendingCallContract(contextOfERC1155(opts), msg)
+	} else {
+		output, err = _ERC1155.backend.CallContract(contextOfERC1155(opts), msg, nil)
+	}
+	if err != nil {
+		return unpackERC1155Error(err)
+	}
+	if out == nil {
+		return nil
+	}
+	return _ERC1155.abi.Unpack(out, method, output)
+}
+
+// message packs an invocation of method into a call message sent with opts.
+func (_ERC1155 *ERC1155) message(opts *bind.TransactOpts, method string, params ...interface{}) (ethereum.CallMsg, error) {
+	if opts == nil {
+		return ethereum.CallMsg{}, errors.New("no transact options to invoke ERC1155." + method + " with")
+	}
+	input, err := _ERC1155.abi.Pack(method, params...)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	return ethereum.CallMsg{
+		From:     opts.From,
+		To:       &_ERC1155.address,
+		Gas:      opts.GasLimit,
+		GasPrice: opts.GasPrice,
+		Value:    opts.Value,
+		Data:     input,
+	}, nil
+}
+
+// contextOfERC1155 returns the context of opts, defaulting to the background one.
+func contextOfERC1155(opts *bind.TransactOpts) context.Context {
+	if opts.Context == nil {
+		return context.Background()
+	}
+	return opts.Context
+}
+
+//////////////////////////////////////////////////////
+//		Interface
+////////////////////////////////////////////////////
+
+// ERC1155Interface lists every call, transaction and log unpacker of ERC1155.
+// It is regenerated along with the binding, so it can't drift from the ABI.
+type ERC1155Interface interface {
+	BalanceOf(opts *bind.CallOpts, account common.Address, id *big.Int) (*big.Int, error)
+	BalanceOfBatch(opts *bind.CallOpts, accounts []common.Address, ids []*big.Int) ([]*big.Int, error)
+	IsApprovedForAll(opts *bind.CallOpts, account common.Address, operator common.Address) (bool, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error)
+	Uri(opts *bind.CallOpts, arg0 *big.Int) (string, error)
+
+	Mint(opts *bind.TransactOpts, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error)
+	SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error)
+	SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+
+	UnpackApprovalForAllLog(log types.Log) (*ApprovalForAllLog, error)
+	UnpackOwnershipTransferredLog(log types.Log) (*OwnershipTransferredLog, error)
+	UnpackTransferBatchLog(log types.Log) (*TransferBatchLog, error)
+	UnpackTransferSingleLog(log types.Log) (*TransferSingleLog, error)
+	UnpackURILog(log types.Log) (*URILog, error)
+}
+
+// This nil assignment ensures at compile time that ERC1155 implements ERC1155Interface.
+var _ ERC1155Interface = (*ERC1155)(nil)
+
+// This nil assignment ensures at compile time that ERC1155 implements std.ERC1155, the
+// standard its ABI conforms to.
+var _ std.ERC1155 = (*ERC1155)(nil)
+
+//////////////////////////////////////////////////////
+//		Deployment
+////////////////////////////////////////////////////
+
+// DeployERC1155 deploys a new Ethereum contract, binding an instance of ERC1155 to it.
+//
+// Sets the URI of every token, in which clients substitute {id} with the hexadecimal token id.
+func DeployERC1155(auth *bind.TransactOpts, backend bind.ContractBackend, uri_ string) (common.Address, *types.Transaction, *ERC1155, error) {
+	parsed, err := parseERC1155ABI()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(ERC1155Bin), backend, uri_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC1155{contract: contract, abi: parsed, address: address, backend: backend}, nil
+}
+
+// ErrERC1155CodeMismatch is returned by NewERC1155Verified when the code deployed
+// at an address isn't the one of ERC1155.
+var ErrERC1155CodeMismatch = errors.New("code does not match ERC1155Bin")
+
+// NewERC1155Verified binds an instance of ERC1155, after checking that the code
+// deployed at address is the runtime code of ERC1155Bin.
+func NewERC1155Verified(ctx context.Context, address common.Address, backend bind.ContractBackend) (*ERC1155, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w: %s", bind.ErrNoCode, address.Hex())
+	}
+	if !matchERC1155Code(code) {
+		return nil, fmt.Errorf("%w: %s", ErrERC1155CodeMismatch, address.Hex())
+	}
+	return NewERC1155(address, backend)
+}
+
+// matchERC1155Code reports whether code is the runtime code embedded in ERC1155Bin.
+// The metadata hash is ignored, as are the zeroed regions the bytecode reserves
+// for immutables and linked library addresses.
+func matchERC1155Code(code []byte) bool {
+	template := ERC1155Bin
+
+	bin := common.FromHex(template)
+
+	// Strip the CBOR encoded metadata, its length being in the last two bytes
+	if n := len(code); n > 2 {
+		if meta := int(code[n-2])<<8 | int(code[n-1]); meta+2 < n && code[n-2-meta]&0xe0 == 0xa0 {
+			code = code[:n-2-meta]
+		}
+	}
+	// Mask the runs of zeros long enough to hold an address or an immutable
+	masked := make([]bool, len(bin))
+	for start := 0; start < len(bin); {
+		end := start
+		for end < len(bin) && bin[end] == 0 {
+			end++
+		}
+		if end-start >= common.AddressLength {
+			for i := start; i < end; i++ {
+				masked[i] = true
+			}
+		}
+		start = end + 1
+	}
+	// Look for the runtime code within the creation code
+	for offset := 0; len(code) > 0 && offset+len(code) <= len(bin); offset++ {
+		matched := true
+		for i := range code {
+			if code[i] != bin[offset+i] && !masked[offset+i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+// - Solidity: function balanceOf(address account, uint256 id) constant returns(uint256)
+//
+// Returns the amount of tokens of id owned by account.
+func (_ERC1155 *ERC1155) BalanceOf(opts *bind.CallOpts, account common.Address, id *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ERC1155.bound().Call(opts, out, "balanceOf", account, id)
+	return *ret0, unpackERC1155Error(err)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+// - Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) constant returns(uint256[])
+//
+// Returns the balances of accounts in the tokens of ids.
+func (_ERC1155 *ERC1155) BalanceOfBatch(opts *bind.CallOpts, accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	var (
+		ret0 = new([]*big.Int)
+	)
+	out := ret0
+	err := _ERC1155.bound().Call(opts, out, "balanceOfBatch", accounts, ids)
+	return *ret0, unpackERC1155Error(err)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+// - Solidity: function isApprovedForAll(address account, address operator) constant returns(bool)
+//
+// Returns whether operator is allowed to manage all of the tokens of account.
+func (_ERC1155 *ERC1155) IsApprovedForAll(opts *bind.CallOpts, account common.Address, operator common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ERC1155.bound().Call(opts, out, "isApprovedForAll", account, operator)
+	return *ret0, unpackERC1155Error(err)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// - Solidity: function owner() constant returns(address)
+//
+// Returns the owner of the contract.
+func (_ERC1155 *ERC1155) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _ERC1155.bound().Call(opts, out, "owner")
+	return *ret0, unpackERC1155Error(err)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+// - Solidity: function supportsInterface(bytes4 interfaceId) constant returns(bool)
+//
+// Returns whether the contract implements interfaceId.
+func (_ERC1155 *ERC1155) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ERC1155.bound().Call(opts, out, "supportsInterface", interfaceId)
+	return *ret0, unpackERC1155Error(err)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+// - Solidity: function uri(uint256 ) constant returns(string)
+//
+// Returns the URI of every token.
+func (_ERC1155 *ERC1155) Uri(opts *bind.CallOpts, arg0 *big.Int) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _ERC1155.bound().Call(opts, out, "uri", arg0)
+	return *ret0, unpackERC1155Error(err)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// Mint is a paid mutator transaction binding the contract method 0x731133e9.
+// - Solidity: function mint(address to, uint256 id, uint256 amount, bytes data) returns()
+//
+// Mints amount tokens of id to to, checking a contract receiving them implements onERC1155Received. Only the owner can mint.
+func (_ERC1155 *ERC1155) Mint(opts *bind.TransactOpts, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155.transact(opts, "mint", to, id, amount, data)
+}
+
+// EstimateMint estimates the gas needed to invoke the contract method 0x731133e9.
+func (_ERC1155 *ERC1155) EstimateMint(opts *bind.TransactOpts, to common.Address, id *big.Int, amount *big.Int, data []byte) (uint64, error) {
+	return _ERC1155.estimate(opts, "mint", to, id, amount, data)
+}
+
+// SimulateMint runs the contract method 0x731133e9 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC1155 *ERC1155) SimulateMint(opts *bind.TransactOpts, to common.Address, id *big.Int, amount *big.Int, data []byte) error {
+	return _ERC1155.simulate(opts, nil, "mint", to, id, amount, data)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// - Solidity: function renounceOwnership() returns()
+//
+// Leaves the contract without an owner, disabling the functions restricted to it.
+func (_ERC1155 *ERC1155) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC1155.transact(opts, "renounceOwnership")
+}
+
+// EstimateRenounceOwnership estimates the gas needed to invoke the contract method 0x715018a6.
+func (_ERC1155 *ERC1155) EstimateRenounceOwnership(opts *bind.TransactOpts) (uint64, error) {
+	return _ERC1155.estimate(opts, "renounceOwnership")
+}
+
+// SimulateRenounceOwnership runs the contract method 0x715018a6 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC1155 *ERC1155) SimulateRenounceOwnership(opts *bind.TransactOpts) error {
+	return _ERC1155.simulate(opts, nil, "renounceOwnership")
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+// - Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+//
+// Transfers amounts tokens of ids, checking a contract receiving them implements onERC1155BatchReceived.
+func (_ERC1155 *ERC1155) SafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155.transact(opts, "safeBatchTransferFrom", from, to, ids, amounts, data)
+}
+
+// EstimateSafeBatchTransferFrom estimates the gas needed to invoke the contract method 0x2eb2c2d6.
+func (_ERC1155 *ERC1155) EstimateSafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (uint64, error) {
+	return _ERC1155.estimate(opts, "safeBatchTransferFrom", from, to, ids, amounts, data)
+}
+
+// SimulateSafeBatchTransferFrom runs the contract method 0x2eb2c2d6 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC1155 *ERC1155) SimulateSafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) error {
+	return _ERC1155.simulate(opts, nil, "safeBatchTransferFrom", from, to, ids, amounts, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+// - Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+//
+// Transfers amount tokens of id, checking a contract receiving them implements onERC1155Received.
+func (_ERC1155 *ERC1155) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155.transact(opts, "safeTransferFrom", from, to, id, amount, data)
+}
+
+// EstimateSafeTransferFrom estimates the gas needed to invoke the contract method 0xf242432a.
+func (_ERC1155 *ERC1155) EstimateSafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (uint64, error) {
+	return _ERC1155.estimate(opts, "safeTransferFrom", from, to, id, amount, data)
+}
+
+// SimulateSafeTransferFrom runs the contract method 0xf242432a as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC1155 *ERC1155) SimulateSafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) error {
+	return _ERC1155.simulate(opts, nil, "safeTransferFrom", from, to, id, amount, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+// - Solidity: function setApprovalForAll(address operator, bool approved) returns()
+//
+// Approves or removes operator as an operator for the caller.
+func (_ERC1155 *ERC1155) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC1155.transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// EstimateSetApprovalForAll estimates the gas needed to invoke the contract method 0xa22cb465.
+func (_ERC1155 *ERC1155) EstimateSetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (uint64, error) {
+	return _ERC1155.estimate(opts, "setApprovalForAll", operator, approved)
+}
+
+// SimulateSetApprovalForAll runs the contract method 0xa22cb465 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC1155 *ERC1155) SimulateSetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) error {
+	return _ERC1155.simulate(opts, nil, "setApprovalForAll", operator, approved)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+// - Solidity: function transferOwnership(address newOwner) returns()
+//
+// Transfers ownership of the contract to newOwner.
+func (_ERC1155 *ERC1155) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ERC1155.transact(opts, "transferOwnership", newOwner)
+}
+
+// EstimateTransferOwnership estimates the gas needed to invoke the contract method 0xf2fde38b.
+func (_ERC1155 *ERC1155) EstimateTransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (uint64, error) {
+	return _ERC1155.estimate(opts, "transferOwnership", newOwner)
+}
+
+// SimulateTransferOwnership runs the contract method 0xf2fde38b as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC1155 *ERC1155) SimulateTransferOwnership(opts *bind.TransactOpts, newOwner common.Address) error {
+	return _ERC1155.simulate(opts, nil, "transferOwnership", newOwner)
+}
+
+//////////////////////////////////////////////////////
+//		Sessions
+////////////////////////////////////////////////////
+
+// ERC1155Session binds ERC1155 to default call and transact options, exposing
+// every method without the opts argument.
+type ERC1155Session struct {
+	Contract     *ERC1155          // Contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC1155CallerSession binds ERC1155 to default call options, exposing every
+// call without the opts argument.
+type ERC1155CallerSession struct {
+	Contract *ERC1155      // Contract binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// ERC1155TransactorSession binds ERC1155 to default transact options, exposing
+// every transaction without the opts argument.
+type ERC1155TransactorSession struct {
+	Contract     *ERC1155          // Contract binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NewERC1155Session opens a session on contract, sending transactions with auth
+// and making calls from the same account.
+func NewERC1155Session(contract *ERC1155, auth *bind.TransactOpts) *ERC1155Session {
+	return &ERC1155Session{
+		Contract:     contract,
+		CallOpts:     bind.CallOpts{From: auth.From, Context: auth.Context},
+		TransactOpts: *auth,
+	}
+}
+
+// ERC1155SessionInterface is the opts-free counterpart of ERC1155Interface.
+type ERC1155SessionInterface interface {
+	BalanceOf(account common.Address, id *big.Int) (*big.Int, error)
+	BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error)
+	IsApprovedForAll(account common.Address, operator common.Address) (bool, error)
+	Owner() (common.Address, error)
+	SupportsInterface(interfaceId [4]byte) (bool, error)
+	Uri(arg0 *big.Int) (string, error)
+
+	Mint(to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error)
+	RenounceOwnership() (*types.Transaction, error)
+	SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error)
+	SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error)
+	SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error)
+	TransferOwnership(newOwner common.Address) (*types.Transaction, error)
+}
+
+// This nil assignment ensures at compile time that ERC1155Session implements ERC1155SessionInterface.
+var _ ERC1155SessionInterface = (*ERC1155Session)(nil)
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+func (_ERC1155 *ERC1155Session) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _ERC1155.Contract.BalanceOf(&_ERC1155.CallOpts, account, id)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+func (_ERC1155 *ERC1155CallerSession) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _ERC1155.Contract.BalanceOf(&_ERC1155.CallOpts, account, id)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+func (_ERC1155 *ERC1155Session) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _ERC1155.Contract.BalanceOfBatch(&_ERC1155.CallOpts, accounts, ids)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+func (_ERC1155 *ERC1155CallerSession) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _ERC1155.Contract.BalanceOfBatch(&_ERC1155.CallOpts, accounts, ids)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+func (_ERC1155 *ERC1155Session) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _ERC1155.Contract.IsApprovedForAll(&_ERC1155.CallOpts, account, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+func (_ERC1155 *ERC1155CallerSession) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _ERC1155.Contract.IsApprovedForAll(&_ERC1155.CallOpts, account, operator)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+func (_ERC1155 *ERC1155Session) Owner() (common.Address, error) {
+	return _ERC1155.Contract.Owner(&_ERC1155.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+func (_ERC1155 *ERC1155CallerSession) Owner() (common.Address, error) {
+	return _ERC1155.Contract.Owner(&_ERC1155.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+func (_ERC1155 *ERC1155Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC1155.Contract.SupportsInterface(&_ERC1155.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+func (_ERC1155 *ERC1155CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC1155.Contract.SupportsInterface(&_ERC1155.CallOpts, interfaceId)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+func (_ERC1155 *ERC1155Session) Uri(arg0 *big.Int) (string, error) {
+	return _ERC1155.Contract.Uri(&_ERC1155.CallOpts, arg0)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+func (_ERC1155 *ERC1155CallerSession) Uri(arg0 *big.Int) (string, error) {
+	return _ERC1155.Contract.Uri(&_ERC1155.CallOpts, arg0)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x731133e9.
+func (_ERC1155 *ERC1155Session) Mint(to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155.Contract.Mint(&_ERC1155.TransactOpts, to, id, amount, data)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x731133e9.
+func (_ERC1155 *ERC1155TransactorSession) Mint(to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155.Contract.Mint(&_ERC1155.TransactOpts, to, id, amount, data)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+func (_ERC1155 *ERC1155Session) RenounceOwnership() (*types.Transaction, error) {
+	return _ERC1155.Contract.RenounceOwnership(&_ERC1155.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+func (_ERC1155 *ERC1155TransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ERC1155.Contract.RenounceOwnership(&_ERC1155.TransactOpts)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+func (_ERC1155 *ERC1155Session) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155.Contract.SafeBatchTransferFrom(&_ERC1155.TransactOpts, from, to, ids, amounts, data)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+func (_ERC1155 *ERC1155TransactorSession) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155.Contract.SafeBatchTransferFrom(&_ERC1155.TransactOpts, from, to, ids, amounts, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+func (_ERC1155 *ERC1155Session) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155.Contract.SafeTransferFrom(&_ERC1155.TransactOpts, from, to, id, amount, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+func (_ERC1155 *ERC1155TransactorSession) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155.Contract.SafeTransferFrom(&_ERC1155.TransactOpts, from, to, id, amount, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+func (_ERC1155 *ERC1155Session) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC1155.Contract.SetApprovalForAll(&_ERC1155.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+func (_ERC1155 *ERC1155TransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC1155.Contract.SetApprovalForAll(&_ERC1155.TransactOpts, operator, approved)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+func (_ERC1155 *ERC1155Session) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ERC1155.Contract.TransferOwnership(&_ERC1155.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+func (_ERC1155 *ERC1155TransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ERC1155.Contract.TransferOwnership(&_ERC1155.TransactOpts, newOwner)
+}
+
+//////////////////////////////////////////////////////
+//		Calldata
+////////////////////////////////////////////////////
+
+var (
+	parsedERC1155ABIOnce sync.Once
+	parsedERC1155ABI     abi.ABI
+	parsedERC1155ABIErr  error
+)
+
+// loadERC1155ABI parses ERC1155ABI once, sharing it between the calldata helpers.
+func loadERC1155ABI() (abi.ABI, error) {
+	parsedERC1155ABIOnce.Do(func() {
+		parsedERC1155ABI, parsedERC1155ABIErr = parseERC1155ABI()
+	})
+	return parsedERC1155ABI, parsedERC1155ABIErr
+}
+
+// unnamedERC1155Args names unnamed arguments the way the input structs do, so
+// they can be unpacked into them.
+func unnamedERC1155Args(args abi.Arguments) abi.Arguments {
+	named := make(abi.Arguments, len(args))
+	for i, arg := range args {
+		if arg.Name == "" {
+			arg.Name = fmt.Sprintf("arg%d", i)
+		}
+		named[i] = arg
+	}
+	return named
+}
+
+// ERC1155BalanceOfInput holds the arguments of a ERC1155.BalanceOf invocation.
+type ERC1155BalanceOfInput struct {
+	Account common.Address
+	Id      *big.Int
+}
+
+// PackERC1155BalanceOf packs the calldata invoking the contract method 0x00fdd58e.
+func PackERC1155BalanceOf(account common.Address, id *big.Int) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("balanceOf", account, id)
+}
+
+// UnpackERC1155BalanceOfInput unpacks the calldata of a transaction invoking the
+// contract method 0x00fdd58e, selector included.
+func UnpackERC1155BalanceOfInput(data []byte) (*ERC1155BalanceOfInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "00fdd58e" {
+		return nil, errors.New("calldata does not invoke balanceOf(address,uint256)")
+	}
+	input := new(ERC1155BalanceOfInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["balanceOf"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC1155BalanceOfOutput unpacks the data returned by the contract method 0x00fdd58e,
+// as ERC1155.BalanceOf returns it.
+func UnpackERC1155BalanceOfOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadERC1155ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "balanceOf", data)
+	}
+	return *ret0, err
+}
+
+// ERC1155BalanceOfBatchInput holds the arguments of a ERC1155.BalanceOfBatch invocation.
+type ERC1155BalanceOfBatchInput struct {
+	Accounts []common.Address
+	Ids      []*big.Int
+}
+
+// PackERC1155BalanceOfBatch packs the calldata invoking the contract method 0x4e1273f4.
+func PackERC1155BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("balanceOfBatch", accounts, ids)
+}
+
+// UnpackERC1155BalanceOfBatchInput unpacks the calldata of a transaction invoking the
+// contract method 0x4e1273f4, selector included.
+func UnpackERC1155BalanceOfBatchInput(data []byte) (*ERC1155BalanceOfBatchInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "4e1273f4" {
+		return nil, errors.New("calldata does not invoke balanceOfBatch(address[],uint256[])")
+	}
+	input := new(ERC1155BalanceOfBatchInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["balanceOfBatch"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC1155BalanceOfBatchOutput unpacks the data returned by the contract method 0x4e1273f4,
+// as ERC1155.BalanceOfBatch returns it.
+func UnpackERC1155BalanceOfBatchOutput(data []byte) ([]*big.Int, error) {
+	var (
+		ret0 = new([]*big.Int)
+	)
+	out := ret0
+	parsed, err := loadERC1155ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "balanceOfBatch", data)
+	}
+	return *ret0, err
+}
+
+// ERC1155IsApprovedForAllInput holds the arguments of a ERC1155.IsApprovedForAll invocation.
+type ERC1155IsApprovedForAllInput struct {
+	Account  common.Address
+	Operator common.Address
+}
+
+// PackERC1155IsApprovedForAll packs the calldata invoking the contract method 0xe985e9c5.
+func PackERC1155IsApprovedForAll(account common.Address, operator common.Address) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("isApprovedForAll", account, operator)
+}
+
+// UnpackERC1155IsApprovedForAllInput unpacks the calldata of a transaction invoking the
+// contract method 0xe985e9c5, selector included.
+func UnpackERC1155IsApprovedForAllInput(data []byte) (*ERC1155IsApprovedForAllInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "e985e9c5" {
+		return nil, errors.New("calldata does not invoke isApprovedForAll(address,address)")
+	}
+	input := new(ERC1155IsApprovedForAllInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["isApprovedForAll"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC1155IsApprovedForAllOutput unpacks the data returned by the contract method 0xe985e9c5,
+// as ERC1155.IsApprovedForAll returns it.
+func UnpackERC1155IsApprovedForAllOutput(data []byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	parsed, err := loadERC1155ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "isApprovedForAll", data)
+	}
+	return *ret0, err
+}
+
+// ERC1155OwnerInput holds the arguments of a ERC1155.Owner invocation.
+type ERC1155OwnerInput struct{}
+
+// PackERC1155Owner packs the calldata invoking the contract method 0x8da5cb5b.
+func PackERC1155Owner() ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("owner")
+}
+
+// UnpackERC1155OwnerInput unpacks the calldata of a transaction invoking the
+// contract method 0x8da5cb5b, selector included.
+func UnpackERC1155OwnerInput(data []byte) (*ERC1155OwnerInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "8da5cb5b" {
+		return nil, errors.New("calldata does not invoke owner()")
+	}
+	input := new(ERC1155OwnerInput)
+
+	return input, nil
+}
+
+// UnpackERC1155OwnerOutput unpacks the data returned by the contract method 0x8da5cb5b,
+// as ERC1155.Owner returns it.
+func UnpackERC1155OwnerOutput(data []byte) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	parsed, err := loadERC1155ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "owner", data)
+	}
+	return *ret0, err
+}
+
+// ERC1155SupportsInterfaceInput holds the arguments of a ERC1155.SupportsInterface invocation.
+type ERC1155SupportsInterfaceInput struct {
+	InterfaceId [4]byte
+}
+
+// PackERC1155SupportsInterface packs the calldata invoking the contract method 0x01ffc9a7.
+func PackERC1155SupportsInterface(interfaceId [4]byte) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("supportsInterface", interfaceId)
+}
+
+// UnpackERC1155SupportsInterfaceInput unpacks the calldata of a transaction invoking the
+// contract method 0x01ffc9a7, selector included.
+func UnpackERC1155SupportsInterfaceInput(data []byte) (*ERC1155SupportsInterfaceInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "01ffc9a7" {
+		return nil, errors.New("calldata does not invoke supportsInterface(bytes4)")
+	}
+	input := new(ERC1155SupportsInterfaceInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["supportsInterface"].Inputs)
+	if err := args.Unpack(&input.InterfaceId, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC1155SupportsInterfaceOutput unpacks the data returned by the contract method 0x01ffc9a7,
+// as ERC1155.SupportsInterface returns it.
+func UnpackERC1155SupportsInterfaceOutput(data []byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	parsed, err := loadERC1155ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "supportsInterface", data)
+	}
+	return *ret0, err
+}
+
+// ERC1155UriInput holds the arguments of a ERC1155.Uri invocation.
+type ERC1155UriInput struct {
+	Arg0 *big.Int
+}
+
+// PackERC1155Uri packs the calldata invoking the contract method 0x0e89341c.
+func PackERC1155Uri(arg0 *big.Int) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("uri", arg0)
+}
+
+// UnpackERC1155UriInput unpacks the calldata of a transaction invoking the
+// contract method 0x0e89341c, selector included.
+func UnpackERC1155UriInput(data []byte) (*ERC1155UriInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "0e89341c" {
+		return nil, errors.New("calldata does not invoke uri(uint256)")
+	}
+	input := new(ERC1155UriInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["uri"].Inputs)
+	if err := args.Unpack(&input.Arg0, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC1155UriOutput unpacks the data returned by the contract method 0x0e89341c,
+// as ERC1155.Uri returns it.
+func UnpackERC1155UriOutput(data []byte) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	parsed, err := loadERC1155ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "uri", data)
+	}
+	return *ret0, err
+}
+
+// ERC1155MintInput holds the arguments of a ERC1155.Mint invocation.
+type ERC1155MintInput struct {
+	To     common.Address
+	Id     *big.Int
+	Amount *big.Int
+	Data   []byte
+}
+
+// PackERC1155Mint packs the calldata invoking the contract method 0x731133e9.
+func PackERC1155Mint(to common.Address, id *big.Int, amount *big.Int, data []byte) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("mint", to, id, amount, data)
+}
+
+// UnpackERC1155MintInput unpacks the calldata of a transaction invoking the
+// contract method 0x731133e9, selector included.
+func UnpackERC1155MintInput(data []byte) (*ERC1155MintInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "731133e9" {
+		return nil, errors.New("calldata does not invoke mint(address,uint256,uint256,bytes)")
+	}
+	input := new(ERC1155MintInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["mint"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC1155RenounceOwnershipInput holds the arguments of a ERC1155.RenounceOwnership invocation.
+type ERC1155RenounceOwnershipInput struct{}
+
+// PackERC1155RenounceOwnership packs the calldata invoking the contract method 0x715018a6.
+func PackERC1155RenounceOwnership() ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("renounceOwnership")
+}
+
+// UnpackERC1155RenounceOwnershipInput unpacks the calldata of a transaction invoking the
+// contract method 0x715018a6, selector included.
+func UnpackERC1155RenounceOwnershipInput(data []byte) (*ERC1155RenounceOwnershipInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "715018a6" {
+		return nil, errors.New("calldata does not invoke renounceOwnership()")
+	}
+	input := new(ERC1155RenounceOwnershipInput)
+
+	return input, nil
+}
+
+// ERC1155SafeBatchTransferFromInput holds the arguments of a ERC1155.SafeBatchTransferFrom invocation.
+type ERC1155SafeBatchTransferFromInput struct {
+	From    common.Address
+	To      common.Address
+	Ids     []*big.Int
+	Amounts []*big.Int
+	Data    []byte
+}
+
+// PackERC1155SafeBatchTransferFrom packs the calldata invoking the contract method 0x2eb2c2d6.
+func PackERC1155SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("safeBatchTransferFrom", from, to, ids, amounts, data)
+}
+
+// UnpackERC1155SafeBatchTransferFromInput unpacks the calldata of a transaction invoking the
+// contract method 0x2eb2c2d6, selector included.
+func UnpackERC1155SafeBatchTransferFromInput(data []byte) (*ERC1155SafeBatchTransferFromInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "2eb2c2d6" {
+		return nil, errors.New("calldata does not invoke safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)")
+	}
+	input := new(ERC1155SafeBatchTransferFromInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["safeBatchTransferFrom"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC1155SafeTransferFromInput holds the arguments of a ERC1155.SafeTransferFrom invocation.
+type ERC1155SafeTransferFromInput struct {
+	From   common.Address
+	To     common.Address
+	Id     *big.Int
+	Amount *big.Int
+	Data   []byte
+}
+
+// PackERC1155SafeTransferFrom packs the calldata invoking the contract method 0xf242432a.
+func PackERC1155SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("safeTransferFrom", from, to, id, amount, data)
+}
+
+// UnpackERC1155SafeTransferFromInput unpacks the calldata of a transaction invoking the
+// contract method 0xf242432a, selector included.
+func UnpackERC1155SafeTransferFromInput(data []byte) (*ERC1155SafeTransferFromInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "f242432a" {
+		return nil, errors.New("calldata does not invoke safeTransferFrom(address,address,uint256,uint256,bytes)")
+	}
+	input := new(ERC1155SafeTransferFromInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["safeTransferFrom"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC1155SetApprovalForAllInput holds the arguments of a ERC1155.SetApprovalForAll invocation.
+type ERC1155SetApprovalForAllInput struct {
+	Operator common.Address
+	Approved bool
+}
+
+// PackERC1155SetApprovalForAll packs the calldata invoking the contract method 0xa22cb465.
+func PackERC1155SetApprovalForAll(operator common.Address, approved bool) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("setApprovalForAll", operator, approved)
+}
+
+// UnpackERC1155SetApprovalForAllInput unpacks the calldata of a transaction invoking the
+// contract method 0xa22cb465, selector included.
+func UnpackERC1155SetApprovalForAllInput(data []byte) (*ERC1155SetApprovalForAllInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "a22cb465" {
+		return nil, errors.New("calldata does not invoke setApprovalForAll(address,bool)")
+	}
+	input := new(ERC1155SetApprovalForAllInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["setApprovalForAll"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC1155TransferOwnershipInput holds the arguments of a ERC1155.TransferOwnership invocation.
+type ERC1155TransferOwnershipInput struct {
+	NewOwner common.Address
+}
+
+// PackERC1155TransferOwnership packs the calldata invoking the contract method 0xf2fde38b.
+func PackERC1155TransferOwnership(newOwner common.Address) ([]byte, error) {
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("transferOwnership", newOwner)
+}
+
+// UnpackERC1155TransferOwnershipInput unpacks the calldata of a transaction invoking the
+// contract method 0xf2fde38b, selector included.
+func UnpackERC1155TransferOwnershipInput(data []byte) (*ERC1155TransferOwnershipInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "f2fde38b" {
+		return nil, errors.New("calldata does not invoke transferOwnership(address)")
+	}
+	input := new(ERC1155TransferOwnershipInput)
+	parsed, err := loadERC1155ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC1155Args(parsed.Methods["transferOwnership"].Inputs)
+	if err := args.Unpack(&input.NewOwner, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ErrERC1155UnknownMethod is returned by DecodeERC1155Call for calldata that doesn't invoke a ERC1155 method.
+var ErrERC1155UnknownMethod = errors.New("unknown ERC1155 method")
+
+// DecodeERC1155Call identifies the method invoked by calldata through its 4-byte
+// selector, returning the matching *MethodInput holding its arguments.
+func DecodeERC1155Call(data []byte) (interface{}, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("%w: calldata too short", ErrERC1155UnknownMethod)
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "00fdd58e":
+		input, err := UnpackERC1155BalanceOfInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "4e1273f4":
+		input, err := UnpackERC1155BalanceOfBatchInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "e985e9c5":
+		input, err := UnpackERC1155IsApprovedForAllInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "8da5cb5b":
+		input, err := UnpackERC1155OwnerInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "01ffc9a7":
+		input, err := UnpackERC1155SupportsInterfaceInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "0e89341c":
+		input, err := UnpackERC1155UriInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "731133e9":
+		input, err := UnpackERC1155MintInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "715018a6":
+		input, err := UnpackERC1155RenounceOwnershipInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "2eb2c2d6":
+		input, err := UnpackERC1155SafeBatchTransferFromInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "f242432a":
+		input, err := UnpackERC1155SafeTransferFromInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "a22cb465":
+		input, err := UnpackERC1155SetApprovalForAllInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "f2fde38b":
+		input, err := UnpackERC1155TransferOwnershipInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	}
+	return nil, fmt.Errorf("%w: selector %x", ErrERC1155UnknownMethod, data[:4])
+}
+
+//////////////////////////////////////////////////////
+//		Errors
+////////////////////////////////////////////////////
+
+// ERC1155Revert is a revert with a reason string, as raised by require and revert.
+type ERC1155Revert struct {
+	Reason string
+}
+
+func (e ERC1155Revert) Error() string {
+	return "ERC1155: execution reverted: " + e.Reason
+}
+
+// ERC1155Panic is raised by failed assertions, arithmetic overflows and the like.
+type ERC1155Panic struct {
+	Code *big.Int
+}
+
+func (e ERC1155Panic) Error() string {
+	return fmt.Sprintf("ERC1155: panic code %#x", e.Code)
+}
+
+// ERC1155ErrorsABI describes the errors ERC1155 can revert with, each as a function
+// returning the error arguments.
+const ERC1155ErrorsABI = "[{\"type\":\"function\",\"name\":\"Error\",\"outputs\":[{\"name\":\"reason\",\"type\":\"string\"}]},{\"type\":\"function\",\"name\":\"Panic\",\"outputs\":[{\"name\":\"code\",\"type\":\"uint256\"}]}]"
+
+// DecodeERC1155Revert decodes revert data into a ERC1155Revert, a ERC1155Panic
+// or one of the custom errors of ERC1155. It returns nil for unknown data.
+func DecodeERC1155Revert(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(ERC1155ErrorsABI))
+	if err != nil {
+		return nil
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "08c379a0":
+		var e ERC1155Revert
+		if parsed.Unpack(&e, "Error", data[4:]) == nil {
+			return e
+		}
+	case "4e487b71":
+		var e ERC1155Panic
+		if parsed.Unpack(&e, "Panic", data[4:]) == nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// unpackERC1155Error replaces an error carrying revert data with the typed error
+// decoded from it, leaving any other error untouched. Only errors exposing the
+// data through ErrorData, like sim.RevertError, carry it; RPC errors don't.
+func unpackERC1155Error(err error) error {
+	var reverted interface{ ErrorData() interface{} }
+	if !errors.As(err, &reverted) {
+		return err
+	}
+	var data []byte
+	switch d := reverted.ErrorData().(type) {
+	case string:
+		data = common.FromHex(d)
+	case []byte:
+		data = d
+	}
+	if decoded := DecodeERC1155Revert(data); decoded != nil {
+		return decoded
+	}
+	return err
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+// logERC1155JSON is the JSON encoding shared by the events of ERC1155.
+type logERC1155JSON struct {
+	Event       string          `json:"event"`
+	Contract    string          `json:"contract"`
+	Fields      json.RawMessage `json:"fields"`
+	Address     common.Address  `json:"address"`
+	Topics      []common.Hash   `json:"topics"`
+	Data        hexutil.Bytes   `json:"data"`
+	BlockNumber uint64          `json:"blockNumber"`
+	BlockHash   common.Hash     `json:"blockHash"`
+	TxHash      common.Hash     `json:"transactionHash"`
+	TxIndex     uint            `json:"transactionIndex"`
+	LogIndex    uint            `json:"logIndex"`
+	Removed     bool            `json:"removed"`
+}
+
+// marshalLogERC1155 encodes the decoded fields of an event along with its log.
+func marshalLogERC1155(name string, fields interface{}, log types.Log) ([]byte, error) {
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(logERC1155JSON{
+		Event:       name,
+		Contract:    "ERC1155",
+		Fields:      encoded,
+		Address:     log.Address,
+		Topics:      log.Topics,
+		Data:        log.Data,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Removed:     log.Removed,
+	})
+}
+
+// encodeJSONERC1155 encodes an event field as JSON, writing big numbers as
+// decimal strings and byte arrays as hex down through slices, arrays and
+// structs. Struct fields are keyed by their json tag.
+func encodeJSONERC1155(v reflect.Value) (json.RawMessage, error) {
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		if value == nil {
+			return json.RawMessage("null"), nil
+		}
+		return json.Marshal(value.String())
+	case []byte:
+		return json.Marshal(hexutil.Bytes(value))
+	case common.Address, common.Hash:
+		return json.Marshal(value)
+	}
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		if v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8 {
+			encoded := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(encoded), v)
+			return json.Marshal(hexutil.Bytes(encoded))
+		}
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		items := make([]json.RawMessage, v.Len())
+		for i := range items {
+			item, err := encodeJSONERC1155(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return json.Marshal(items)
+	case reflect.Struct:
+		encoded := []byte{'{'}
+		for i := 0; i < v.NumField(); i++ {
+			key, err := json.Marshal(jsonKeyERC1155(v.Type().Field(i)))
+			if err != nil {
+				return nil, err
+			}
+			value, err := encodeJSONERC1155(v.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			if i > 0 {
+				encoded = append(encoded, ',')
+			}
+			encoded = append(append(append(encoded, key...), ':'), value...)
+		}
+		return append(encoded, '}'), nil
+	}
+	return json.Marshal(v.Interface())
+}
+
+// decodeJSONERC1155 decodes an event field encoded by encodeJSONERC1155 into v.
+func decodeJSONERC1155(input json.RawMessage, v reflect.Value) error {
+	switch v.Interface().(type) {
+	case *big.Int:
+		var dec *string
+		if err := json.Unmarshal(input, &dec); err != nil {
+			return err
+		}
+		if dec == nil {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		value, ok := new(big.Int).SetString(*dec, 10)
+		if !ok {
+			return fmt.Errorf("invalid number %q", *dec)
+		}
+		v.Set(reflect.ValueOf(value))
+		return nil
+	case []byte:
+		var dec hexutil.Bytes
+		if err := json.Unmarshal(input, &dec); err != nil {
+			return err
+		}
+		v.SetBytes(dec)
+		return nil
+	case common.Address, common.Hash:
+		return json.Unmarshal(input, v.Addr().Interface())
+	}
+	switch v.Kind() {
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			var dec hexutil.Bytes
+			if err := json.Unmarshal(input, &dec); err != nil {
+				return err
+			}
+			if len(dec) != v.Len() {
+				return fmt.Errorf("invalid length %d, want %d", len(dec), v.Len())
+			}
+			reflect.Copy(v, reflect.ValueOf([]byte(dec)))
+			return nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(input, &items); err != nil {
+			return err
+		}
+		if len(items) != v.Len() {
+			return fmt.Errorf("invalid length %d, want %d", len(items), v.Len())
+		}
+		for i, item := range items {
+			if err := decodeJSONERC1155(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		var items []json.RawMessage
+		if err := json.Unmarshal(input, &items); err != nil {
+			return err
+		}
+		if items == nil {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		v.Set(reflect.MakeSlice(v.Type(), len(items), len(items)))
+		for i, item := range items {
+			if err := decodeJSONERC1155(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(input, &fields); err != nil {
+			return err
+		}
+		for i := 0; i < v.NumField(); i++ {
+			key := jsonKeyERC1155(v.Type().Field(i))
+			if field, ok := fields[key]; ok {
+				if err := decodeJSONERC1155(field, v.Field(i)); err != nil {
+					return fmt.Errorf("invalid %s: %v", key, err)
+				}
+			}
+		}
+		return nil
+	}
+	return json.Unmarshal(input, v.Addr().Interface())
+}
+
+// jsonKeyERC1155 returns the JSON key of a struct field.
+func jsonKeyERC1155(field reflect.StructField) string {
+	if key := field.Tag.Get("json"); key != "" {
+		return key
+	}
+	return field.Name
+}
+
+// unmarshalLogERC1155 decodes the JSON encoding of an event, checking its name
+// and returning the raw log.
+func unmarshalLogERC1155(input []byte, name string, fields interface{}) (types.Log, error) {
+	var dec logERC1155JSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return types.Log{}, err
+	}
+	if dec.Event != name || dec.Contract != "ERC1155" {
+		return types.Log{}, fmt.Errorf("cannot unmarshal %s.%s into a ERC1155.%s event", dec.Contract, dec.Event, name)
+	}
+	if err := json.Unmarshal(dec.Fields, fields); err != nil {
+		return types.Log{}, err
+	}
+	return types.Log{
+		Address:     dec.Address,
+		Topics:      dec.Topics,
+		Data:        dec.Data,
+		BlockNumber: dec.BlockNumber,
+		BlockHash:   dec.BlockHash,
+		TxHash:      dec.TxHash,
+		TxIndex:     dec.TxIndex,
+		Index:       dec.LogIndex,
+		Removed:     dec.Removed,
+	}, nil
+}
+
+//////// ApprovalForAll ////////
+
+// ApprovalForAllID is the hex of the Topic Hash
+const ERC1155ApprovalForAllID = "0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31"
+
+// ApprovalForAllLog represents a ApprovalForAll event raised by the ERC1155 contract.
+type ApprovalForAllLog struct {
+	Account  common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e ApprovalForAllLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Account  common.Address `json:"account"`
+		Operator common.Address `json:"operator"`
+		Approved bool           `json:"approved"`
+	}
+	fields.Account = e.Account
+	fields.Operator = e.Operator
+	fields.Approved = e.Approved
+	return marshalLogERC1155("ApprovalForAll", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *ApprovalForAllLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Account  common.Address `json:"account"`
+		Operator common.Address `json:"operator"`
+		Approved bool           `json:"approved"`
+	}
+	raw, err := unmarshalLogERC1155(input, "ApprovalForAll", &fields)
+	if err != nil {
+		return err
+	}
+	e.Account = fields.Account
+	e.Operator = fields.Operator
+	e.Approved = fields.Approved
+	e.Raw = raw
+	return nil
+}
+
+// UnpackApprovalForAllLog is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_ERC1155 *ERC1155) UnpackApprovalForAllLog(log types.Log) (*ApprovalForAllLog, error) {
+	event := new(ApprovalForAllLog)
+	if err := _ERC1155.bound().UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ApprovalForAllLogIterator is returned from FilterApprovalForAll and is used to iterate over
+// the raw logs and unpacked data for ApprovalForAll events raised by the ERC1155 contract.
+type ApprovalForAllLogIterator struct {
+	Event *ApprovalForAllLog // Event containing the contract specifics and raw log
+
+	contract *ERC1155              // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ApprovalForAllLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackApprovalForAllLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackApprovalForAllLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ApprovalForAllLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ApprovalForAllLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_ERC1155 *ERC1155) FilterApprovalForAll(opts *bind.FilterOpts, account []common.Address, operator []common.Address) (*ApprovalForAllLogIterator, error) {
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().FilterLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ApprovalForAllLogIterator{contract: _ERC1155, logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_ERC1155 *ERC1155) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *ApprovalForAllLog, account []common.Address, operator []common.Address) (event.Subscription, error) {
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().WatchLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC1155.UnpackApprovalForAllLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// OwnershipTransferred ////////
+
+// OwnershipTransferredID is the hex of the Topic Hash
+const ERC1155OwnershipTransferredID = "0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"
+
+// OwnershipTransferredLog represents a OwnershipTransferred event raised by the ERC1155 contract.
+type OwnershipTransferredLog struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e OwnershipTransferredLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		PreviousOwner common.Address `json:"previousOwner"`
+		NewOwner      common.Address `json:"newOwner"`
+	}
+	fields.PreviousOwner = e.PreviousOwner
+	fields.NewOwner = e.NewOwner
+	return marshalLogERC1155("OwnershipTransferred", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *OwnershipTransferredLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		PreviousOwner common.Address `json:"previousOwner"`
+		NewOwner      common.Address `json:"newOwner"`
+	}
+	raw, err := unmarshalLogERC1155(input, "OwnershipTransferred", &fields)
+	if err != nil {
+		return err
+	}
+	e.PreviousOwner = fields.PreviousOwner
+	e.NewOwner = fields.NewOwner
+	e.Raw = raw
+	return nil
+}
+
+// UnpackOwnershipTransferredLog is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC1155 *ERC1155) UnpackOwnershipTransferredLog(log types.Log) (*OwnershipTransferredLog, error) {
+	event := new(OwnershipTransferredLog)
+	if err := _ERC1155.bound().UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OwnershipTransferredLogIterator is returned from FilterOwnershipTransferred and is used to iterate over
+// the raw logs and unpacked data for OwnershipTransferred events raised by the ERC1155 contract.
+type OwnershipTransferredLogIterator struct {
+	Event *OwnershipTransferredLog // Event containing the contract specifics and raw log
+
+	contract *ERC1155              // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OwnershipTransferredLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackOwnershipTransferredLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackOwnershipTransferredLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OwnershipTransferredLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OwnershipTransferredLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC1155 *ERC1155) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*OwnershipTransferredLogIterator, error) {
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnershipTransferredLogIterator{contract: _ERC1155, logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC1155 *ERC1155) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *OwnershipTransferredLog, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC1155.UnpackOwnershipTransferredLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// TransferBatch ////////
+
+// TransferBatchID is the hex of the Topic Hash
+const ERC1155TransferBatchID = "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"
+
+// TransferBatchLog represents a TransferBatch event raised by the ERC1155 contract.
+type TransferBatchLog struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e TransferBatchLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Operator common.Address  `json:"operator"`
+		From     common.Address  `json:"from"`
+		To       common.Address  `json:"to"`
+		Ids      json.RawMessage `json:"ids"`
+		Values   json.RawMessage `json:"values"`
+	}
+	fields.Operator = e.Operator
+	fields.From = e.From
+	fields.To = e.To
+	encodedIds, err := encodeJSONERC1155(reflect.ValueOf(e.Ids))
+	if err != nil {
+		return nil, err
+	}
+	fields.Ids = encodedIds
+	encodedValues, err := encodeJSONERC1155(reflect.ValueOf(e.Values))
+	if err != nil {
+		return nil, err
+	}
+	fields.Values = encodedValues
+	return marshalLogERC1155("TransferBatch", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *TransferBatchLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Operator common.Address  `json:"operator"`
+		From     common.Address  `json:"from"`
+		To       common.Address  `json:"to"`
+		Ids      json.RawMessage `json:"ids"`
+		Values   json.RawMessage `json:"values"`
+	}
+	raw, err := unmarshalLogERC1155(input, "TransferBatch", &fields)
+	if err != nil {
+		return err
+	}
+	e.Operator = fields.Operator
+	e.From = fields.From
+	e.To = fields.To
+	if len(fields.Ids) > 0 {
+		if err := decodeJSONERC1155(fields.Ids, reflect.ValueOf(&e.Ids).Elem()); err != nil {
+			return fmt.Errorf("invalid ids: %v", err)
+		}
+	}
+	if len(fields.Values) > 0 {
+		if err := decodeJSONERC1155(fields.Values, reflect.ValueOf(&e.Values).Elem()); err != nil {
+			return fmt.Errorf("invalid values: %v", err)
+		}
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackTransferBatchLog is a log parse operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_ERC1155 *ERC1155) UnpackTransferBatchLog(log types.Log) (*TransferBatchLog, error) {
+	event := new(TransferBatchLog)
+	if err := _ERC1155.bound().UnpackLog(event, "TransferBatch", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TransferBatchLogIterator is returned from FilterTransferBatch and is used to iterate over
+// the raw logs and unpacked data for TransferBatch events raised by the ERC1155 contract.
+type TransferBatchLogIterator struct {
+	Event *TransferBatchLog // Event containing the contract specifics and raw log
+
+	contract *ERC1155              // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TransferBatchLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackTransferBatchLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackTransferBatchLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TransferBatchLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TransferBatchLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterTransferBatch is a free log retrieval operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_ERC1155 *ERC1155) FilterTransferBatch(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*TransferBatchLogIterator, error) {
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().FilterLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferBatchLogIterator{contract: _ERC1155, logs: logs, sub: sub}, nil
+}
+
+// WatchTransferBatch is a free log subscription operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_ERC1155 *ERC1155) WatchTransferBatch(opts *bind.WatchOpts, sink chan<- *TransferBatchLog, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().WatchLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC1155.UnpackTransferBatchLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// TransferSingle ////////
+
+// TransferSingleID is the hex of the Topic Hash
+const ERC1155TransferSingleID = "0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62"
+
+// TransferSingleLog represents a TransferSingle event raised by the ERC1155 contract.
+type TransferSingleLog struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Id       *big.Int
+	Value    *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e TransferSingleLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Operator common.Address `json:"operator"`
+		From     common.Address `json:"from"`
+		To       common.Address `json:"to"`
+		Id       string         `json:"id"`
+		Value    string         `json:"value"`
+	}
+	fields.Operator = e.Operator
+	fields.From = e.From
+	fields.To = e.To
+	if e.Id != nil {
+		fields.Id = e.Id.String()
+	}
+	if e.Value != nil {
+		fields.Value = e.Value.String()
+	}
+	return marshalLogERC1155("TransferSingle", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *TransferSingleLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Operator common.Address `json:"operator"`
+		From     common.Address `json:"from"`
+		To       common.Address `json:"to"`
+		Id       string         `json:"id"`
+		Value    string         `json:"value"`
+	}
+	raw, err := unmarshalLogERC1155(input, "TransferSingle", &fields)
+	if err != nil {
+		return err
+	}
+	e.Operator = fields.Operator
+	e.From = fields.From
+	e.To = fields.To
+	if fields.Id != "" {
+		value, ok := new(big.Int).SetString(fields.Id, 10)
+		if !ok {
+			return fmt.Errorf("invalid id %q", fields.Id)
+		}
+		e.Id = value
+	}
+	if fields.Value != "" {
+		value, ok := new(big.Int).SetString(fields.Value, 10)
+		if !ok {
+			return fmt.Errorf("invalid value %q", fields.Value)
+		}
+		e.Value = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackTransferSingleLog is a log parse operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_ERC1155 *ERC1155) UnpackTransferSingleLog(log types.Log) (*TransferSingleLog, error) {
+	event := new(TransferSingleLog)
+	if err := _ERC1155.bound().UnpackLog(event, "TransferSingle", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TransferSingleLogIterator is returned from FilterTransferSingle and is used to iterate over
+// the raw logs and unpacked data for TransferSingle events raised by the ERC1155 contract.
+type TransferSingleLogIterator struct {
+	Event *TransferSingleLog // Event containing the contract specifics and raw log
+
+	contract *ERC1155              // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TransferSingleLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackTransferSingleLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackTransferSingleLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TransferSingleLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TransferSingleLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterTransferSingle is a free log retrieval operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_ERC1155 *ERC1155) FilterTransferSingle(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*TransferSingleLogIterator, error) {
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().FilterLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferSingleLogIterator{contract: _ERC1155, logs: logs, sub: sub}, nil
+}
+
+// WatchTransferSingle is a free log subscription operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_ERC1155 *ERC1155) WatchTransferSingle(opts *bind.WatchOpts, sink chan<- *TransferSingleLog, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().WatchLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC1155.UnpackTransferSingleLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// URI ////////
+
+// URIID is the hex of the Topic Hash
+const ERC1155URIID = "0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b"
+
+// URILog represents a URI event raised by the ERC1155 contract.
+type URILog struct {
+	Value string
+	Id    *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e URILog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Value string `json:"value"`
+		Id    string `json:"id"`
+	}
+	fields.Value = e.Value
+	if e.Id != nil {
+		fields.Id = e.Id.String()
+	}
+	return marshalLogERC1155("URI", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *URILog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Value string `json:"value"`
+		Id    string `json:"id"`
+	}
+	raw, err := unmarshalLogERC1155(input, "URI", &fields)
+	if err != nil {
+		return err
+	}
+	e.Value = fields.Value
+	if fields.Id != "" {
+		value, ok := new(big.Int).SetString(fields.Id, 10)
+		if !ok {
+			return fmt.Errorf("invalid id %q", fields.Id)
+		}
+		e.Id = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackURILog is a log parse operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b
+// Solidity: event URI(string value, uint256 indexed id)
+func (_ERC1155 *ERC1155) UnpackURILog(log types.Log) (*URILog, error) {
+	event := new(URILog)
+	if err := _ERC1155.bound().UnpackLog(event, "URI", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// URILogIterator is returned from FilterURI and is used to iterate over
+// the raw logs and unpacked data for URI events raised by the ERC1155 contract.
+type URILogIterator struct {
+	Event *URILog // Event containing the contract specifics and raw log
+
+	contract *ERC1155              // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *URILogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackURILog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackURILog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *URILogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *URILogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterURI is a free log retrieval operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+// Solidity: event URI(string value, uint256 indexed id)
+func (_ERC1155 *ERC1155) FilterURI(opts *bind.FilterOpts, id []*big.Int) (*URILogIterator, error) {
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().FilterLogs(opts, "URI", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &URILogIterator{contract: _ERC1155, logs: logs, sub: sub}, nil
+}
+
+// WatchURI is a free log subscription operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+// Solidity: event URI(string value, uint256 indexed id)
+func (_ERC1155 *ERC1155) WatchURI(opts *bind.WatchOpts, sink chan<- *URILog, id []*big.Int) (event.Subscription, error) {
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _ERC1155.bound().WatchLogs(opts, "URI", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC1155.UnpackURILog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////////////////////////////////////////////////////
+//		Event Dispatch
+////////////////////////////////////////////////////
+
+// ERC1155EventHandler handles each of the events raised by the ERC1155 contract.
+type ERC1155EventHandler interface {
+	HandleApprovalForAll(event *ApprovalForAllLog) error
+	HandleOwnershipTransferred(event *OwnershipTransferredLog) error
+	HandleTransferBatch(event *TransferBatchLog) error
+	HandleTransferSingle(event *TransferSingleLog) error
+	HandleURI(event *URILog) error
+}
+
+// ErrERC1155UnknownEvent is returned by Dispatch for logs that weren't raised by a ERC1155 event.
+var ErrERC1155UnknownEvent = errors.New("unknown ERC1155 event")
+
+// Dispatch unpacks the log according to its first topic and passes it on to the
+// matching method of the handler.
+func (_ERC1155 *ERC1155) Dispatch(log types.Log, h ERC1155EventHandler) error {
+	if len(log.Topics) == 0 {
+		return fmt.Errorf("%w: log has no topics", ErrERC1155UnknownEvent)
+	}
+	switch log.Topics[0].Hex() {
+	case ERC1155ApprovalForAllID:
+		event, err := _ERC1155.UnpackApprovalForAllLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleApprovalForAll(event)
+
+	case ERC1155OwnershipTransferredID:
+		event, err := _ERC1155.UnpackOwnershipTransferredLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleOwnershipTransferred(event)
+
+	case ERC1155TransferBatchID:
+		event, err := _ERC1155.UnpackTransferBatchLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleTransferBatch(event)
+
+	case ERC1155TransferSingleID:
+		event, err := _ERC1155.UnpackTransferSingleLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleTransferSingle(event)
+
+	case ERC1155URIID:
+		event, err := _ERC1155.UnpackURILog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleURI(event)
+
+	}
+	return fmt.Errorf("%w: topic %s", ErrERC1155UnknownEvent, log.Topics[0].Hex())
+}
+
+//////////////////////////////////////////////////////
+//		Bin and ABI
+////////////////////////////////////////////////////
+
+// ERC1155Bin is used to deploy the generated contract
+const ERC1155Bin = "0x60806040523480156200001157600080fd5b5060405162001986380380620019868339810160408190526200003491620000bb565b6200003f3362000055565b60036200004d82826200021f565b5050620002eb565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b634e487b7160e01b600052604160045260246000fd5b60006020808385031215620000cf57600080fd5b82516001600160401b0380821115620000e757600080fd5b818501915085601f830112620000fc57600080fd5b815181811115620001115762000111620000a5565b604051601f8201601f19908116603f011681019083821181831017156200013c576200013c620000a5565b8160405282815288868487010111156200015557600080fd5b600093505b828410156200017957848401860151818501870152928501926200015a565b600086848301015280965050505050505092915050565b600181811c90821680620001a557607f821691505b602082108103620001c657634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200021a57600081815260208120601f850160051c81016020861015620001f55750805b601f850160051c820191505b81811015620002165782815560010162000201565b5050505b505050565b81516001600160401b038111156200023b576200023b620000a5565b62000253816200024c845462000190565b84620001cc565b602080601f8311600181146200028b5760008415620002725750858301515b600019600386901b1c1916600185901b17855562000216565b600085815260208120601f198616915b82811015620002bc578886015182559484019460019091019084016200029b565b5085821015620002db5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61168b80620002fb6000396000f3fe608060405234801561001057600080fd5b50600436106100b35760003560e01c8063731133e911610071578063731133e91461015e5780638da5cb5b14610171578063a22cb4651461018c578063e985e9c51461019f578063f242432a146101db578063f2fde38b146101ee57600080fd5b8062fdd58e146100b857806301ffc9a7146100de5780630e89341c146101015780632eb2c2d6146101215780634e1273f414610136578063715018a614610156575b600080fd5b6100cb6100c6366004610da0565b610201565b6040519081526020015b60405180910390f35b6100f16100ec366004610de0565b61029c565b60405190151581526020016100d5565b61011461010f366004610e04565b6102ec565b6040516100d59190610e63565b61013461012f366004610fc2565b610380565b005b61014961014436600461106c565b6103cc565b6040516100d59190611172565b6101346104f6565b61013461016c366004611185565b61052c565b6000546040516001600160a01b0390911681526020016100d5565b61013461019a3660046111e6565b610568565b6100f16101ad366004611222565b6001600160a01b03918216600090815260026020908152604080832093909416825291909152205460ff1690565b6101346101e9366004611255565b610577565b6101346101fc3660046112ba565b6105bc565b60006001600160a01b0383166102715760405162461bcd60e51b815260206004820152602a60248201527f455243313135353a2061646472657373207a65726f206973206e6f742061207660448201526930b634b21037bbb732b960b11b60648201526084015b60405180910390fd5b5060008181526001602090815260408083206001600160a01b03861684529091529020545b92915050565b60006001600160e01b03198216636cdb3d1360e11b14806102cd57506001600160e01b031982166303a24d0760e21b145b8061029657506301ffc9a760e01b6001600160e01b0319831614610296565b6060600380546102fb906112d5565b80601f0160208091040260200160405190810160405280929190818152602001828054610327906112d5565b80156103745780601f1061034957610100808354040283529160200191610374565b820191906000526020600020905b81548152906001019060200180831161035757829003601f168201915b50505050509050919050565b6001600160a01b03851633148061039c575061039c85336101ad565b6103b85760405162461bcd60e51b81526004016102689061130f565b6103c58585858585610657565b5050505050565b606081518351146104315760405162461bcd60e51b815260206004820152602960248201527f455243313135353a206163636f756e747320616e6420696473206c656e677468604482015268040dad2e6dac2e8c6d60bb1b6064820152608401610268565b6000835167ffffffffffffffff81111561044d5761044d610e76565b604051908082528060200260200182016040528015610476578160200160208202803683370190505b50905060005b84518110156104ee576104c185828151811061049a5761049a61135d565b60200260200101518583815181106104b4576104b461135d565b6020026020010151610201565b8282815181106104d3576104d361135d565b60209081029190910101526104e781611389565b905061047c565b509392505050565b6000546001600160a01b031633146105205760405162461bcd60e51b8152600401610268906113a2565b61052a6000610837565b565b6000546001600160a01b031633146105565760405162461bcd60e51b8152600401610268906113a2565b61056284848484610887565b50505050565b61057333838361097b565b5050565b6001600160a01b038516331480610593575061059385336101ad565b6105af5760405162461bcd60e51b81526004016102689061130f565b6103c58585858585610a5b565b6000546001600160a01b031633146105e65760405162461bcd60e51b8152600401610268906113a2565b6001600160a01b03811661064b5760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610268565b61065481610837565b50565b81518351146106b95760405162461bcd60e51b815260206004820152602860248201527f455243313135353a2069647320616e6420616d6f756e7473206c656e677468206044820152670dad2e6dac2e8c6d60c31b6064820152608401610268565b6001600160a01b0384166106df5760405162461bcd60e51b8152600401610268906113d7565b3360005b84518110156107c95760008582815181106107005761070061135d565b60200260200101519050600085838151811061071e5761071e61135d565b60209081029190910181015160008481526001835260408082206001600160a01b038e16835290935291909120549091508181101561076f5760405162461bcd60e51b81526004016102689061141c565b60008381526001602090815260408083206001600160a01b038e8116855292528083208585039055908b168252812080548492906107ae908490611466565b92505081905550505050806107c290611389565b90506106e3565b50846001600160a01b0316866001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb8787604051610819929190611479565b60405180910390a461082f818787878787610b6e565b505050505050565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6001600160a01b0384166108e75760405162461bcd60e51b815260206004820152602160248201527f455243313135353a206d696e7420746f20746865207a65726f206164647265736044820152607360f81b6064820152608401610268565b60008381526001602090815260408083206001600160a01b038816845290915281208054339285929161091b908490611466565b909155505060408051858152602081018590526001600160a01b0380881692600092918516917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a46103c581600087878787610cc9565b816001600160a01b0316836001600160a01b0316036109ee5760405162461bcd60e51b815260206004820152602960248201527f455243313135353a2073657474696e6720617070726f76616c20737461747573604482015268103337b91039b2b63360b91b6064820152608401610268565b6001600160a01b03838116600081815260026020908152604080832094871680845294825291829020805460ff191686151590811790915591519182527f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a3505050565b6001600160a01b038416610a815760405162461bcd60e51b8152600401610268906113d7565b60008381526001602090815260408083206001600160a01b0389168452909152902054339083811015610ac65760405162461bcd60e51b81526004016102689061141c565b60008581526001602090815260408083206001600160a01b038b8116855292528083208785039055908816825281208054869290610b05908490611466565b909155505060408051868152602081018690526001600160a01b03808916928a821692918616917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a4610b65828888888888610cc9565b50505050505050565b6001600160a01b0384163b1561082f5760405163bc197c8160e01b81526001600160a01b0385169063bc197c8190610bb290899089908890889088906004016114a7565b6020604051808303816000875af1925050508015610bed575060408051601f3d908101601f19168201909252610bea91810190611505565b60015b610c9957610bf9611522565b806308c379a003610c325750610c0d61153e565b80610c185750610c34565b8060405162461bcd60e51b81526004016102689190610e63565b505b60405162461bcd60e51b815260206004820152603460248201527f455243313135353a207472616e7366657220746f206e6f6e2d455243313135356044820152732932b1b2b4bb32b91034b6b83632b6b2b73a32b960611b6064820152608401610268565b6001600160e01b0319811663bc197c8160e01b14610b655760405162461bcd60e51b8152600401610268906115c8565b6001600160a01b0384163b1561082f5760405163f23a6e6160e01b81526001600160a01b0385169063f23a6e6190610d0d9089908990889088908890600401611610565b6020604051808303816000875af1925050508015610d48575060408051601f3d908101601f19168201909252610d4591810190611505565b60015b610d5457610bf9611522565b6001600160e01b0319811663f23a6e6160e01b14610b655760405162461bcd60e51b8152600401610268906115c8565b80356001600160a01b0381168114610d9b57600080fd5b919050565b60008060408385031215610db357600080fd5b610dbc83610d84565b946020939093013593505050565b6001600160e01b03198116811461065457600080fd5b600060208284031215610df257600080fd5b8135610dfd81610dca565b9392505050565b600060208284031215610e1657600080fd5b5035919050565b6000815180845260005b81811015610e4357602081850181015186830182015201610e27565b506000602082860101526020601f19601f83011685010191505092915050565b602081526000610dfd6020830184610e1d565b634e487b7160e01b600052604160045260246000fd5b601f8201601f1916810167ffffffffffffffff81118282101715610eb257610eb2610e76565b6040525050565b600067ffffffffffffffff821115610ed357610ed3610e76565b5060051b60200190565b600082601f830112610eee57600080fd5b81356020610efb82610eb9565b604051610f088282610e8c565b83815260059390931b8501820192828101915086841115610f2857600080fd5b8286015b84811015610f435780358352918301918301610f2c565b509695505050505050565b600082601f830112610f5f57600080fd5b813567ffffffffffffffff811115610f7957610f79610e76565b604051610f90601f8301601f191660200182610e8c565b818152846020838601011115610fa557600080fd5b816020850160208301376000918101602001919091529392505050565b600080600080600060a08688031215610fda57600080fd5b610fe386610d84565b9450610ff160208701610d84565b9350604086013567ffffffffffffffff8082111561100e57600080fd5b61101a89838a01610edd565b9450606088013591508082111561103057600080fd5b61103c89838a01610edd565b9350608088013591508082111561105257600080fd5b5061105f88828901610f4e565b9150509295509295909350565b6000806040838503121561107f57600080fd5b823567ffffffffffffffff8082111561109757600080fd5b818501915085601f8301126110ab57600080fd5b813560206110b882610eb9565b6040516110c58282610e8c565b83815260059390931b85018201928281019150898411156110e557600080fd5b948201945b8386101561110a576110fb86610d84565b825294820194908201906110ea565b9650508601359250508082111561112057600080fd5b5061112d85828601610edd565b9150509250929050565b600081518084526020808501945080840160005b838110156111675781518752958201959082019060010161114b565b509495945050505050565b602081526000610dfd6020830184611137565b6000806000806080858703121561119b57600080fd5b6111a485610d84565b93506020850135925060408501359150606085013567ffffffffffffffff8111156111ce57600080fd5b6111da87828801610f4e565b91505092959194509250565b600080604083850312156111f957600080fd5b61120283610d84565b91506020830135801515811461121757600080fd5b809150509250929050565b6000806040838503121561123557600080fd5b61123e83610d84565b915061124c60208401610d84565b90509250929050565b600080600080600060a0868803121561126d57600080fd5b61127686610d84565b945061128460208701610d84565b93506040860135925060608601359150608086013567ffffffffffffffff8111156112ae57600080fd5b61105f88828901610f4e565b6000602082840312156112cc57600080fd5b610dfd82610d84565b600181811c908216806112e957607f821691505b60208210810361130957634e487b7160e01b600052602260045260246000fd5b50919050565b6020808252602e908201527f455243313135353a2063616c6c6572206973206e6f7420746f6b656e206f776e60408201526d195c881bdc88185c1c1c9bdd995960921b606082015260800190565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b60006001820161139b5761139b611373565b5060010190565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b60208082526025908201527f455243313135353a207472616e7366657220746f20746865207a65726f206164604082015264647265737360d81b606082015260800190565b6020808252602a908201527f455243313135353a20696e73756666696369656e742062616c616e636520666f60408201526939103a3930b739b332b960b11b606082015260800190565b8082018082111561029657610296611373565b60408152600061148c6040830185611137565b828103602084015261149e8185611137565b95945050505050565b6001600160a01b0386811682528516602082015260a0604082018190526000906114d390830186611137565b82810360608401526114e58186611137565b905082810360808401526114f98185610e1d565b98975050505050505050565b60006020828403121561151757600080fd5b8151610dfd81610dca565b600060033d111561153b5760046000803e5060005160e01c5b90565b600060443d101561154c5790565b6040516003193d81016004833e81513d67ffffffffffffffff816024840111818411171561157c57505050505090565b82850191508151818111156115945750505050505090565b843d87010160208285010111156115ae5750505050505090565b6115bd60208286010187610e8c565b509095945050505050565b60208082526028908201527f455243313135353a204552433131353552656365697665722072656a656374656040820152676420746f6b656e7360c01b606082015260800190565b6001600160a01b03868116825285166020820152604081018490526060810183905260a06080820181905260009061164a90830184610e1d565b97965050505050505056fea26469706673582212204939d707d42de9983d0b2846fdb140b91883ca605553e70636d6e32e78bb683b64736f6c63430008150033"
+
+// ERC1155ABI is used to communicate with the compiled solidity code of the generated contract
+const ERC1155ABI = "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"uri_\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"TransferBatch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"TransferSingle\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"URI\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"accounts\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"}],\"name\":\"balanceOfBatch\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"amounts\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeBatchTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"uri\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// ERC1155RuntimeBin is the code deployed by ERC1155Bin, with libraries left unlinked
+const ERC1155RuntimeBin = "0x608060405234801561001057600080fd5b50600436106100b35760003560e01c8063731133e911610071578063731133e91461015e5780638da5cb5b14610171578063a22cb4651461018c578063e985e9c51461019f578063f242432a146101db578063f2fde38b146101ee57600080fd5b8062fdd58e146100b857806301ffc9a7146100de5780630e89341c146101015780632eb2c2d6146101215780634e1273f414610136578063715018a614610156575b600080fd5b6100cb6100c6366004610da0565b610201565b6040519081526020015b60405180910390f35b6100f16100ec366004610de0565b61029c565b60405190151581526020016100d5565b61011461010f366004610e04565b6102ec565b6040516100d59190610e63565b61013461012f366004610fc2565b610380565b005b61014961014436600461106c565b6103cc565b6040516100d59190611172565b6101346104f6565b61013461016c366004611185565b61052c565b6000546040516001600160a01b0390911681526020016100d5565b61013461019a3660046111e6565b610568565b6100f16101ad366004611222565b6001600160a01b03918216600090815260026020908152604080832093909416825291909152205460ff1690565b6101346101e9366004611255565b610577565b6101346101fc3660046112ba565b6105bc565b60006001600160a01b0383166102715760405162461bcd60e51b815260206004820152602a60248201527f455243313135353a2061646472657373207a65726f206973206e6f742061207660448201526930b634b21037bbb732b960b11b60648201526084015b60405180910390fd5b5060008181526001602090815260408083206001600160a01b03861684529091529020545b92915050565b60006001600160e01b03198216636cdb3d1360e11b14806102cd57506001600160e01b031982166303a24d0760e21b145b8061029657506301ffc9a760e01b6001600160e01b0319831614610296565b6060600380546102fb906112d5565b80601f0160208091040260200160405190810160405280929190818152602001828054610327906112d5565b80156103745780601f1061034957610100808354040283529160200191610374565b820191906000526020600020905b81548152906001019060200180831161035757829003601f168201915b50505050509050919050565b6001600160a01b03851633148061039c575061039c85336101ad565b6103b85760405162461bcd60e51b81526004016102689061130f565b6103c58585858585610657565b5050505050565b606081518351146104315760405162461bcd60e51b815260206004820152602960248201527f455243313135353a206163636f756e747320616e6420696473206c656e677468604482015268040dad2e6dac2e8c6d60bb1b6064820152608401610268565b6000835167ffffffffffffffff81111561044d5761044d610e76565b604051908082528060200260200182016040528015610476578160200160208202803683370190505b50905060005b84518110156104ee576104c185828151811061049a5761049a61135d565b60200260200101518583815181106104b4576104b461135d565b6020026020010151610201565b8282815181106104d3576104d361135d565b60209081029190910101526104e781611389565b905061047c565b509392505050565b6000546001600160a01b031633146105205760405162461bcd60e51b8152600401610268906113a2565b61052a6000610837565b565b6000546001600160a01b031633146105565760405162461bcd60e51b8152600401610268906113a2565b61056284848484610887565b50505050565b61057333838361097b565b5050565b6001600160a01b038516331480610593575061059385336101ad565b6105af5760405162461bcd60e51b81526004016102689061130f565b6103c58585858585610a5b565b6000546001600160a01b031633146105e65760405162461bcd60e51b8152600401610268906113a2565b6001600160a01b03811661064b5760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610268565b61065481610837565b50565b81518351146106b95760405162461bcd60e51b815260206004820152602860248201527f455243313135353a2069647320616e6420616d6f756e7473206c656e677468206044820152670dad2e6dac2e8c6d60c31b6064820152608401610268565b6001600160a01b0384166106df5760405162461bcd60e51b8152600401610268906113d7565b3360005b84518110156107c95760008582815181106107005761070061135d565b60200260200101519050600085838151811061071e5761071e61135d565b60209081029190910181015160008481526001835260408082206001600160a01b038e16835290935291909120549091508181101561076f5760405162461bcd60e51b81526004016102689061141c565b60008381526001602090815260408083206001600160a01b038e8116855292528083208585039055908b168252812080548492906107ae908490611466565b92505081905550505050806107c290611389565b90506106e3565b50846001600160a01b0316866001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb8787604051610819929190611479565b60405180910390a461082f818787878787610b6e565b505050505050565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6001600160a01b0384166108e75760405162461bcd60e51b815260206004820152602160248201527f455243313135353a206d696e7420746f20746865207a65726f206164647265736044820152607360f81b6064820152608401610268565b60008381526001602090815260408083206001600160a01b038816845290915281208054339285929161091b908490611466565b909155505060408051858152602081018590526001600160a01b0380881692600092918516917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a46103c581600087878787610cc9565b816001600160a01b0316836001600160a01b0316036109ee5760405162461bcd60e51b815260206004820152602960248201527f455243313135353a2073657474696e6720617070726f76616c20737461747573604482015268103337b91039b2b63360b91b6064820152608401610268565b6001600160a01b03838116600081815260026020908152604080832094871680845294825291829020805460ff191686151590811790915591519182527f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a3505050565b6001600160a01b038416610a815760405162461bcd60e51b8152600401610268906113d7565b60008381526001602090815260408083206001600160a01b0389168452909152902054339083811015610ac65760405162461bcd60e51b81526004016102689061141c565b60008581526001602090815260408083206001600160a01b038b8116855292528083208785039055908816825281208054869290610b05908490611466565b909155505060408051868152602081018690526001600160a01b03808916928a821692918616917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a4610b65828888888888610cc9565b50505050505050565b6001600160a01b0384163b1561082f5760405163bc197c8160e01b81526001600160a01b0385169063bc197c8190610bb290899089908890889088906004016114a7565b6020604051808303816000875af1925050508015610bed575060408051601f3d908101601f19168201909252610bea91810190611505565b60015b610c9957610bf9611522565b806308c379a003610c325750610c0d61153e565b80610c185750610c34565b8060405162461bcd60e51b81526004016102689190610e63565b505b60405162461bcd60e51b815260206004820152603460248201527f455243313135353a207472616e7366657220746f206e6f6e2d455243313135356044820152732932b1b2b4bb32b91034b6b83632b6b2b73a32b960611b6064820152608401610268565b6001600160e01b0319811663bc197c8160e01b14610b655760405162461bcd60e51b8152600401610268906115c8565b6001600160a01b0384163b1561082f5760405163f23a6e6160e01b81526001600160a01b0385169063f23a6e6190610d0d9089908990889088908890600401611610565b6020604051808303816000875af1925050508015610d48575060408051601f3d908101601f19168201909252610d4591810190611505565b60015b610d5457610bf9611522565b6001600160e01b0319811663f23a6e6160e01b14610b655760405162461bcd60e51b8152600401610268906115c8565b80356001600160a01b0381168114610d9b57600080fd5b919050565b60008060408385031215610db357600080fd5b610dbc83610d84565b946020939093013593505050565b6001600160e01b03198116811461065457600080fd5b600060208284031215610df257600080fd5b8135610dfd81610dca565b9392505050565b600060208284031215610e1657600080fd5b5035919050565b6000815180845260005b81811015610e4357602081850181015186830182015201610e27565b506000602082860101526020601f19601f83011685010191505092915050565b602081526000610dfd6020830184610e1d565b634e487b7160e01b600052604160045260246000fd5b601f8201601f1916810167ffffffffffffffff81118282101715610eb257610eb2610e76565b6040525050565b600067ffffffffffffffff821115610ed357610ed3610e76565b5060051b60200190565b600082601f830112610eee57600080fd5b81356020610efb82610eb9565b604051610f088282610e8c565b83815260059390931b8501820192828101915086841115610f2857600080fd5b8286015b84811015610f435780358352918301918301610f2c565b509695505050505050565b600082601f830112610f5f57600080fd5b813567ffffffffffffffff811115610f7957610f79610e76565b604051610f90601f8301601f191660200182610e8c565b818152846020838601011115610fa557600080fd5b816020850160208301376000918101602001919091529392505050565b600080600080600060a08688031215610fda57600080fd5b610fe386610d84565b9450610ff160208701610d84565b9350604086013567ffffffffffffffff8082111561100e57600080fd5b61101a89838a01610edd565b9450606088013591508082111561103057600080fd5b61103c89838a01610edd565b9350608088013591508082111561105257600080fd5b5061105f88828901610f4e565b9150509295509295909350565b6000806040838503121561107f57600080fd5b823567ffffffffffffffff8082111561109757600080fd5b818501915085601f8301126110ab57600080fd5b813560206110b882610eb9565b6040516110c58282610e8c565b83815260059390931b85018201928281019150898411156110e557600080fd5b948201945b8386101561110a576110fb86610d84565b825294820194908201906110ea565b9650508601359250508082111561112057600080fd5b5061112d85828601610edd565b9150509250929050565b600081518084526020808501945080840160005b838110156111675781518752958201959082019060010161114b565b509495945050505050565b602081526000610dfd6020830184611137565b6000806000806080858703121561119b57600080fd5b6111a485610d84565b93506020850135925060408501359150606085013567ffffffffffffffff8111156111ce57600080fd5b6111da87828801610f4e565b91505092959194509250565b600080604083850312156111f957600080fd5b61120283610d84565b91506020830135801515811461121757600080fd5b809150509250929050565b6000806040838503121561123557600080fd5b61123e83610d84565b915061124c60208401610d84565b90509250929050565b600080600080600060a0868803121561126d57600080fd5b61127686610d84565b945061128460208701610d84565b93506040860135925060608601359150608086013567ffffffffffffffff8111156112ae57600080fd5b61105f88828901610f4e565b6000602082840312156112cc57600080fd5b610dfd82610d84565b600181811c908216806112e957607f821691505b60208210810361130957634e487b7160e01b600052602260045260246000fd5b50919050565b6020808252602e908201527f455243313135353a2063616c6c6572206973206e6f7420746f6b656e206f776e60408201526d195c881bdc88185c1c1c9bdd995960921b606082015260800190565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b60006001820161139b5761139b611373565b5060010190565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b60208082526025908201527f455243313135353a207472616e7366657220746f20746865207a65726f206164604082015264647265737360d81b606082015260800190565b6020808252602a908201527f455243313135353a20696e73756666696369656e742062616c616e636520666f60408201526939103a3930b739b332b960b11b606082015260800190565b8082018082111561029657610296611373565b60408152600061148c6040830185611137565b828103602084015261149e8185611137565b95945050505050565b6001600160a01b0386811682528516602082015260a0604082018190526000906114d390830186611137565b82810360608401526114e58186611137565b905082810360808401526114f98185610e1d565b98975050505050505050565b60006020828403121561151757600080fd5b8151610dfd81610dca565b600060033d111561153b5760046000803e5060005160e01c5b90565b600060443d101561154c5790565b6040516003193d81016004833e81513d67ffffffffffffffff816024840111818411171561157c57505050505090565b82850191508151818111156115945750505050505090565b843d87010160208285010111156115ae5750505050505090565b6115bd60208286010187610e8c565b509095945050505050565b60208082526028908201527f455243313135353a204552433131353552656365697665722072656a656374656040820152676420746f6b656e7360c01b606082015260800190565b6001600160a01b03868116825285166020820152604081018490526060810183905260a06080820181905260009061164a90830184610e1d565b97965050505050505056fea26469706673582212204939d707d42de9983d0b2846fdb140b91883ca605553e70636d6e32e78bb683b64736f6c63430008150033"
+
+// ERC1155SourceMap maps the instructions of ERC1155Bin to the Solidity sources
+const ERC1155SourceMap = "327:7255:0:-:0;;;701:60;;;;;;;;;;;;;;;;;;;;;;;;;;;;:::i;:::-;355:32:5;218:10:3;355:18:5;:32::i;:::-;743:4:0;:11;750:4;743;:11;:::i;:::-;;701:60;327:7255;;1145:187:5;1218:16;1237:6;;-1:-1:-1;;;;;1253:17:5;;;-1:-1:-1;;;;;;1253:17:5;;;;;;1285:40;;1237:6;;;;;;;1285:40;;1218:16;1285:40;1208:124;1145:187;:::o;14:127:6:-;75:10;70:3;66:20;63:1;56:31;106:4;103:1;96:15;130:4;127:1;120:15;146:1042;226:6;257:2;300;288:9;279:7;275:23;271:32;268:52;;;316:1;313;306:12;268:52;343:16;;-1:-1:-1;;;;;408:14:6;;;405:34;;;435:1;432;425:12;405:34;473:6;462:9;458:22;448:32;;518:7;511:4;507:2;503:13;499:27;489:55;;540:1;537;530:12;489:55;569:2;563:9;591:2;587;584:10;581:36;;;597:18;;:::i;:::-;672:2;666:9;640:2;726:13;;-1:-1:-1;;722:22:6;;;746:2;718:31;714:40;702:53;;;770:18;;;790:22;;;767:46;764:72;;;816:18;;:::i;:::-;856:10;852:2;845:22;891:2;883:6;876:18;931:7;926:2;921;917;913:11;909:20;906:33;903:53;;;952:1;949;942:12;903:53;974:1;965:10;;984:129;998:2;995:1;992:9;984:129;;;1086:10;;;1082:19;;1076:26;1055:14;;;1051:23;;1044:59;1009:10;;;;984:129;;;1155:1;1150:2;1145;1137:6;1133:15;1129:24;1122:35;1176:6;1166:16;;;;;;;;146:1042;;;;:::o;1193:380::-;1272:1;1268:12;;;;1315;;;1336:61;;1390:4;1382:6;1378:17;1368:27;;1336:61;1443:2;1435:6;1432:14;1412:18;1409:38;1406:161;;1489:10;1484:3;1480:20;1477:1;1470:31;1524:4;1521:1;1514:15;1552:4;1549:1;1542:15;1406:161;;1193:380;;;:::o;1704:545::-;1806:2;1801:3;1798:11;1795:448;;;1842:1;1867:5;1863:2;1856:17;1912:4;1908:2;1898:19;1982:2;1970:10;1966:19;1963:1;1959:27;1953:4;1949:38;2018:4;2006:10;2003:20;2000:47;;;-1:-1:-1;2041:4:6;2000:47;2096:2;2091:3;2087:12;2084:1;2080:20;2074:4;2070:31;2060:41;;2151:82;2169:2;2162:5;2159:13;2151:82;;;2214:17;;;2195:1;2184:13;2151:82;;;2155:3;;;1795:448;1704:545;;;:::o;2425:1352::-;2545:10;;-1:-1:-1;;;;;2567:30:6;;2564:56;;;2600:18;;:::i;:::-;2629:97;2719:6;2679:38;2711:4;2705:11;2679:38;:::i;:::-;2673:4;2629:97;:::i;:::-;2781:4;;2845:2;2834:14;;2862:1;2857:663;;;;3564:1;3581:6;3578:89;;;-1:-1:-1;3633:19:6;;;3627:26;3578:89;-1:-1:-1;;2382:1:6;2378:11;;;2374:24;2370:29;2360:40;2406:1;2402:11;;;2357:57;3680:81;;2827:944;;2857:663;1651:1;1644:14;;;1688:4;1675:18;;-1:-1:-1;;2893:20:6;;;3011:236;3025:7;3022:1;3019:14;3011:236;;;3114:19;;;3108:26;3093:42;;3206:27;;;;3174:1;3162:14;;;;3041:19;;3011:236;;;3015:3;3275:6;3266:7;3263:19;3260:201;;;3336:19;;;3330:26;-1:-1:-1;;3419:1:6;3415:14;;;3431:3;3411:24;3407:37;3403:42;3388:58;3373:74;;3260:201;-1:-1:-1;;;;;3507:1:6;3491:14;;;3487:22;3474:36;;-1:-1:-1;2425:1352:6:o;:::-;327:7255:0;;;;;;"
+
+// ERC1155RuntimeSourceMap maps the instructions of ERC1155RuntimeBin to the Solidity sources
+const ERC1155RuntimeSourceMap = "327:7255:0:-:0;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;1373:227;;;;;;:::i;:::-;;:::i;:::-;;;597:25:6;;;585:2;570:18;1373:227:0;;;;;;;;836:305;;;;;;:::i;:::-;;:::i;:::-;;;1184:14:6;;1177:22;1159:41;;1147:2;1132:18;836:305:0;1019:187:6;1195:103:0;;;;;;:::i;:::-;;:::i;:::-;;;;;;;:::i;3548:380::-;;;;;;:::i;:::-;;:::i;:::-;;1677:462;;;;;;:::i;:::-;;:::i;:::-;;;;;;;:::i;769:101:5:-;;;:::i;2799:142:0:-;;;;;;:::i;:::-;;:::i;574:85:5:-;620:7;646:6;574:85;;-1:-1:-1;;;;;646:6:5;;;7461:51:6;;7449:2;7434:18;574:85:5;7315:203:6;2221:153:0;;;;;;:::i;:::-;;:::i;2480:166::-;;;;;;:::i;:::-;-1:-1:-1;;;;;2602:27:0;;;2579:4;2602:27;;;:18;:27;;;;;;;;:37;;;;;;;;;;;;;;;2480:166;3067:348;;;;;;:::i;:::-;;:::i;941:198:5:-;;;;;;:::i;:::-;;:::i;1373:227:0:-;1459:7;-1:-1:-1;;;;;1486:21:0;;1478:76;;;;-1:-1:-1;;;1478:76:0;;9144:2:6;1478:76:0;;;9126:21:6;9183:2;9163:18;;;9156:30;9222:34;9202:18;;;9195:62;-1:-1:-1;;;9273:18:6;;;9266:40;9323:19;;1478:76:0;;;;;;;;;-1:-1:-1;1571:13:0;;;;:9;:13;;;;;;;;-1:-1:-1;;;;;1571:22:0;;;;;;;;;;1373:227;;;;;:::o;836:305::-;938:4;-1:-1:-1;;;;;;973:41:0;;-1:-1:-1;;;973:41:0;;:109;;-1:-1:-1;;;;;;;1030:52:0;;-1:-1:-1;;;1030:52:0;973:109;:161;;;-1:-1:-1;;;;;;;;;;390:40:4;;;1098:36:0;282:155:4;1195:103:0;1255:13;1287:4;1280:11;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;1195:103;;;:::o;3548:380::-;-1:-1:-1;;;;;3727:20:0;;218:10:3;3727:20:0;;:60;;-1:-1:-1;3751:36:0;3768:4;218:10:3;2480:166:0;:::i;3751:36::-;3706:153;;;;-1:-1:-1;;;3706:153:0;;;;;;;:::i;:::-;3869:52;3892:4;3898:2;3902:3;3907:7;3916:4;3869:22;:52::i;:::-;3548:380;;;;;:::o;1677:462::-;1788:16;1843:3;:10;1824:8;:15;:29;1816:83;;;;-1:-1:-1;;;1816:83:0;;10355:2:6;1816:83:0;;;10337:21:6;10394:2;10374:18;;;10367:30;10433:34;10413:18;;;10406:62;-1:-1:-1;;;10484:18:6;;;10477:39;10533:19;;1816:83:0;10153:405:6;1816:83:0;1910:30;1957:8;:15;1943:30;;;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;-1:-1:-1;1943:30:0;;1910:63;;1988:9;1983:120;2007:8;:15;2003:1;:19;1983:120;;;2062:30;2072:8;2081:1;2072:11;;;;;;;;:::i;:::-;;;;;;;2085:3;2089:1;2085:6;;;;;;;;:::i;:::-;;;;;;;2062:9;:30::i;:::-;2043:13;2057:1;2043:16;;;;;;;;:::i;:::-;;;;;;;;;;:49;2024:3;;;:::i;:::-;;;1983:120;;;-1:-1:-1;2119:13:0;1677:462;-1:-1:-1;;;1677:462:0:o;769:101:5:-;620:7;646:6;-1:-1:-1;;;;;646:6:5;218:10:3;439:23:5;431:68;;;;-1:-1:-1;;;431:68:5;;;;;;;:::i;:::-;833:30:::1;860:1;833:18;:30::i;:::-;769:101::o:0;2799:142:0:-;620:7:5;646:6;-1:-1:-1;;;;;646:6:5;218:10:3;439:23:5;431:68;;;;-1:-1:-1;;;431:68:5;;;;;;;:::i;:::-;2907:27:0::1;2913:2;2917;2921:6;2929:4;2907:5;:27::i;:::-;2799:142:::0;;;;:::o;2221:153::-;2315:52;218:10:3;2348:8:0;2358;2315:18;:52::i;:::-;2221:153;;:::o;3067:348::-;-1:-1:-1;;;;;3221:20:0;;218:10:3;3221:20:0;;:60;;-1:-1:-1;3245:36:0;3262:4;218:10:3;2480:166:0;:::i;3245:36::-;3200:153;;;;-1:-1:-1;;;3200:153:0;;;;;;;:::i;:::-;3363:45;3381:4;3387:2;3391;3395:6;3403:4;3363:17;:45::i;941:198:5:-;620:7;646:6;-1:-1:-1;;;;;646:6:5;218:10:3;439:23:5;431:68;;;;-1:-1:-1;;;431:68:5;;;;;;;:::i;:::-;-1:-1:-1;;;;;1029:22:5;::::1;1021:73;;;::::0;-1:-1:-1;;;1021:73:5;;11530:2:6;1021:73:5::1;::::0;::::1;11512:21:6::0;11569:2;11549:18;;;11542:30;11608:34;11588:18;;;11581:62;-1:-1:-1;;;11659:18:6;;;11652:36;11705:19;;1021:73:5::1;11328:402:6::0;1021:73:5::1;1104:28;1123:8;1104:18;:28::i;:::-;941:198:::0;:::o;4582:926:0:-;4756:7;:14;4742:3;:10;:28;4734:81;;;;-1:-1:-1;;;4734:81:0;;11937:2:6;4734:81:0;;;11919:21:6;11976:2;11956:18;;;11949:30;12015:34;11995:18;;;11988:62;-1:-1:-1;;;12066:18:6;;;12059:38;12114:19;;4734:81:0;11735:404:6;4734:81:0;-1:-1:-1;;;;;4833:16:0;;4825:66;;;;-1:-1:-1;;;4825:66:0;;;;;;;:::i;:::-;218:10:3;4902:16:0;4943:411;4967:3;:10;4963:1;:14;4943:411;;;4998:10;5011:3;5015:1;5011:6;;;;;;;;:::i;:::-;;;;;;;4998:19;;5031:14;5048:7;5056:1;5048:10;;;;;;;;:::i;:::-;;;;;;;;;;;;5073:19;5095:13;;;:9;:13;;;;;;-1:-1:-1;;;;;5095:19:0;;;;;;;;;;;;5048:10;;-1:-1:-1;5136:21:0;;;;5128:76;;;;-1:-1:-1;;;5128:76:0;;;;;;;:::i;:::-;5246:13;;;;:9;:13;;;;;;;;-1:-1:-1;;;;;5246:19:0;;;;;;;;;;5268:20;;;5246:42;;5316:17;;;;;;;:27;;5268:20;;5246:13;5316:27;;5268:20;;5316:27;:::i;:::-;;;;;;;;4984:370;;;4979:3;;;;:::i;:::-;;;4943:411;;;;5399:2;-1:-1:-1;;;;;5369:47:0;5393:4;-1:-1:-1;;;;;5369:47:0;5383:8;-1:-1:-1;;;;;5369:47:0;;5403:3;5408:7;5369:47;;;;;;;:::i;:::-;;;;;;;;5426:75;5462:8;5472:4;5478:2;5482:3;5487:7;5496:4;5426:35;:75::i;:::-;4724:784;4582:926;;;;;:::o;1145:187:5:-;1218:16;1237:6;;-1:-1:-1;;;;;1253:17:5;;;-1:-1:-1;;;;;;1253:17:5;;;;;;1285:40;;1237:6;;;;;;;1285:40;;1218:16;1285:40;1208:124;1145:187;:::o;5514:400:0:-;-1:-1:-1;;;;;5623:16:0;;5615:62;;;;-1:-1:-1;;;5615:62:0;;13763:2:6;5615:62:0;;;13745:21:6;13802:2;13782:18;;;13775:30;13841:34;13821:18;;;13814:62;-1:-1:-1;;;13892:18:6;;;13885:31;13933:19;;5615:62:0;13561:397:6;5615:62:0;5688:16;5729:13;;;:9;:13;;;;;;;;-1:-1:-1;;;;;5729:17:0;;;;;;;;;:27;;218:10:3;;5750:6:0;;5729:17;:27;;5750:6;;5729:27;:::i;:::-;;;;-1:-1:-1;;5771:52:0;;;14137:25:6;;;14193:2;14178:18;;14171:34;;;-1:-1:-1;;;;;5771:52:0;;;;5804:1;;5771:52;;;;;;14110:18:6;5771:52:0;;;;;;;5833:74;5864:8;5882:1;5886:2;5890;5894:6;5902:4;5833:30;:74::i;5920:293::-;6040:8;-1:-1:-1;;;;;6031:17:0;:5;-1:-1:-1;;;;;6031:17:0;;6023:71;;;;-1:-1:-1;;;6023:71:0;;14418:2:6;6023:71:0;;;14400:21:6;14457:2;14437:18;;;14430:30;14496:34;14476:18;;;14469:62;-1:-1:-1;;;14547:18:6;;;14540:39;14596:19;;6023:71:0;14216:405:6;6023:71:0;-1:-1:-1;;;;;6104:25:0;;;;;;;:18;:25;;;;;;;;:35;;;;;;;;;;;;;:46;;-1:-1:-1;;6104:46:0;;;;;;;;;;6165:41;;1159::6;;;6165::0;;1132:18:6;6165:41:0;;;;;;;5920:293;;;:::o;3934:642::-;-1:-1:-1;;;;;4069:16:0;;4061:66;;;;-1:-1:-1;;;4061:66:0;;;;;;;:::i;:::-;4138:16;4201:13;;;:9;:13;;;;;;;;-1:-1:-1;;;;;4201:19:0;;;;;;;;;;218:10:3;;4238:21:0;;;;4230:76;;;;-1:-1:-1;;;4230:76:0;;;;;;;:::i;:::-;4340:13;;;;:9;:13;;;;;;;;-1:-1:-1;;;;;4340:19:0;;;;;;;;;;4362:20;;;4340:42;;4402:17;;;;;;;:27;;4362:20;;4340:13;4402:27;;4362:20;;4402:27;:::i;:::-;;;;-1:-1:-1;;4445:46:0;;;14137:25:6;;;14193:2;14178:18;;14171:34;;;-1:-1:-1;;;;;4445:46:0;;;;;;;;;;;;;;14110:18:6;4445:46:0;;;;;;;4501:68;4532:8;4542:4;4548:2;4552;4556:6;4564:4;4501:30;:68::i;:::-;4051:525;;3934:642;;;;;:::o;6884:696::-;-1:-1:-1;;;;;7062:14:0;;;7058:56;7097:7;7058:56;7127:79;;-1:-1:-1;;;7127:79:0;;-1:-1:-1;;;;;7127:43:0;;;;;:79;;7171:8;;7181:4;;7187:3;;7192:7;;7201:4;;7127:79;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;-1:-1:-1;7127:79:0;;;;;;;;-1:-1:-1;;7127:79:0;;;;;;;;;;;;:::i;:::-;;;7123:451;;;;:::i;:::-;;;;;;;;;:::i;:::-;;;;;;;;7462:6;7455:14;;-1:-1:-1;;;7455:14:0;;;;;;;;:::i;7123:451::-;;;7501:62;;-1:-1:-1;;;7501:62:0;;16774:2:6;7501:62:0;;;16756:21:6;16813:2;16793:18;;;16786:30;16852:34;16832:18;;;16825:62;-1:-1:-1;;;16903:18:6;;;16896:50;16963:19;;7501:62:0;16572:416:6;7123:451:0;-1:-1:-1;;;;;;7251:60:0;;-1:-1:-1;;;7251:60:0;7247:149;;7331:50;;-1:-1:-1;;;7331:50:0;;;;;;;:::i;6219:659::-;-1:-1:-1;;;;;6372:14:0;;;6368:56;6407:7;6368:56;6437:72;;-1:-1:-1;;;6437:72:0;;-1:-1:-1;;;;;6437:38:0;;;;;:72;;6476:8;;6486:4;;6492:2;;6496:6;;6504:4;;6437:72;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;-1:-1:-1;6437:72:0;;;;;;;;-1:-1:-1;;6437:72:0;;;;;;;;;;;;:::i;:::-;;;6433:439;;;;:::i;:::-;-1:-1:-1;;;;;;6554:55:0;;-1:-1:-1;;;6554:55:0;6550:144;;6629:50;;-1:-1:-1;;;6629:50:0;;;;;;;:::i;14:173:6:-;82:20;;-1:-1:-1;;;;;131:31:6;;121:42;;111:70;;177:1;174;167:12;111:70;14:173;;;:::o;192:254::-;260:6;268;321:2;309:9;300:7;296:23;292:32;289:52;;;337:1;334;327:12;289:52;360:29;379:9;360:29;:::i;:::-;350:39;436:2;421:18;;;;408:32;;-1:-1:-1;;;192:254:6:o;633:131::-;-1:-1:-1;;;;;;707:32:6;;697:43;;687:71;;754:1;751;744:12;769:245;827:6;880:2;868:9;859:7;855:23;851:32;848:52;;;896:1;893;886:12;848:52;935:9;922:23;954:30;978:5;954:30;:::i;:::-;1003:5;769:245;-1:-1:-1;;;769:245:6:o;1211:180::-;1270:6;1323:2;1311:9;1302:7;1298:23;1294:32;1291:52;;;1339:1;1336;1329:12;1291:52;-1:-1:-1;1362:23:6;;1211:180;-1:-1:-1;1211:180:6:o;1396:423::-;1438:3;1476:5;1470:12;1503:6;1498:3;1491:19;1528:1;1538:162;1552:6;1549:1;1546:13;1538:162;;;1614:4;1670:13;;;1666:22;;1660:29;1642:11;;;1638:20;;1631:59;1567:12;1538:162;;;1542:3;1745:1;1738:4;1729:6;1724:3;1720:16;1716:27;1709:38;1808:4;1801:2;1797:7;1792:2;1784:6;1780:15;1776:29;1771:3;1767:39;1763:50;1756:57;;;1396:423;;;;:::o;1824:220::-;1973:2;1962:9;1955:21;1936:4;1993:45;2034:2;2023:9;2019:18;2011:6;1993:45;:::i;2049:127::-;2110:10;2105:3;2101:20;2098:1;2091:31;2141:4;2138:1;2131:15;2165:4;2162:1;2155:15;2181:249;2291:2;2272:13;;-1:-1:-1;;2268:27:6;2256:40;;2326:18;2311:34;;2347:22;;;2308:62;2305:88;;;2373:18;;:::i;:::-;2409:2;2402:22;-1:-1:-1;;2181:249:6:o;2435:183::-;2495:4;2528:18;2520:6;2517:30;2514:56;;;2550:18;;:::i;:::-;-1:-1:-1;2595:1:6;2591:14;2607:4;2587:25;;2435:183::o;2623:724::-;2677:5;2730:3;2723:4;2715:6;2711:17;2707:27;2697:55;;2748:1;2745;2738:12;2697:55;2784:6;2771:20;2810:4;2833:43;2873:2;2833:43;:::i;:::-;2905:2;2899:9;2917:31;2945:2;2937:6;2917:31;:::i;:::-;2983:18;;;3075:1;3071:10;;;;3059:23;;3055:32;;;3017:15;;;;-1:-1:-1;3099:15:6;;;3096:35;;;3127:1;3124;3117:12;3096:35;3163:2;3155:6;3151:15;3175:142;3191:6;3186:3;3183:15;3175:142;;;3257:17;;3245:30;;3295:12;;;;3208;;3175:142;;;-1:-1:-1;3335:6:6;2623:724;-1:-1:-1;;;;;;2623:724:6:o;3352:555::-;3394:5;3447:3;3440:4;3432:6;3428:17;3424:27;3414:55;;3465:1;3462;3455:12;3414:55;3501:6;3488:20;3527:18;3523:2;3520:26;3517:52;;;3549:18;;:::i;:::-;3598:2;3592:9;3610:67;3665:2;3646:13;;-1:-1:-1;;3642:27:6;3671:4;3638:38;3592:9;3610:67;:::i;:::-;3701:2;3693:6;3686:18;3747:3;3740:4;3735:2;3727:6;3723:15;3719:26;3716:35;3713:55;;;3764:1;3761;3754:12;3713:55;3828:2;3821:4;3813:6;3809:17;3802:4;3794:6;3790:17;3777:54;3875:1;3851:15;;;3868:4;3847:26;3840:37;;;;3855:6;3352:555;-1:-1:-1;;;3352:555:6:o;3912:943::-;4066:6;4074;4082;4090;4098;4151:3;4139:9;4130:7;4126:23;4122:33;4119:53;;;4168:1;4165;4158:12;4119:53;4191:29;4210:9;4191:29;:::i;:::-;4181:39;;4239:38;4273:2;4262:9;4258:18;4239:38;:::i;:::-;4229:48;;4328:2;4317:9;4313:18;4300:32;4351:18;4392:2;4384:6;4381:14;4378:34;;;4408:1;4405;4398:12;4378:34;4431:61;4484:7;4475:6;4464:9;4460:22;4431:61;:::i;:::-;4421:71;;4545:2;4534:9;4530:18;4517:32;4501:48;;4574:2;4564:8;4561:16;4558:36;;;4590:1;4587;4580:12;4558:36;4613:63;4668:7;4657:8;4646:9;4642:24;4613:63;:::i;:::-;4603:73;;4729:3;4718:9;4714:19;4701:33;4685:49;;4759:2;4749:8;4746:16;4743:36;;;4775:1;4772;4765:12;4743:36;;4798:51;4841:7;4830:8;4819:9;4815:24;4798:51;:::i;:::-;4788:61;;;3912:943;;;;;;;;:::o;4860:1208::-;4978:6;4986;5039:2;5027:9;5018:7;5014:23;5010:32;5007:52;;;5055:1;5052;5045:12;5007:52;5095:9;5082:23;5124:18;5165:2;5157:6;5154:14;5151:34;;;5181:1;5178;5171:12;5151:34;5219:6;5208:9;5204:22;5194:32;;5264:7;5257:4;5253:2;5249:13;5245:27;5235:55;;5286:1;5283;5276:12;5235:55;5322:2;5309:16;5344:4;5367:43;5407:2;5367:43;:::i;:::-;5439:2;5433:9;5451:31;5479:2;5471:6;5451:31;:::i;:::-;5517:18;;;5605:1;5601:10;;;;5593:19;;5589:28;;;5551:15;;;;-1:-1:-1;5629:19:6;;;5626:39;;;5661:1;5658;5651:12;5626:39;5685:11;;;;5705:148;5721:6;5716:3;5713:15;5705:148;;;5787:23;5806:3;5787:23;:::i;:::-;5775:36;;5738:12;;;;5831;;;;5705:148;;;5872:6;-1:-1:-1;;5916:18:6;;5903:32;;-1:-1:-1;;5947:16:6;;;5944:36;;;5976:1;5973;5966:12;5944:36;;5999:63;6054:7;6043:8;6032:9;6028:24;5999:63;:::i;:::-;5989:73;;;4860:1208;;;;;:::o;6073:435::-;6126:3;6164:5;6158:12;6191:6;6186:3;6179:19;6217:4;6246:2;6241:3;6237:12;6230:19;;6283:2;6276:5;6272:14;6304:1;6314:169;6328:6;6325:1;6322:13;6314:169;;;6389:13;;6377:26;;6423:12;;;;6458:15;;;;6350:1;6343:9;6314:169;;;-1:-1:-1;6499:3:6;;6073:435;-1:-1:-1;;;;;6073:435:6:o;6513:261::-;6692:2;6681:9;6674:21;6655:4;6712:56;6764:2;6753:9;6749:18;6741:6;6712:56;:::i;6779:531::-;6874:6;6882;6890;6898;6951:3;6939:9;6930:7;6926:23;6922:33;6919:53;;;6968:1;6965;6958:12;6919:53;6991:29;7010:9;6991:29;:::i;:::-;6981:39;;7067:2;7056:9;7052:18;7039:32;7029:42;;7118:2;7107:9;7103:18;7090:32;7080:42;;7173:2;7162:9;7158:18;7145:32;7200:18;7192:6;7189:30;7186:50;;;7232:1;7229;7222:12;7186:50;7255:49;7296:7;7287:6;7276:9;7272:22;7255:49;:::i;:::-;7245:59;;;6779:531;;;;;;;:::o;7523:347::-;7588:6;7596;7649:2;7637:9;7628:7;7624:23;7620:32;7617:52;;;7665:1;7662;7655:12;7617:52;7688:29;7707:9;7688:29;:::i;:::-;7678:39;;7767:2;7756:9;7752:18;7739:32;7814:5;7807:13;7800:21;7793:5;7790:32;7780:60;;7836:1;7833;7826:12;7780:60;7859:5;7849:15;;;7523:347;;;;;:::o;7875:260::-;7943:6;7951;8004:2;7992:9;7983:7;7979:23;7975:32;7972:52;;;8020:1;8017;8010:12;7972:52;8043:29;8062:9;8043:29;:::i;:::-;8033:39;;8091:38;8125:2;8114:9;8110:18;8091:38;:::i;:::-;8081:48;;7875:260;;;;;:::o;8140:606::-;8244:6;8252;8260;8268;8276;8329:3;8317:9;8308:7;8304:23;8300:33;8297:53;;;8346:1;8343;8336:12;8297:53;8369:29;8388:9;8369:29;:::i;:::-;8359:39;;8417:38;8451:2;8440:9;8436:18;8417:38;:::i;:::-;8407:48;;8502:2;8491:9;8487:18;8474:32;8464:42;;8553:2;8542:9;8538:18;8525:32;8515:42;;8608:3;8597:9;8593:19;8580:33;8636:18;8628:6;8625:30;8622:50;;;8668:1;8665;8658:12;8622:50;8691:49;8732:7;8723:6;8712:9;8708:22;8691:49;:::i;8751:186::-;8810:6;8863:2;8851:9;8842:7;8838:23;8834:32;8831:52;;;8879:1;8876;8869:12;8831:52;8902:29;8921:9;8902:29;:::i;9353:380::-;9432:1;9428:12;;;;9475;;;9496:61;;9550:4;9542:6;9538:17;9528:27;;9496:61;9603:2;9595:6;9592:14;9572:18;9569:38;9566:161;;9649:10;9644:3;9640:20;9637:1;9630:31;9684:4;9681:1;9674:15;9712:4;9709:1;9702:15;9566:161;;9353:380;;;:::o;9738:410::-;9940:2;9922:21;;;9979:2;9959:18;;;9952:30;10018:34;10013:2;9998:18;;9991:62;-1:-1:-1;;;10084:2:6;10069:18;;10062:44;10138:3;10123:19;;9738:410::o;10563:127::-;10624:10;10619:3;10615:20;10612:1;10605:31;10655:4;10652:1;10645:15;10679:4;10676:1;10669:15;10695:127;10756:10;10751:3;10747:20;10744:1;10737:31;10787:4;10784:1;10777:15;10811:4;10808:1;10801:15;10827:135;10866:3;10887:17;;;10884:43;;10907:18;;:::i;:::-;-1:-1:-1;10954:1:6;10943:13;;10827:135::o;10967:356::-;11169:2;11151:21;;;11188:18;;;11181:30;11247:34;11242:2;11227:18;;11220:62;11314:2;11299:18;;10967:356::o;12144:401::-;12346:2;12328:21;;;12385:2;12365:18;;;12358:30;12424:34;12419:2;12404:18;;12397:62;-1:-1:-1;;;12490:2:6;12475:18;;12468:35;12535:3;12520:19;;12144:401::o;12550:406::-;12752:2;12734:21;;;12791:2;12771:18;;;12764:30;12830:34;12825:2;12810:18;;12803:62;-1:-1:-1;;;12896:2:6;12881:18;;12874:40;12946:3;12931:19;;12550:406::o;12961:125::-;13026:9;;;13047:10;;;13044:36;;;13060:18;;:::i;13091:465::-;13348:2;13337:9;13330:21;13311:4;13374:56;13426:2;13415:9;13411:18;13403:6;13374:56;:::i;:::-;13478:9;13470:6;13466:22;13461:2;13450:9;13446:18;13439:50;13506:44;13543:6;13535;13506:44;:::i;:::-;13498:52;13091:465;-1:-1:-1;;;;;13091:465:6:o;14626:827::-;-1:-1:-1;;;;;15023:15:6;;;15005:34;;15075:15;;15070:2;15055:18;;15048:43;14985:3;15122:2;15107:18;;15100:31;;;14948:4;;15154:57;;15191:19;;15183:6;15154:57;:::i;:::-;15259:9;15251:6;15247:22;15242:2;15231:9;15227:18;15220:50;15293:44;15330:6;15322;15293:44;:::i;:::-;15279:58;;15386:9;15378:6;15374:22;15368:3;15357:9;15353:19;15346:51;15414:33;15440:6;15432;15414:33;:::i;:::-;15406:41;14626:827;-1:-1:-1;;;;;;;;14626:827:6:o;15458:249::-;15527:6;15580:2;15568:9;15559:7;15555:23;15551:32;15548:52;;;15596:1;15593;15586:12;15548:52;15628:9;15622:16;15647:30;15671:5;15647:30;:::i;15712:179::-;15747:3;15789:1;15771:16;15768:23;15765:120;;;15835:1;15832;15829;15814:23;-1:-1:-1;15872:1:6;15866:8;15861:3;15857:18;15765:120;15712:179;:::o;15896:671::-;15935:3;15977:4;15959:16;15956:26;15953:39;;;15896:671;:::o;15953:39::-;16019:2;16013:9;-1:-1:-1;;16084:16:6;16080:25;;16077:1;16013:9;16056:50;16135:4;16129:11;16159:16;16194:18;16265:2;16258:4;16250:6;16246:17;16243:25;16238:2;16230:6;16227:14;16224:45;16221:58;;;16272:5;;;;;15896:671;:::o;16221:58::-;16309:6;16303:4;16299:17;16288:28;;16345:3;16339:10;16372:2;16364:6;16361:14;16358:27;;;16378:5;;;;;;15896:671;:::o;16358:27::-;16462:2;16443:16;16437:4;16433:27;16429:36;16422:4;16413:6;16408:3;16404:16;16400:27;16397:69;16394:82;;;16469:5;;;;;;15896:671;:::o;16394:82::-;16485:57;16536:4;16527:6;16519;16515:19;16511:30;16505:4;16485:57;:::i;:::-;-1:-1:-1;16558:3:6;;15896:671;-1:-1:-1;;;;;15896:671:6:o;16993:404::-;17195:2;17177:21;;;17234:2;17214:18;;;17207:30;17273:34;17268:2;17253:18;;17246:62;-1:-1:-1;;;17339:2:6;17324:18;;17317:38;17387:3;17372:19;;16993:404::o;17402:561::-;-1:-1:-1;;;;;17699:15:6;;;17681:34;;17751:15;;17746:2;17731:18;;17724:43;17798:2;17783:18;;17776:34;;;17841:2;17826:18;;17819:34;;;17661:3;17884;17869:19;;17862:32;;;17624:4;;17911:46;;17937:19;;17929:6;17911:46;:::i;:::-;17903:54;17402:561;-1:-1:-1;;;;;;;17402:561:6:o"
+
+// ERC1155FuncSigs maps the 4-byte function signature to its string representation.
+var ERC1155FuncSigs = map[string]string{
+	"00fdd58e": "balanceOf(address,uint256)",
+	"4e1273f4": "balanceOfBatch(address[],uint256[])",
+	"e985e9c5": "isApprovedForAll(address,address)",
+	"731133e9": "mint(address,uint256,uint256,bytes)",
+	"8da5cb5b": "owner()",
+	"715018a6": "renounceOwnership()",
+	"2eb2c2d6": "safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)",
+	"f242432a": "safeTransferFrom(address,address,uint256,uint256,bytes)",
+	"a22cb465": "setApprovalForAll(address,bool)",
+	"01ffc9a7": "supportsInterface(bytes4)",
+	"f2fde38b": "transferOwnership(address)",
+	"0e89341c": "uri(uint256)",
+}
@@ -0,0 +1,767 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package erc1155
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+)
+
+// ERC1155Mock is a scriptable implementation of ERC1155Interface for unit tests.
+// Results are scripted per method and arguments, for example
+//
+//	mock.OnMethod(args...).Return(results..., err)
+//
+// Calls that were not scripted return zero values and an error.
+type ERC1155Mock struct {
+	mu    sync.Mutex
+	calls []ERC1155MockCall
+
+	onBalanceOf         []*ERC1155BalanceOfMock
+	onBalanceOfBatch    []*ERC1155BalanceOfBatchMock
+	onIsApprovedForAll  []*ERC1155IsApprovedForAllMock
+	onOwner             []*ERC1155OwnerMock
+	onSupportsInterface []*ERC1155SupportsInterfaceMock
+	onUri               []*ERC1155UriMock
+
+	onMint                  []*ERC1155MintMock
+	onRenounceOwnership     []*ERC1155RenounceOwnershipMock
+	onSafeBatchTransferFrom []*ERC1155SafeBatchTransferFromMock
+	onSafeTransferFrom      []*ERC1155SafeTransferFromMock
+	onSetApprovalForAll     []*ERC1155SetApprovalForAllMock
+	onTransferOwnership     []*ERC1155TransferOwnershipMock
+
+	onUnpackApprovalForAllLog       []*ERC1155UnpackApprovalForAllLogMock
+	onUnpackOwnershipTransferredLog []*ERC1155UnpackOwnershipTransferredLogMock
+	onUnpackTransferBatchLog        []*ERC1155UnpackTransferBatchLogMock
+	onUnpackTransferSingleLog       []*ERC1155UnpackTransferSingleLogMock
+	onUnpackURILog                  []*ERC1155UnpackURILogMock
+}
+
+// This nil assignment ensures at compile time that ERC1155Mock implements ERC1155Interface.
+var _ ERC1155Interface = (*ERC1155Mock)(nil)
+
+// ERC1155MockCall records a single call made to a ERC1155Mock.
+type ERC1155MockCall struct {
+	Method string        // Name of the called method
+	Args   []interface{} // Arguments passed to the method, excluding opts
+}
+
+// MockCalls returns every call made to the mock so far, in order.
+func (_m *ERC1155Mock) MockCalls() []ERC1155MockCall {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	return append([]ERC1155MockCall(nil), _m.calls...)
+}
+
+// matchERC1155MockArgs compares scripted and received arguments, treating big
+// integers as equal whenever they hold the same value.
+func matchERC1155MockArgs(want, got []interface{}) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		w, wok := want[i].(*big.Int)
+		g, gok := got[i].(*big.Int)
+		if wok && gok && w != nil && g != nil {
+			if w.Cmp(g) != 0 {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(want[i], got[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// ERC1155BalanceOfMock scripts the results of ERC1155Mock.BalanceOf.
+type ERC1155BalanceOfMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnBalanceOf scripts the results of BalanceOf calls made with the given arguments.
+func (_m *ERC1155Mock) OnBalanceOf(account common.Address, id *big.Int) *ERC1155BalanceOfMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155BalanceOfMock{mock: _m, args: []interface{}{account, id}}
+	_m.onBalanceOf = append(_m.onBalanceOf, _e)
+	return _e
+}
+
+// Return sets the results of the BalanceOf calls matching the scripted arguments.
+func (_e *ERC1155BalanceOfMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// BalanceOf records the call and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) BalanceOf(opts *bind.CallOpts, account common.Address, id *big.Int) (*big.Int, error) {
+	_args := []interface{}{account, id}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "BalanceOf", Args: _args})
+	for _i := len(_m.onBalanceOf) - 1; _i >= 0; _i-- {
+		if _e := _m.onBalanceOf[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC1155BalanceOfMock
+	return _e.ret0, fmt.Errorf("ERC1155Mock: no results scripted for BalanceOf%v", _args)
+}
+
+// ERC1155BalanceOfBatchMock scripts the results of ERC1155Mock.BalanceOfBatch.
+type ERC1155BalanceOfBatchMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	ret0 []*big.Int
+	err  error
+}
+
+// OnBalanceOfBatch scripts the results of BalanceOfBatch calls made with the given arguments.
+func (_m *ERC1155Mock) OnBalanceOfBatch(accounts []common.Address, ids []*big.Int) *ERC1155BalanceOfBatchMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155BalanceOfBatchMock{mock: _m, args: []interface{}{accounts, ids}}
+	_m.onBalanceOfBatch = append(_m.onBalanceOfBatch, _e)
+	return _e
+}
+
+// Return sets the results of the BalanceOfBatch calls matching the scripted arguments.
+func (_e *ERC1155BalanceOfBatchMock) Return(ret0 []*big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// BalanceOfBatch records the call and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) BalanceOfBatch(opts *bind.CallOpts, accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	_args := []interface{}{accounts, ids}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "BalanceOfBatch", Args: _args})
+	for _i := len(_m.onBalanceOfBatch) - 1; _i >= 0; _i-- {
+		if _e := _m.onBalanceOfBatch[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC1155BalanceOfBatchMock
+	return _e.ret0, fmt.Errorf("ERC1155Mock: no results scripted for BalanceOfBatch%v", _args)
+}
+
+// ERC1155IsApprovedForAllMock scripts the results of ERC1155Mock.IsApprovedForAll.
+type ERC1155IsApprovedForAllMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	ret0 bool
+	err  error
+}
+
+// OnIsApprovedForAll scripts the results of IsApprovedForAll calls made with the given arguments.
+func (_m *ERC1155Mock) OnIsApprovedForAll(account common.Address, operator common.Address) *ERC1155IsApprovedForAllMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155IsApprovedForAllMock{mock: _m, args: []interface{}{account, operator}}
+	_m.onIsApprovedForAll = append(_m.onIsApprovedForAll, _e)
+	return _e
+}
+
+// Return sets the results of the IsApprovedForAll calls matching the scripted arguments.
+func (_e *ERC1155IsApprovedForAllMock) Return(ret0 bool, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// IsApprovedForAll records the call and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) IsApprovedForAll(opts *bind.CallOpts, account common.Address, operator common.Address) (bool, error) {
+	_args := []interface{}{account, operator}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "IsApprovedForAll", Args: _args})
+	for _i := len(_m.onIsApprovedForAll) - 1; _i >= 0; _i-- {
+		if _e := _m.onIsApprovedForAll[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC1155IsApprovedForAllMock
+	return _e.ret0, fmt.Errorf("ERC1155Mock: no results scripted for IsApprovedForAll%v", _args)
+}
+
+// ERC1155OwnerMock scripts the results of ERC1155Mock.Owner.
+type ERC1155OwnerMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	ret0 common.Address
+	err  error
+}
+
+// OnOwner scripts the results of Owner calls made with the given arguments.
+func (_m *ERC1155Mock) OnOwner() *ERC1155OwnerMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155OwnerMock{mock: _m, args: []interface{}{}}
+	_m.onOwner = append(_m.onOwner, _e)
+	return _e
+}
+
+// Return sets the results of the Owner calls matching the scripted arguments.
+func (_e *ERC1155OwnerMock) Return(ret0 common.Address, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Owner records the call and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) Owner(opts *bind.CallOpts) (common.Address, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "Owner", Args: _args})
+	for _i := len(_m.onOwner) - 1; _i >= 0; _i-- {
+		if _e := _m.onOwner[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC1155OwnerMock
+	return _e.ret0, fmt.Errorf("ERC1155Mock: no results scripted for Owner%v", _args)
+}
+
+// ERC1155SupportsInterfaceMock scripts the results of ERC1155Mock.SupportsInterface.
+type ERC1155SupportsInterfaceMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	ret0 bool
+	err  error
+}
+
+// OnSupportsInterface scripts the results of SupportsInterface calls made with the given arguments.
+func (_m *ERC1155Mock) OnSupportsInterface(interfaceId [4]byte) *ERC1155SupportsInterfaceMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155SupportsInterfaceMock{mock: _m, args: []interface{}{interfaceId}}
+	_m.onSupportsInterface = append(_m.onSupportsInterface, _e)
+	return _e
+}
+
+// Return sets the results of the SupportsInterface calls matching the scripted arguments.
+func (_e *ERC1155SupportsInterfaceMock) Return(ret0 bool, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// SupportsInterface records the call and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	_args := []interface{}{interfaceId}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "SupportsInterface", Args: _args})
+	for _i := len(_m.onSupportsInterface) - 1; _i >= 0; _i-- {
+		if _e := _m.onSupportsInterface[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC1155SupportsInterfaceMock
+	return _e.ret0, fmt.Errorf("ERC1155Mock: no results scripted for SupportsInterface%v", _args)
+}
+
+// ERC1155UriMock scripts the results of ERC1155Mock.Uri.
+type ERC1155UriMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	ret0 string
+	err  error
+}
+
+// OnUri scripts the results of Uri calls made with the given arguments.
+func (_m *ERC1155Mock) OnUri(arg0 *big.Int) *ERC1155UriMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155UriMock{mock: _m, args: []interface{}{arg0}}
+	_m.onUri = append(_m.onUri, _e)
+	return _e
+}
+
+// Return sets the results of the Uri calls matching the scripted arguments.
+func (_e *ERC1155UriMock) Return(ret0 string, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Uri records the call and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) Uri(opts *bind.CallOpts, arg0 *big.Int) (string, error) {
+	_args := []interface{}{arg0}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "Uri", Args: _args})
+	for _i := len(_m.onUri) - 1; _i >= 0; _i-- {
+		if _e := _m.onUri[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC1155UriMock
+	return _e.ret0, fmt.Errorf("ERC1155Mock: no results scripted for Uri%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// ERC1155MintMock scripts the results of ERC1155Mock.Mint.
+type ERC1155MintMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnMint scripts the results of Mint transactions made with the given arguments.
+func (_m *ERC1155Mock) OnMint(to common.Address, id *big.Int, amount *big.Int, data []byte) *ERC1155MintMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155MintMock{mock: _m, args: []interface{}{to, id, amount, data}}
+	_m.onMint = append(_m.onMint, _e)
+	return _e
+}
+
+// Return sets the results of the Mint transactions matching the scripted arguments.
+func (_e *ERC1155MintMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Mint records the transaction and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) Mint(opts *bind.TransactOpts, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	_args := []interface{}{to, id, amount, data}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "Mint", Args: _args})
+	for _i := len(_m.onMint) - 1; _i >= 0; _i-- {
+		if _e := _m.onMint[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for Mint%v", _args)
+}
+
+// ERC1155RenounceOwnershipMock scripts the results of ERC1155Mock.RenounceOwnership.
+type ERC1155RenounceOwnershipMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnRenounceOwnership scripts the results of RenounceOwnership transactions made with the given arguments.
+func (_m *ERC1155Mock) OnRenounceOwnership() *ERC1155RenounceOwnershipMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155RenounceOwnershipMock{mock: _m, args: []interface{}{}}
+	_m.onRenounceOwnership = append(_m.onRenounceOwnership, _e)
+	return _e
+}
+
+// Return sets the results of the RenounceOwnership transactions matching the scripted arguments.
+func (_e *ERC1155RenounceOwnershipMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// RenounceOwnership records the transaction and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "RenounceOwnership", Args: _args})
+	for _i := len(_m.onRenounceOwnership) - 1; _i >= 0; _i-- {
+		if _e := _m.onRenounceOwnership[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for RenounceOwnership%v", _args)
+}
+
+// ERC1155SafeBatchTransferFromMock scripts the results of ERC1155Mock.SafeBatchTransferFrom.
+type ERC1155SafeBatchTransferFromMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnSafeBatchTransferFrom scripts the results of SafeBatchTransferFrom transactions made with the given arguments.
+func (_m *ERC1155Mock) OnSafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) *ERC1155SafeBatchTransferFromMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155SafeBatchTransferFromMock{mock: _m, args: []interface{}{from, to, ids, amounts, data}}
+	_m.onSafeBatchTransferFrom = append(_m.onSafeBatchTransferFrom, _e)
+	return _e
+}
+
+// Return sets the results of the SafeBatchTransferFrom transactions matching the scripted arguments.
+func (_e *ERC1155SafeBatchTransferFromMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// SafeBatchTransferFrom records the transaction and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) SafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	_args := []interface{}{from, to, ids, amounts, data}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "SafeBatchTransferFrom", Args: _args})
+	for _i := len(_m.onSafeBatchTransferFrom) - 1; _i >= 0; _i-- {
+		if _e := _m.onSafeBatchTransferFrom[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for SafeBatchTransferFrom%v", _args)
+}
+
+// ERC1155SafeTransferFromMock scripts the results of ERC1155Mock.SafeTransferFrom.
+type ERC1155SafeTransferFromMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnSafeTransferFrom scripts the results of SafeTransferFrom transactions made with the given arguments.
+func (_m *ERC1155Mock) OnSafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) *ERC1155SafeTransferFromMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155SafeTransferFromMock{mock: _m, args: []interface{}{from, to, id, amount, data}}
+	_m.onSafeTransferFrom = append(_m.onSafeTransferFrom, _e)
+	return _e
+}
+
+// Return sets the results of the SafeTransferFrom transactions matching the scripted arguments.
+func (_e *ERC1155SafeTransferFromMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// SafeTransferFrom records the transaction and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	_args := []interface{}{from, to, id, amount, data}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "SafeTransferFrom", Args: _args})
+	for _i := len(_m.onSafeTransferFrom) - 1; _i >= 0; _i-- {
+		if _e := _m.onSafeTransferFrom[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for SafeTransferFrom%v", _args)
+}
+
+// ERC1155SetApprovalForAllMock scripts the results of ERC1155Mock.SetApprovalForAll.
+type ERC1155SetApprovalForAllMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnSetApprovalForAll scripts the results of SetApprovalForAll transactions made with the given arguments.
+func (_m *ERC1155Mock) OnSetApprovalForAll(operator common.Address, approved bool) *ERC1155SetApprovalForAllMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155SetApprovalForAllMock{mock: _m, args: []interface{}{operator, approved}}
+	_m.onSetApprovalForAll = append(_m.onSetApprovalForAll, _e)
+	return _e
+}
+
+// Return sets the results of the SetApprovalForAll transactions matching the scripted arguments.
+func (_e *ERC1155SetApprovalForAllMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// SetApprovalForAll records the transaction and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	_args := []interface{}{operator, approved}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "SetApprovalForAll", Args: _args})
+	for _i := len(_m.onSetApprovalForAll) - 1; _i >= 0; _i-- {
+		if _e := _m.onSetApprovalForAll[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for SetApprovalForAll%v", _args)
+}
+
+// ERC1155TransferOwnershipMock scripts the results of ERC1155Mock.TransferOwnership.
+type ERC1155TransferOwnershipMock struct {
+	mock *ERC1155Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnTransferOwnership scripts the results of TransferOwnership transactions made with the given arguments.
+func (_m *ERC1155Mock) OnTransferOwnership(newOwner common.Address) *ERC1155TransferOwnershipMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155TransferOwnershipMock{mock: _m, args: []interface{}{newOwner}}
+	_m.onTransferOwnership = append(_m.onTransferOwnership, _e)
+	return _e
+}
+
+// Return sets the results of the TransferOwnership transactions matching the scripted arguments.
+func (_e *ERC1155TransferOwnershipMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// TransferOwnership records the transaction and returns the results scripted for its arguments.
+func (_m *ERC1155Mock) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	_args := []interface{}{newOwner}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "TransferOwnership", Args: _args})
+	for _i := len(_m.onTransferOwnership) - 1; _i >= 0; _i-- {
+		if _e := _m.onTransferOwnership[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for TransferOwnership%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+// ERC1155UnpackApprovalForAllLogMock scripts the results of ERC1155Mock.UnpackApprovalForAllLog.
+type ERC1155UnpackApprovalForAllLogMock struct {
+	mock  *ERC1155Mock
+	args  []interface{}
+	event *ApprovalForAllLog
+	err   error
+}
+
+// OnUnpackApprovalForAllLog scripts the results of unpacking the given log.
+func (_m *ERC1155Mock) OnUnpackApprovalForAllLog(log types.Log) *ERC1155UnpackApprovalForAllLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155UnpackApprovalForAllLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackApprovalForAllLog = append(_m.onUnpackApprovalForAllLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC1155UnpackApprovalForAllLogMock) Return(event *ApprovalForAllLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackApprovalForAllLog records the call and returns the results scripted for the log.
+func (_m *ERC1155Mock) UnpackApprovalForAllLog(log types.Log) (*ApprovalForAllLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "UnpackApprovalForAllLog", Args: _args})
+	for _i := len(_m.onUnpackApprovalForAllLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackApprovalForAllLog[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for UnpackApprovalForAllLog")
+}
+
+// ERC1155UnpackOwnershipTransferredLogMock scripts the results of ERC1155Mock.UnpackOwnershipTransferredLog.
+type ERC1155UnpackOwnershipTransferredLogMock struct {
+	mock  *ERC1155Mock
+	args  []interface{}
+	event *OwnershipTransferredLog
+	err   error
+}
+
+// OnUnpackOwnershipTransferredLog scripts the results of unpacking the given log.
+func (_m *ERC1155Mock) OnUnpackOwnershipTransferredLog(log types.Log) *ERC1155UnpackOwnershipTransferredLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155UnpackOwnershipTransferredLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackOwnershipTransferredLog = append(_m.onUnpackOwnershipTransferredLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC1155UnpackOwnershipTransferredLogMock) Return(event *OwnershipTransferredLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackOwnershipTransferredLog records the call and returns the results scripted for the log.
+func (_m *ERC1155Mock) UnpackOwnershipTransferredLog(log types.Log) (*OwnershipTransferredLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "UnpackOwnershipTransferredLog", Args: _args})
+	for _i := len(_m.onUnpackOwnershipTransferredLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackOwnershipTransferredLog[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for UnpackOwnershipTransferredLog")
+}
+
+// ERC1155UnpackTransferBatchLogMock scripts the results of ERC1155Mock.UnpackTransferBatchLog.
+type ERC1155UnpackTransferBatchLogMock struct {
+	mock  *ERC1155Mock
+	args  []interface{}
+	event *TransferBatchLog
+	err   error
+}
+
+// OnUnpackTransferBatchLog scripts the results of unpacking the given log.
+func (_m *ERC1155Mock) OnUnpackTransferBatchLog(log types.Log) *ERC1155UnpackTransferBatchLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155UnpackTransferBatchLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackTransferBatchLog = append(_m.onUnpackTransferBatchLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC1155UnpackTransferBatchLogMock) Return(event *TransferBatchLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackTransferBatchLog records the call and returns the results scripted for the log.
+func (_m *ERC1155Mock) UnpackTransferBatchLog(log types.Log) (*TransferBatchLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "UnpackTransferBatchLog", Args: _args})
+	for _i := len(_m.onUnpackTransferBatchLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackTransferBatchLog[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for UnpackTransferBatchLog")
+}
+
+// ERC1155UnpackTransferSingleLogMock scripts the results of ERC1155Mock.UnpackTransferSingleLog.
+type ERC1155UnpackTransferSingleLogMock struct {
+	mock  *ERC1155Mock
+	args  []interface{}
+	event *TransferSingleLog
+	err   error
+}
+
+// OnUnpackTransferSingleLog scripts the results of unpacking the given log.
+func (_m *ERC1155Mock) OnUnpackTransferSingleLog(log types.Log) *ERC1155UnpackTransferSingleLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155UnpackTransferSingleLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackTransferSingleLog = append(_m.onUnpackTransferSingleLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC1155UnpackTransferSingleLogMock) Return(event *TransferSingleLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackTransferSingleLog records the call and returns the results scripted for the log.
+func (_m *ERC1155Mock) UnpackTransferSingleLog(log types.Log) (*TransferSingleLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "UnpackTransferSingleLog", Args: _args})
+	for _i := len(_m.onUnpackTransferSingleLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackTransferSingleLog[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for UnpackTransferSingleLog")
+}
+
+// ERC1155UnpackURILogMock scripts the results of ERC1155Mock.UnpackURILog.
+type ERC1155UnpackURILogMock struct {
+	mock  *ERC1155Mock
+	args  []interface{}
+	event *URILog
+	err   error
+}
+
+// OnUnpackURILog scripts the results of unpacking the given log.
+func (_m *ERC1155Mock) OnUnpackURILog(log types.Log) *ERC1155UnpackURILogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC1155UnpackURILogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackURILog = append(_m.onUnpackURILog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC1155UnpackURILogMock) Return(event *URILog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackURILog records the call and returns the results scripted for the log.
+func (_m *ERC1155Mock) UnpackURILog(log types.Log) (*URILog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC1155MockCall{Method: "UnpackURILog", Args: _args})
+	for _i := len(_m.onUnpackURILog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackURILog[_i]; matchERC1155MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC1155Mock: no results scripted for UnpackURILog")
+}
@@ -0,0 +1,153 @@
+package erc1155
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evan-forbes/buddy/sim"
+	"github.com/evan-forbes/buddy/std/internal/receiver"
+)
+
+func ints(values ...int64) []*big.Int {
+	out := make([]*big.Int, len(values))
+	for i, v := range values {
+		out[i] = big.NewInt(v)
+	}
+	return out
+}
+
+func TestERC1155(t *testing.T) {
+	accounts := sim.NewAccounts("alice", "bob", "carol")
+	backend := sim.NewSimulatedBackend(accounts.Genesis(), 10000000)
+	defer backend.Close()
+	alice, bob, carol := accounts["alice"], accounts["bob"], accounts["carol"]
+
+	address, _, token, err := DeployERC1155(alice.TxOpts, backend, "https://buddy.example/{id}.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiverAddress, _, hooks, err := receiver.DeployReceiver(alice.TxOpts, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	var revert ERC1155Revert
+	if _, err := token.Mint(bob.TxOpts, bob.Address, big.NewInt(1), big.NewInt(10), nil); !errors.As(err, &revert) || revert.Reason != "Ownable: caller is not the owner" {
+		t.Errorf("minting as bob failed with %v", err)
+	}
+	if _, err := token.Mint(alice.TxOpts, address, big.NewInt(1), big.NewInt(10), nil); !errors.As(err, &revert) || revert.Reason != "ERC1155: transfer to non-ERC1155Receiver implementer" {
+		t.Errorf("minting to a non receiver failed with %v", err)
+	}
+	for id, value := range map[int64]int64{1: 100, 2: 50, 3: 7} {
+		if _, err := token.Mint(alice.TxOpts, bob.Address, big.NewInt(id), big.NewInt(value), []byte("buddy")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := token.Mint(alice.TxOpts, receiverAddress, big.NewInt(3), big.NewInt(1), []byte("a longer payload padded over two words")); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	if _, err := token.SafeTransferFrom(carol.TxOpts, bob.Address, carol.Address, big.NewInt(1), big.NewInt(1), nil); !errors.As(err, &revert) || revert.Reason != "ERC1155: caller is not token owner or approved" {
+		t.Errorf("transferring unapproved failed with %v", err)
+	}
+	if _, err := token.SafeTransferFrom(bob.TxOpts, bob.Address, carol.Address, big.NewInt(1), big.NewInt(101), nil); !errors.As(err, &revert) || revert.Reason != "ERC1155: insufficient balance for transfer" {
+		t.Errorf("transferring past the balance failed with %v", err)
+	}
+	if _, err := token.SetApprovalForAll(bob.TxOpts, carol.Address, true); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if _, err := token.SafeTransferFrom(carol.TxOpts, bob.Address, carol.Address, big.NewInt(1), big.NewInt(40), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := token.SafeBatchTransferFrom(bob.TxOpts, bob.Address, receiverAddress, ints(1, 2), ints(10, 20), []byte("buddy")); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if _, err := token.SafeBatchTransferFrom(bob.TxOpts, bob.Address, carol.Address, ints(1, 2), ints(1), nil); !errors.As(err, &revert) || revert.Reason != "ERC1155: ids and amounts length mismatch" {
+		t.Errorf("transferring mismatched ids failed with %v", err)
+	}
+
+	owners := []common.Address{bob.Address, carol.Address, receiverAddress, bob.Address, receiverAddress, receiverAddress}
+	balances, err := token.BalanceOfBatch(nil, owners, ints(1, 1, 1, 2, 2, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []int64{50, 40, 10, 30, 20, 1} {
+		if balances[i].Int64() != want {
+			t.Errorf("balance %d = %v, want %d", i, balances[i], want)
+		}
+	}
+
+	batches, err := token.FilterTransferBatch(nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !batches.Next() {
+		t.Fatal("batch transfer was not logged")
+	}
+	batch := batches.Event
+	if batch.To != receiverAddress || len(batch.Ids) != 2 || batch.Ids[1].Int64() != 2 || len(batch.Values) != 2 || batch.Values[1].Int64() != 20 {
+		t.Errorf("unexpected batch transfer %+v", batch)
+	}
+	// big numbers inside arrays are written as decimal strings
+	encoded, err := json.Marshal(batch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shape struct {
+		Fields struct {
+			Ids, Values []string
+		}
+	}
+	if err := json.Unmarshal(encoded, &shape); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(shape.Fields.Ids, []string{"1", "2"}) || !reflect.DeepEqual(shape.Fields.Values, []string{"10", "20"}) {
+		t.Errorf("unexpected batch transfer JSON %s", encoded)
+	}
+	decoded := new(TransferBatchLog)
+	if err := json.Unmarshal(encoded, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, batch) {
+		t.Errorf("round trip mismatch:\nhave %+v\nwant %+v", decoded, batch)
+	}
+
+	// the receiver logged the arguments of both hooks
+	singles, err := hooks.FilterERC1155Received(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !singles.Next() {
+		t.Fatal("receiver was not called on mint")
+	}
+	if single := singles.Event; single.Operator != alice.Address || single.From != (common.Address{}) || single.Id.Int64() != 3 || single.Value.Int64() != 1 || string(single.Data) != "a longer payload padded over two words" {
+		t.Errorf("unexpected mint hook %+v", single)
+	}
+	batched, err := hooks.FilterERC1155BatchReceived(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !batched.Next() {
+		t.Fatal("receiver was not called on batch transfer")
+	}
+	if batch := batched.Event; batch.Operator != bob.Address || batch.From != bob.Address || len(batch.Ids) != 2 || batch.Values[1].Int64() != 20 || string(batch.Data) != "buddy" {
+		t.Errorf("unexpected batch hook %+v", batch)
+	}
+	if uri, err := token.Uri(nil, big.NewInt(1)); err != nil || uri != "https://buddy.example/{id}.json" {
+		t.Errorf("uri = %q, %v", uri, err)
+	}
+
+	for id, want := range map[[4]byte]bool{{0x01, 0xff, 0xc9, 0xa7}: true, {0xd9, 0xb6, 0x7a, 0x26}: true, {0x80, 0xac, 0x58, 0xcd}: false} {
+		if supported, err := token.SupportsInterface(nil, id); err != nil || supported != want {
+			t.Errorf("supports %x = %v, %v", id, supported, err)
+		}
+	}
+}
@@ -0,0 +1,1745 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package erc20
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/evan-forbes/buddy/std"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = context.Background
+	_ = errors.New
+	_ = json.Marshal
+	_ = fmt.Errorf
+	_ = big.NewInt
+	_ = reflect.ValueOf
+	_ = strings.NewReader
+	_ = sync.NewCond
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = hexutil.Encode
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ERC20 is a wrapper around BoundContract, enforcing type checking and including
+// QoL helper methods
+//
+// Title: ERC20
+//
+// A fixed supply token minted to its deployer. An allowance of 2^256-1 is never spent.
+//
+// Follows the OpenZeppelin Contracts 4.9 implementation and its revert reasons.
+type ERC20 struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+	abi      abi.ABI             // Parsed ABI, used to replay failed transactions
+	address  common.Address      // Address the contract is deployed at
+	backend  bind.ContractBackend
+}
+
+// NewERC20 creates a new instance of ERC20, bound to a specific deployed contract.
+func NewERC20(address common.Address, backend bind.ContractBackend) (*ERC20, error) {
+	a, err := parseERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(address, a, backend, backend, backend)
+	return &ERC20{contract: contract, abi: a, address: address, backend: backend}, nil
+}
+
+// parseERC20ABI parses ERC20ABI, keying overloaded methods by their full
+// signature so that every binding calls the exact overload it was generated for.
+func parseERC20ABI() (abi.ABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(ERC20ABI))
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	overloads := make(map[string]int)
+	for _, method := range parsed.Methods {
+		overloads[method.RawName]++
+	}
+	methods := make(map[string]abi.Method, len(parsed.Methods))
+	for name, method := range parsed.Methods {
+		if overloads[method.RawName] > 1 {
+			name = method.Sig()
+		}
+		methods[name] = method
+	}
+	parsed.Methods = methods
+	return parsed, nil
+}
+
+// bound exposes the underlying BoundContract used for the low level calls
+func (_ERC20 *ERC20) bound() *bind.BoundContract {
+	return _ERC20.contract
+}
+
+// transact invokes method. Should that fail while estimating gas, the invocation
+// is replayed as a call to recover the revert data behind the failure.
+func (_ERC20 *ERC20) transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	tx, err := _ERC20.contract.Transact(opts, method, params...)
+	if err == nil || opts.GasLimit != 0 {
+		return tx, err
+	}
+	msg, perr := _ERC20.message(opts, method, params...)
+	if perr != nil {
+		return nil, err
+	}
+	if _, cerr := _ERC20.backend.CallContract(contextOfERC20(opts), msg, nil); cerr != nil {
+		if decoded := unpackERC20Error(cerr); decoded != cerr {
+			return nil, decoded
+		}
+	}
+	return nil, err
+}
+
+// estimate estimates the gas needed to invoke method with the given options.
+func (_ERC20 *ERC20) estimate(opts *bind.TransactOpts, method string, params ...interface{}) (uint64, error) {
+	msg, err := _ERC20.message(opts, method, params...)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := _ERC20.backend.EstimateGas(contextOfERC20(opts), msg)
+	return gas, unpackERC20Error(err)
+}
+
+// simulate runs method as an eth_call from opts.From against the pending state,
+// unpacking its outputs into out, if any.
+func (_ERC20 *ERC20) simulate(opts *bind.TransactOpts, out interface{}, method string, params ...interface{}) error {
+	msg, err := _ERC20.message(opts, method, params...)
+	if err != nil {
+		return err
+	}
+	var output []byte
+	if pending, ok := _ERC20.backend.(bind.PendingContractCaller); ok {
+		output, err = pending.PendingCallContract(contextOfERC20(opts), msg)
+	} else {
+		output, err = _ERC20.backend.CallContract(contextOfERC20(opts), msg, nil)
+	}
+	if err != nil {
+		return unpackERC20Error(err)
+	}
+	if out == nil {
+		return nil
+	}
+	return _ERC20.abi.Unpack(out, method, output)
+}
+
+// message packs an invocation of method into a call message sent with opts.
+func (_ERC20 *ERC20) message(opts *bind.TransactOpts, method string, params ...interface{}) (ethereum.CallMsg, error) {
+	if opts == nil {
+		return ethereum.CallMsg{}, errors.New("no transact options to invoke ERC20." + method + " with")
+	}
+	input, err := _ERC20.abi.Pack(method, params...)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	return ethereum.CallMsg{
+		From:     opts.From,
+		To:       &_ERC20.address,
+		Gas:      opts.GasLimit,
+		GasPrice: opts.GasPrice,
+		Value:    opts.Value,
+		Data:     input,
+	}, nil
+}
+
+// contextOfERC20 returns the context of opts, defaulting to the background one.
+func contextOfERC20(opts *bind.TransactOpts) context.Context {
+	if opts.Context == nil {
+		return context.Background()
+	}
+	return opts.Context
+}
+
+//////////////////////////////////////////////////////
+//		Interface
+////////////////////////////////////////////////////
+
+// ERC20Interface lists every call, transaction and log unpacker of ERC20.
+// It is regenerated along with the binding, so it can't drift from the ABI.
+type ERC20Interface interface {
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	Decimals(opts *bind.CallOpts) (uint8, error)
+	Name(opts *bind.CallOpts) (string, error)
+	Symbol(opts *bind.CallOpts) (string, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+	DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error)
+	IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+
+	UnpackApprovalLog(log types.Log) (*ApprovalLog, error)
+	UnpackTransferLog(log types.Log) (*TransferLog, error)
+}
+
+// This nil assignment ensures at compile time that ERC20 implements ERC20Interface.
+var _ ERC20Interface = (*ERC20)(nil)
+
+// This nil assignment ensures at compile time that ERC20 implements std.ERC20, the
+// standard its ABI conforms to.
+var _ std.ERC20 = (*ERC20)(nil)
+
+//////////////////////////////////////////////////////
+//		Deployment
+////////////////////////////////////////////////////
+
+// DeployERC20 deploys a new Ethereum contract, binding an instance of ERC20 to it.
+//
+// Mints supply tokens to the deployer.
+func DeployERC20(auth *bind.TransactOpts, backend bind.ContractBackend, name_ string, symbol_ string, decimals_ uint8, supply *big.Int) (common.Address, *types.Transaction, *ERC20, error) {
+	parsed, err := parseERC20ABI()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(ERC20Bin), backend, name_, symbol_, decimals_, supply)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC20{contract: contract, abi: parsed, address: address, backend: backend}, nil
+}
+
+// ErrERC20CodeMismatch is returned by NewERC20Verified when the code deployed
+// at an address isn't the one of ERC20.
+var ErrERC20CodeMismatch = errors.New("code does not match ERC20Bin")
+
+// NewERC20Verified binds an instance of ERC20, after checking that the code
+// deployed at address is the runtime code of ERC20Bin.
+func NewERC20Verified(ctx context.Context, address common.Address, backend bind.ContractBackend) (*ERC20, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w: %s", bind.ErrNoCode, address.Hex())
+	}
+	if !matchERC20Code(code) {
+		return nil, fmt.Errorf("%w: %s", ErrERC20CodeMismatch, address.Hex())
+	}
+	return NewERC20(address, backend)
+}
+
+// matchERC20Code reports whether code is the runtime code embedded in ERC20Bin.
+// The metadata hash is ignored, as are the zeroed regions the bytecode reserves
+// for immutables and linked library addresses.
+func matchERC20Code(code []byte) bool {
+	template := ERC20Bin
+
+	bin := common.FromHex(template)
+
+	// Strip the CBOR encoded metadata, its length being in the last two bytes
+	if n := len(code); n > 2 {
+		if meta := int(code[n-2])<<8 | int(code[n-1]); meta+2 < n && code[n-2-meta]&0xe0 == 0xa0 {
+			code = code[:n-2-meta]
+		}
+	}
+	// Mask the runs of zeros long enough to hold an address or an immutable
+	masked := make([]bool, len(bin))
+	for start := 0; start < len(bin); {
+		end := start
+		for end < len(bin) && bin[end] == 0 {
+			end++
+		}
+		if end-start >= common.AddressLength {
+			for i := start; i < end; i++ {
+				masked[i] = true
+			}
+		}
+		start = end + 1
+	}
+	// Look for the runtime code within the creation code
+	for offset := 0; len(code) > 0 && offset+len(code) <= len(bin); offset++ {
+		matched := true
+		for i := range code {
+			if code[i] != bin[offset+i] && !masked[offset+i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+// - Solidity: function allowance(address owner, address spender) constant returns(uint256)
+//
+// Returns the amount of tokens spender may still spend on behalf of owner.
+func (_ERC20 *ERC20) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ERC20.bound().Call(opts, out, "allowance", owner, spender)
+	return *ret0, unpackERC20Error(err)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+// - Solidity: function balanceOf(address account) constant returns(uint256)
+//
+// Returns the amount of tokens owned by account.
+func (_ERC20 *ERC20) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ERC20.bound().Call(opts, out, "balanceOf", account)
+	return *ret0, unpackERC20Error(err)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+// - Solidity: function decimals() constant returns(uint8)
+//
+// Returns the number of decimals balances are displayed with.
+func (_ERC20 *ERC20) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var (
+		ret0 = new(uint8)
+	)
+	out := ret0
+	err := _ERC20.bound().Call(opts, out, "decimals")
+	return *ret0, unpackERC20Error(err)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+// - Solidity: function name() constant returns(string)
+//
+// Returns the name of the token.
+func (_ERC20 *ERC20) Name(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _ERC20.bound().Call(opts, out, "name")
+	return *ret0, unpackERC20Error(err)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// - Solidity: function symbol() constant returns(string)
+//
+// Returns the symbol of the token.
+func (_ERC20 *ERC20) Symbol(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _ERC20.bound().Call(opts, out, "symbol")
+	return *ret0, unpackERC20Error(err)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+// - Solidity: function totalSupply() constant returns(uint256)
+//
+// Returns the amount of tokens in existence.
+func (_ERC20 *ERC20) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ERC20.bound().Call(opts, out, "totalSupply")
+	return *ret0, unpackERC20Error(err)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+// - Solidity: function approve(address spender, uint256 amount) returns(bool)
+//
+// Sets the allowance of spender over the tokens of the caller.
+func (_ERC20 *ERC20) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ERC20.transact(opts, "approve", spender, amount)
+}
+
+// EstimateApprove estimates the gas needed to invoke the contract method 0x095ea7b3.
+func (_ERC20 *ERC20) EstimateApprove(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (uint64, error) {
+	return _ERC20.estimate(opts, "approve", spender, amount)
+}
+
+// SimulateApprove runs the contract method 0x095ea7b3 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC20 *ERC20) SimulateApprove(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ERC20.simulate(opts, out, "approve", spender, amount)
+	return *ret0, err
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+// - Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+//
+// Lowers the allowance of spender by subtractedValue.
+func (_ERC20 *ERC20) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _ERC20.transact(opts, "decreaseAllowance", spender, subtractedValue)
+}
+
+// EstimateDecreaseAllowance estimates the gas needed to invoke the contract method 0xa457c2d7.
+func (_ERC20 *ERC20) EstimateDecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (uint64, error) {
+	return _ERC20.estimate(opts, "decreaseAllowance", spender, subtractedValue)
+}
+
+// SimulateDecreaseAllowance runs the contract method 0xa457c2d7 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC20 *ERC20) SimulateDecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ERC20.simulate(opts, out, "decreaseAllowance", spender, subtractedValue)
+	return *ret0, err
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+// - Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+//
+// Raises the allowance of spender by addedValue.
+func (_ERC20 *ERC20) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _ERC20.transact(opts, "increaseAllowance", spender, addedValue)
+}
+
+// EstimateIncreaseAllowance estimates the gas needed to invoke the contract method 0x39509351.
+func (_ERC20 *ERC20) EstimateIncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (uint64, error) {
+	return _ERC20.estimate(opts, "increaseAllowance", spender, addedValue)
+}
+
+// SimulateIncreaseAllowance runs the contract method 0x39509351 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC20 *ERC20) SimulateIncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ERC20.simulate(opts, out, "increaseAllowance", spender, addedValue)
+	return *ret0, err
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+// - Solidity: function transfer(address to, uint256 amount) returns(bool)
+//
+// Moves amount tokens from the caller to to.
+func (_ERC20 *ERC20) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ERC20.transact(opts, "transfer", to, amount)
+}
+
+// EstimateTransfer estimates the gas needed to invoke the contract method 0xa9059cbb.
+func (_ERC20 *ERC20) EstimateTransfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (uint64, error) {
+	return _ERC20.estimate(opts, "transfer", to, amount)
+}
+
+// SimulateTransfer runs the contract method 0xa9059cbb as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC20 *ERC20) SimulateTransfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ERC20.simulate(opts, out, "transfer", to, amount)
+	return *ret0, err
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+// - Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+//
+// Moves amount tokens from from to to, spending the allowance of the caller.
+func (_ERC20 *ERC20) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ERC20.transact(opts, "transferFrom", from, to, amount)
+}
+
+// EstimateTransferFrom estimates the gas needed to invoke the contract method 0x23b872dd.
+func (_ERC20 *ERC20) EstimateTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (uint64, error) {
+	return _ERC20.estimate(opts, "transferFrom", from, to, amount)
+}
+
+// SimulateTransferFrom runs the contract method 0x23b872dd as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC20 *ERC20) SimulateTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ERC20.simulate(opts, out, "transferFrom", from, to, amount)
+	return *ret0, err
+}
+
+//////////////////////////////////////////////////////
+//		Sessions
+////////////////////////////////////////////////////
+
+// ERC20Session binds ERC20 to default call and transact options, exposing
+// every method without the opts argument.
+type ERC20Session struct {
+	Contract     *ERC20            // Contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC20CallerSession binds ERC20 to default call options, exposing every
+// call without the opts argument.
+type ERC20CallerSession struct {
+	Contract *ERC20        // Contract binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// ERC20TransactorSession binds ERC20 to default transact options, exposing
+// every transaction without the opts argument.
+type ERC20TransactorSession struct {
+	Contract     *ERC20            // Contract binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NewERC20Session opens a session on contract, sending transactions with auth
+// and making calls from the same account.
+func NewERC20Session(contract *ERC20, auth *bind.TransactOpts) *ERC20Session {
+	return &ERC20Session{
+		Contract:     contract,
+		CallOpts:     bind.CallOpts{From: auth.From, Context: auth.Context},
+		TransactOpts: *auth,
+	}
+}
+
+// ERC20SessionInterface is the opts-free counterpart of ERC20Interface.
+type ERC20SessionInterface interface {
+	Allowance(owner common.Address, spender common.Address) (*big.Int, error)
+	BalanceOf(account common.Address) (*big.Int, error)
+	Decimals() (uint8, error)
+	Name() (string, error)
+	Symbol() (string, error)
+	TotalSupply() (*big.Int, error)
+
+	Approve(spender common.Address, amount *big.Int) (*types.Transaction, error)
+	DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error)
+	IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error)
+	Transfer(to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// This nil assignment ensures at compile time that ERC20Session implements ERC20SessionInterface.
+var _ ERC20SessionInterface = (*ERC20Session)(nil)
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+func (_ERC20 *ERC20Session) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC20.Contract.Allowance(&_ERC20.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+func (_ERC20 *ERC20CallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC20.Contract.Allowance(&_ERC20.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+func (_ERC20 *ERC20Session) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC20.Contract.BalanceOf(&_ERC20.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+func (_ERC20 *ERC20CallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC20.Contract.BalanceOf(&_ERC20.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+func (_ERC20 *ERC20Session) Decimals() (uint8, error) {
+	return _ERC20.Contract.Decimals(&_ERC20.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+func (_ERC20 *ERC20CallerSession) Decimals() (uint8, error) {
+	return _ERC20.Contract.Decimals(&_ERC20.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+func (_ERC20 *ERC20Session) Name() (string, error) {
+	return _ERC20.Contract.Name(&_ERC20.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+func (_ERC20 *ERC20CallerSession) Name() (string, error) {
+	return _ERC20.Contract.Name(&_ERC20.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+func (_ERC20 *ERC20Session) Symbol() (string, error) {
+	return _ERC20.Contract.Symbol(&_ERC20.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+func (_ERC20 *ERC20CallerSession) Symbol() (string, error) {
+	return _ERC20.Contract.Symbol(&_ERC20.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+func (_ERC20 *ERC20Session) TotalSupply() (*big.Int, error) {
+	return _ERC20.Contract.TotalSupply(&_ERC20.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+func (_ERC20 *ERC20CallerSession) TotalSupply() (*big.Int, error) {
+	return _ERC20.Contract.TotalSupply(&_ERC20.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+func (_ERC20 *ERC20Session) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.Approve(&_ERC20.TransactOpts, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+func (_ERC20 *ERC20TransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.Approve(&_ERC20.TransactOpts, spender, amount)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+func (_ERC20 *ERC20Session) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.DecreaseAllowance(&_ERC20.TransactOpts, spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+func (_ERC20 *ERC20TransactorSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.DecreaseAllowance(&_ERC20.TransactOpts, spender, subtractedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+func (_ERC20 *ERC20Session) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.IncreaseAllowance(&_ERC20.TransactOpts, spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+func (_ERC20 *ERC20TransactorSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.IncreaseAllowance(&_ERC20.TransactOpts, spender, addedValue)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+func (_ERC20 *ERC20Session) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.Transfer(&_ERC20.TransactOpts, to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+func (_ERC20 *ERC20TransactorSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.Transfer(&_ERC20.TransactOpts, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+func (_ERC20 *ERC20Session) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.TransferFrom(&_ERC20.TransactOpts, from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+func (_ERC20 *ERC20TransactorSession) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ERC20.Contract.TransferFrom(&_ERC20.TransactOpts, from, to, amount)
+}
+
+//////////////////////////////////////////////////////
+//		Calldata
+////////////////////////////////////////////////////
+
+var (
+	parsedERC20ABIOnce sync.Once
+	parsedERC20ABI     abi.ABI
+	parsedERC20ABIErr  error
+)
+
+// loadERC20ABI parses ERC20ABI once, sharing it between the calldata helpers.
+func loadERC20ABI() (abi.ABI, error) {
+	parsedERC20ABIOnce.Do(func() {
+		parsedERC20ABI, parsedERC20ABIErr = parseERC20ABI()
+	})
+	return parsedERC20ABI, parsedERC20ABIErr
+}
+
+// unnamedERC20Args names unnamed arguments the way the input structs do, so
+// they can be unpacked into them.
+func unnamedERC20Args(args abi.Arguments) abi.Arguments {
+	named := make(abi.Arguments, len(args))
+	for i, arg := range args {
+		if arg.Name == "" {
+			arg.Name = fmt.Sprintf("arg%d", i)
+		}
+		named[i] = arg
+	}
+	return named
+}
+
+// ERC20AllowanceInput holds the arguments of a ERC20.Allowance invocation.
+type ERC20AllowanceInput struct {
+	Owner   common.Address
+	Spender common.Address
+}
+
+// PackERC20Allowance packs the calldata invoking the contract method 0xdd62ed3e.
+func PackERC20Allowance(owner common.Address, spender common.Address) ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("allowance", owner, spender)
+}
+
+// UnpackERC20AllowanceInput unpacks the calldata of a transaction invoking the
+// contract method 0xdd62ed3e, selector included.
+func UnpackERC20AllowanceInput(data []byte) (*ERC20AllowanceInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "dd62ed3e" {
+		return nil, errors.New("calldata does not invoke allowance(address,address)")
+	}
+	input := new(ERC20AllowanceInput)
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC20Args(parsed.Methods["allowance"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC20AllowanceOutput unpacks the data returned by the contract method 0xdd62ed3e,
+// as ERC20.Allowance returns it.
+func UnpackERC20AllowanceOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadERC20ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "allowance", data)
+	}
+	return *ret0, err
+}
+
+// ERC20BalanceOfInput holds the arguments of a ERC20.BalanceOf invocation.
+type ERC20BalanceOfInput struct {
+	Account common.Address
+}
+
+// PackERC20BalanceOf packs the calldata invoking the contract method 0x70a08231.
+func PackERC20BalanceOf(account common.Address) ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("balanceOf", account)
+}
+
+// UnpackERC20BalanceOfInput unpacks the calldata of a transaction invoking the
+// contract method 0x70a08231, selector included.
+func UnpackERC20BalanceOfInput(data []byte) (*ERC20BalanceOfInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "70a08231" {
+		return nil, errors.New("calldata does not invoke balanceOf(address)")
+	}
+	input := new(ERC20BalanceOfInput)
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC20Args(parsed.Methods["balanceOf"].Inputs)
+	if err := args.Unpack(&input.Account, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC20BalanceOfOutput unpacks the data returned by the contract method 0x70a08231,
+// as ERC20.BalanceOf returns it.
+func UnpackERC20BalanceOfOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadERC20ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "balanceOf", data)
+	}
+	return *ret0, err
+}
+
+// ERC20DecimalsInput holds the arguments of a ERC20.Decimals invocation.
+type ERC20DecimalsInput struct{}
+
+// PackERC20Decimals packs the calldata invoking the contract method 0x313ce567.
+func PackERC20Decimals() ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("decimals")
+}
+
+// UnpackERC20DecimalsInput unpacks the calldata of a transaction invoking the
+// contract method 0x313ce567, selector included.
+func UnpackERC20DecimalsInput(data []byte) (*ERC20DecimalsInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "313ce567" {
+		return nil, errors.New("calldata does not invoke decimals()")
+	}
+	input := new(ERC20DecimalsInput)
+
+	return input, nil
+}
+
+// UnpackERC20DecimalsOutput unpacks the data returned by the contract method 0x313ce567,
+// as ERC20.Decimals returns it.
+func UnpackERC20DecimalsOutput(data []byte) (uint8, error) {
+	var (
+		ret0 = new(uint8)
+	)
+	out := ret0
+	parsed, err := loadERC20ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "decimals", data)
+	}
+	return *ret0, err
+}
+
+// ERC20NameInput holds the arguments of a ERC20.Name invocation.
+type ERC20NameInput struct{}
+
+// PackERC20Name packs the calldata invoking the contract method 0x06fdde03.
+func PackERC20Name() ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("name")
+}
+
+// UnpackERC20NameInput unpacks the calldata of a transaction invoking the
+// contract method 0x06fdde03, selector included.
+func UnpackERC20NameInput(data []byte) (*ERC20NameInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "06fdde03" {
+		return nil, errors.New("calldata does not invoke name()")
+	}
+	input := new(ERC20NameInput)
+
+	return input, nil
+}
+
+// UnpackERC20NameOutput unpacks the data returned by the contract method 0x06fdde03,
+// as ERC20.Name returns it.
+func UnpackERC20NameOutput(data []byte) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	parsed, err := loadERC20ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "name", data)
+	}
+	return *ret0, err
+}
+
+// ERC20SymbolInput holds the arguments of a ERC20.Symbol invocation.
+type ERC20SymbolInput struct{}
+
+// PackERC20Symbol packs the calldata invoking the contract method 0x95d89b41.
+func PackERC20Symbol() ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("symbol")
+}
+
+// UnpackERC20SymbolInput unpacks the calldata of a transaction invoking the
+// contract method 0x95d89b41, selector included.
+func UnpackERC20SymbolInput(data []byte) (*ERC20SymbolInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "95d89b41" {
+		return nil, errors.New("calldata does not invoke symbol()")
+	}
+	input := new(ERC20SymbolInput)
+
+	return input, nil
+}
+
+// UnpackERC20SymbolOutput unpacks the data returned by the contract method 0x95d89b41,
+// as ERC20.Symbol returns it.
+func UnpackERC20SymbolOutput(data []byte) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	parsed, err := loadERC20ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "symbol", data)
+	}
+	return *ret0, err
+}
+
+// ERC20TotalSupplyInput holds the arguments of a ERC20.TotalSupply invocation.
+type ERC20TotalSupplyInput struct{}
+
+// PackERC20TotalSupply packs the calldata invoking the contract method 0x18160ddd.
+func PackERC20TotalSupply() ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("totalSupply")
+}
+
+// UnpackERC20TotalSupplyInput unpacks the calldata of a transaction invoking the
+// contract method 0x18160ddd, selector included.
+func UnpackERC20TotalSupplyInput(data []byte) (*ERC20TotalSupplyInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "18160ddd" {
+		return nil, errors.New("calldata does not invoke totalSupply()")
+	}
+	input := new(ERC20TotalSupplyInput)
+
+	return input, nil
+}
+
+// UnpackERC20TotalSupplyOutput unpacks the data returned by the contract method 0x18160ddd,
+// as ERC20.TotalSupply returns it.
+func UnpackERC20TotalSupplyOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadERC20ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "totalSupply", data)
+	}
+	return *ret0, err
+}
+
+// ERC20ApproveInput holds the arguments of a ERC20.Approve invocation.
+type ERC20ApproveInput struct {
+	Spender common.Address
+	Amount  *big.Int
+}
+
+// PackERC20Approve packs the calldata invoking the contract method 0x095ea7b3.
+func PackERC20Approve(spender common.Address, amount *big.Int) ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("approve", spender, amount)
+}
+
+// UnpackERC20ApproveInput unpacks the calldata of a transaction invoking the
+// contract method 0x095ea7b3, selector included.
+func UnpackERC20ApproveInput(data []byte) (*ERC20ApproveInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "095ea7b3" {
+		return nil, errors.New("calldata does not invoke approve(address,uint256)")
+	}
+	input := new(ERC20ApproveInput)
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC20Args(parsed.Methods["approve"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC20DecreaseAllowanceInput holds the arguments of a ERC20.DecreaseAllowance invocation.
+type ERC20DecreaseAllowanceInput struct {
+	Spender         common.Address
+	SubtractedValue *big.Int
+}
+
+// PackERC20DecreaseAllowance packs the calldata invoking the contract method 0xa457c2d7.
+func PackERC20DecreaseAllowance(spender common.Address, subtractedValue *big.Int) ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("decreaseAllowance", spender, subtractedValue)
+}
+
+// UnpackERC20DecreaseAllowanceInput unpacks the calldata of a transaction invoking the
+// contract method 0xa457c2d7, selector included.
+func UnpackERC20DecreaseAllowanceInput(data []byte) (*ERC20DecreaseAllowanceInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "a457c2d7" {
+		return nil, errors.New("calldata does not invoke decreaseAllowance(address,uint256)")
+	}
+	input := new(ERC20DecreaseAllowanceInput)
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC20Args(parsed.Methods["decreaseAllowance"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC20IncreaseAllowanceInput holds the arguments of a ERC20.IncreaseAllowance invocation.
+type ERC20IncreaseAllowanceInput struct {
+	Spender    common.Address
+	AddedValue *big.Int
+}
+
+// PackERC20IncreaseAllowance packs the calldata invoking the contract method 0x39509351.
+func PackERC20IncreaseAllowance(spender common.Address, addedValue *big.Int) ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("increaseAllowance", spender, addedValue)
+}
+
+// UnpackERC20IncreaseAllowanceInput unpacks the calldata of a transaction invoking the
+// contract method 0x39509351, selector included.
+func UnpackERC20IncreaseAllowanceInput(data []byte) (*ERC20IncreaseAllowanceInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "39509351" {
+		return nil, errors.New("calldata does not invoke increaseAllowance(address,uint256)")
+	}
+	input := new(ERC20IncreaseAllowanceInput)
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC20Args(parsed.Methods["increaseAllowance"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC20TransferInput holds the arguments of a ERC20.Transfer invocation.
+type ERC20TransferInput struct {
+	To     common.Address
+	Amount *big.Int
+}
+
+// PackERC20Transfer packs the calldata invoking the contract method 0xa9059cbb.
+func PackERC20Transfer(to common.Address, amount *big.Int) ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("transfer", to, amount)
+}
+
+// UnpackERC20TransferInput unpacks the calldata of a transaction invoking the
+// contract method 0xa9059cbb, selector included.
+func UnpackERC20TransferInput(data []byte) (*ERC20TransferInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "a9059cbb" {
+		return nil, errors.New("calldata does not invoke transfer(address,uint256)")
+	}
+	input := new(ERC20TransferInput)
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC20Args(parsed.Methods["transfer"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC20TransferFromInput holds the arguments of a ERC20.TransferFrom invocation.
+type ERC20TransferFromInput struct {
+	From   common.Address
+	To     common.Address
+	Amount *big.Int
+}
+
+// PackERC20TransferFrom packs the calldata invoking the contract method 0x23b872dd.
+func PackERC20TransferFrom(from common.Address, to common.Address, amount *big.Int) ([]byte, error) {
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("transferFrom", from, to, amount)
+}
+
+// UnpackERC20TransferFromInput unpacks the calldata of a transaction invoking the
+// contract method 0x23b872dd, selector included.
+func UnpackERC20TransferFromInput(data []byte) (*ERC20TransferFromInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "23b872dd" {
+		return nil, errors.New("calldata does not invoke transferFrom(address,address,uint256)")
+	}
+	input := new(ERC20TransferFromInput)
+	parsed, err := loadERC20ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC20Args(parsed.Methods["transferFrom"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ErrERC20UnknownMethod is returned by DecodeERC20Call for calldata that doesn't invoke a ERC20 method.
+var ErrERC20UnknownMethod = errors.New("unknown ERC20 method")
+
+// DecodeERC20Call identifies the method invoked by calldata through its 4-byte
+// selector, returning the matching *MethodInput holding its arguments.
+func DecodeERC20Call(data []byte) (interface{}, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("%w: calldata too short", ErrERC20UnknownMethod)
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "dd62ed3e":
+		input, err := UnpackERC20AllowanceInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "70a08231":
+		input, err := UnpackERC20BalanceOfInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "313ce567":
+		input, err := UnpackERC20DecimalsInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "06fdde03":
+		input, err := UnpackERC20NameInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "95d89b41":
+		input, err := UnpackERC20SymbolInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "18160ddd":
+		input, err := UnpackERC20TotalSupplyInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "095ea7b3":
+		input, err := UnpackERC20ApproveInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "a457c2d7":
+		input, err := UnpackERC20DecreaseAllowanceInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "39509351":
+		input, err := UnpackERC20IncreaseAllowanceInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "a9059cbb":
+		input, err := UnpackERC20TransferInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "23b872dd":
+		input, err := UnpackERC20TransferFromInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	}
+	return nil, fmt.Errorf("%w: selector %x", ErrERC20UnknownMethod, data[:4])
+}
+
+//////////////////////////////////////////////////////
+//		Errors
+////////////////////////////////////////////////////
+
+// ERC20Revert is a revert with a reason string, as raised by require and revert.
+type ERC20Revert struct {
+	Reason string
+}
+
+func (e ERC20Revert) Error() string {
+	return "ERC20: execution reverted: " + e.Reason
+}
+
+// ERC20Panic is raised by failed assertions, arithmetic overflows and the like.
+type ERC20Panic struct {
+	Code *big.Int
+}
+
+func (e ERC20Panic) Error() string {
+	return fmt.Sprintf("ERC20: panic code %#x", e.Code)
+}
+
+// ERC20ErrorsABI describes the errors ERC20 can revert with, each as a function
+// returning the error arguments.
+const ERC20ErrorsABI = "[{\"type\":\"function\",\"name\":\"Error\",\"outputs\":[{\"name\":\"reason\",\"type\":\"string\"}]},{\"type\":\"function\",\"name\":\"Panic\",\"outputs\":[{\"name\":\"code\",\"type\":\"uint256\"}]}]"
+
+// DecodeERC20Revert decodes revert data into a ERC20Revert, a ERC20Panic
+// or one of the custom errors of ERC20. It returns nil for unknown data.
+func DecodeERC20Revert(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(ERC20ErrorsABI))
+	if err != nil {
+		return nil
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "08c379a0":
+		var e ERC20Revert
+		if parsed.Unpack(&e, "Error", data[4:]) == nil {
+			return e
+		}
+	case "4e487b71":
+		var e ERC20Panic
+		if parsed.Unpack(&e, "Panic", data[4:]) == nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// unpackERC20Error replaces an error carrying revert data with the typed error
+// decoded from it, leaving any other error untouched. Only errors exposing the
+// data through ErrorData, like sim.RevertError, carry it; RPC errors don't.
+func unpackERC20Error(err error) error {
+	var reverted interface{ ErrorData() interface{} }
+	if !errors.As(err, &reverted) {
+		return err
+	}
+	var data []byte
+	switch d := reverted.ErrorData().(type) {
+	case string:
+		data = common.FromHex(d)
+	case []byte:
+		data = d
+	}
+	if decoded := DecodeERC20Revert(data); decoded != nil {
+		return decoded
+	}
+	return err
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+// logERC20JSON is the JSON encoding shared by the events of ERC20.
+type logERC20JSON struct {
+	Event       string          `json:"event"`
+	Contract    string          `json:"contract"`
+	Fields      json.RawMessage `json:"fields"`
+	Address     common.Address  `json:"address"`
+	Topics      []common.Hash   `json:"topics"`
+	Data        hexutil.Bytes   `json:"data"`
+	BlockNumber uint64          `json:"blockNumber"`
+	BlockHash   common.Hash     `json:"blockHash"`
+	TxHash      common.Hash     `json:"transactionHash"`
+	TxIndex     uint            `json:"transactionIndex"`
+	LogIndex    uint            `json:"logIndex"`
+	Removed     bool            `json:"removed"`
+}
+
+// marshalLogERC20 encodes the decoded fields of an event along with its log.
+func marshalLogERC20(name string, fields interface{}, log types.Log) ([]byte, error) {
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(logERC20JSON{
+		Event:       name,
+		Contract:    "ERC20",
+		Fields:      encoded,
+		Address:     log.Address,
+		Topics:      log.Topics,
+		Data:        log.Data,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Removed:     log.Removed,
+	})
+}
+
+// unmarshalLogERC20 decodes the JSON encoding of an event, checking its name
+// and returning the raw log.
+func unmarshalLogERC20(input []byte, name string, fields interface{}) (types.Log, error) {
+	var dec logERC20JSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return types.Log{}, err
+	}
+	if dec.Event != name || dec.Contract != "ERC20" {
+		return types.Log{}, fmt.Errorf("cannot unmarshal %s.%s into a ERC20.%s event", dec.Contract, dec.Event, name)
+	}
+	if err := json.Unmarshal(dec.Fields, fields); err != nil {
+		return types.Log{}, err
+	}
+	return types.Log{
+		Address:     dec.Address,
+		Topics:      dec.Topics,
+		Data:        dec.Data,
+		BlockNumber: dec.BlockNumber,
+		BlockHash:   dec.BlockHash,
+		TxHash:      dec.TxHash,
+		TxIndex:     dec.TxIndex,
+		Index:       dec.LogIndex,
+		Removed:     dec.Removed,
+	}, nil
+}
+
+//////// Approval ////////
+
+// ApprovalID is the hex of the Topic Hash
+const ERC20ApprovalID = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+
+// ApprovalLog represents a Approval event raised by the ERC20 contract.
+type ApprovalLog struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e ApprovalLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Owner   common.Address `json:"owner"`
+		Spender common.Address `json:"spender"`
+		Value   string         `json:"value"`
+	}
+	fields.Owner = e.Owner
+	fields.Spender = e.Spender
+	if e.Value != nil {
+		fields.Value = e.Value.String()
+	}
+	return marshalLogERC20("Approval", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *ApprovalLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Owner   common.Address `json:"owner"`
+		Spender common.Address `json:"spender"`
+		Value   string         `json:"value"`
+	}
+	raw, err := unmarshalLogERC20(input, "Approval", &fields)
+	if err != nil {
+		return err
+	}
+	e.Owner = fields.Owner
+	e.Spender = fields.Spender
+	if fields.Value != "" {
+		value, ok := new(big.Int).SetString(fields.Value, 10)
+		if !ok {
+			return fmt.Errorf("invalid value %q", fields.Value)
+		}
+		e.Value = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackApprovalLog is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC20 *ERC20) UnpackApprovalLog(log types.Log) (*ApprovalLog, error) {
+	event := new(ApprovalLog)
+	if err := _ERC20.bound().UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ApprovalLogIterator is returned from FilterApproval and is used to iterate over
+// the raw logs and unpacked data for Approval events raised by the ERC20 contract.
+type ApprovalLogIterator struct {
+	Event *ApprovalLog // Event containing the contract specifics and raw log
+
+	contract *ERC20                // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ApprovalLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackApprovalLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackApprovalLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ApprovalLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ApprovalLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC20 *ERC20) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*ApprovalLogIterator, error) {
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC20.bound().FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ApprovalLogIterator{contract: _ERC20, logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC20 *ERC20) WatchApproval(opts *bind.WatchOpts, sink chan<- *ApprovalLog, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC20.bound().WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC20.UnpackApprovalLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// Transfer ////////
+
+// TransferID is the hex of the Topic Hash
+const ERC20TransferID = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// TransferLog represents a Transfer event raised by the ERC20 contract.
+type TransferLog struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e TransferLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		From  common.Address `json:"from"`
+		To    common.Address `json:"to"`
+		Value string         `json:"value"`
+	}
+	fields.From = e.From
+	fields.To = e.To
+	if e.Value != nil {
+		fields.Value = e.Value.String()
+	}
+	return marshalLogERC20("Transfer", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *TransferLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		From  common.Address `json:"from"`
+		To    common.Address `json:"to"`
+		Value string         `json:"value"`
+	}
+	raw, err := unmarshalLogERC20(input, "Transfer", &fields)
+	if err != nil {
+		return err
+	}
+	e.From = fields.From
+	e.To = fields.To
+	if fields.Value != "" {
+		value, ok := new(big.Int).SetString(fields.Value, 10)
+		if !ok {
+			return fmt.Errorf("invalid value %q", fields.Value)
+		}
+		e.Value = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackTransferLog is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC20 *ERC20) UnpackTransferLog(log types.Log) (*TransferLog, error) {
+	event := new(TransferLog)
+	if err := _ERC20.bound().UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TransferLogIterator is returned from FilterTransfer and is used to iterate over
+// the raw logs and unpacked data for Transfer events raised by the ERC20 contract.
+type TransferLogIterator struct {
+	Event *TransferLog // Event containing the contract specifics and raw log
+
+	contract *ERC20                // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TransferLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackTransferLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackTransferLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TransferLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TransferLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC20 *ERC20) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*TransferLogIterator, error) {
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC20.bound().FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferLogIterator{contract: _ERC20, logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC20 *ERC20) WatchTransfer(opts *bind.WatchOpts, sink chan<- *TransferLog, from []common.Address, to []common.Address) (event.Subscription, error) {
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC20.bound().WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC20.UnpackTransferLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////////////////////////////////////////////////////
+//		Event Dispatch
+////////////////////////////////////////////////////
+
+// ERC20EventHandler handles each of the events raised by the ERC20 contract.
+type ERC20EventHandler interface {
+	HandleApproval(event *ApprovalLog) error
+	HandleTransfer(event *TransferLog) error
+}
+
+// ErrERC20UnknownEvent is returned by Dispatch for logs that weren't raised by a ERC20 event.
+var ErrERC20UnknownEvent = errors.New("unknown ERC20 event")
+
+// Dispatch unpacks the log according to its first topic and passes it on to the
+// matching method of the handler.
+func (_ERC20 *ERC20) Dispatch(log types.Log, h ERC20EventHandler) error {
+	if len(log.Topics) == 0 {
+		return fmt.Errorf("%w: log has no topics", ErrERC20UnknownEvent)
+	}
+	switch log.Topics[0].Hex() {
+	case ERC20ApprovalID:
+		event, err := _ERC20.UnpackApprovalLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleApproval(event)
+
+	case ERC20TransferID:
+		event, err := _ERC20.UnpackTransferLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleTransfer(event)
+
+	}
+	return fmt.Errorf("%w: topic %s", ErrERC20UnknownEvent, log.Topics[0].Hex())
+}
+
+//////////////////////////////////////////////////////
+//		Bin and ABI
+////////////////////////////////////////////////////
+
+// ERC20Bin is used to deploy the generated contract
+const ERC20Bin = "0x60806040523480156200001157600080fd5b5060405162000c8538038062000c85833981016040819052620000349162000209565b600362000042858262000323565b50600462000051848262000323565b506005805460ff191660ff8416179055620000746200006d3390565b826200007e565b5050505062000417565b6001600160a01b038216620000d95760405162461bcd60e51b815260206004820152601f60248201527f45524332303a206d696e7420746f20746865207a65726f206164647265737300604482015260640160405180910390fd5b8060026000828254620000ed9190620003ef565b90915550506001600160a01b038216600081815260208181526040808320805486019055518481527fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef910160405180910390a35050565b634e487b7160e01b600052604160045260246000fd5b600082601f8301126200016c57600080fd5b81516001600160401b038082111562000189576200018962000144565b604051601f8301601f19908116603f01168101908282118183101715620001b457620001b462000144565b81604052838152602092508683858801011115620001d157600080fd5b600091505b83821015620001f55785820183015181830184015290820190620001d6565b600093810190920192909252949350505050565b600080600080608085870312156200022057600080fd5b84516001600160401b03808211156200023857600080fd5b62000246888389016200015a565b955060208701519150808211156200025d57600080fd5b506200026c878288016200015a565b935050604085015160ff811681146200028457600080fd5b6060959095015193969295505050565b600181811c90821680620002a957607f821691505b602082108103620002ca57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200031e57600081815260208120601f850160051c81016020861015620002f95750805b601f850160051c820191505b818110156200031a5782815560010162000305565b5050505b505050565b81516001600160401b038111156200033f576200033f62000144565b620003578162000350845462000294565b84620002d0565b602080601f8311600181146200038f5760008415620003765750858301515b600019600386901b1c1916600185901b1785556200031a565b600085815260208120601f198616915b82811015620003c0578886015182559484019460019091019084016200039f565b5085821015620003df5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b808201808211156200041157634e487b7160e01b600052601160045260246000fd5b92915050565b61085e80620004276000396000f3fe608060405234801561001057600080fd5b50600436106100a95760003560e01c80633950935111610071578063395093511461012957806370a082311461013c57806395d89b4114610165578063a457c2d71461016d578063a9059cbb14610180578063dd62ed3e1461019357600080fd5b806306fdde03146100ae578063095ea7b3146100cc57806318160ddd146100ef57806323b872dd14610101578063313ce56714610114575b600080fd5b6100b66101a6565b6040516100c391906106a8565b60405180910390f35b6100df6100da366004610712565b610238565b60405190151581526020016100c3565b6002545b6040519081526020016100c3565b6100df61010f36600461073c565b61024f565b60055460405160ff90911681526020016100c3565b6100df610137366004610712565b610271565b6100f361014a366004610778565b6001600160a01b031660009081526020819052604090205490565b6100b6610293565b6100df61017b366004610712565b6102a2565b6100df61018e366004610712565b61032d565b6100f36101a136600461079a565b61033a565b6060600380546101b5906107cd565b80601f01602080910402602001604051908101604052809291908181526020018280546101e1906107cd565b801561022e5780601f106102035761010080835404028352916020019161022e565b820191906000526020600020905b81548152906001019060200180831161021157829003601f168201915b5050505050905090565b6000610245338484610365565b5060015b92915050565b600061025c843384610489565b610267848484610503565b5060019392505050565b600033610267818585610284838361033a565b61028e9190610807565b610365565b6060600480546101b5906107cd565b600033816102b0828661033a565b9050838110156103155760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b60648201526084015b60405180910390fd5b6103228286868403610365565b506001949350505050565b6000610245338484610503565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b6001600160a01b0383166103c75760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161030c565b6001600160a01b0382166104285760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161030c565b6001600160a01b0383811660008181526001602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6000610495848461033a565b905060001981146104fd57818110156104f05760405162461bcd60e51b815260206004820152601d60248201527f45524332303a20696e73756666696369656e7420616c6c6f77616e6365000000604482015260640161030c565b6104fd8484848403610365565b50505050565b6001600160a01b0383166105675760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b606482015260840161030c565b6001600160a01b0382166105c95760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b606482015260840161030c565b6001600160a01b038316600090815260208190526040902054818110156106415760405162461bcd60e51b815260206004820152602660248201527f45524332303a207472616e7366657220616d6f756e7420657863656564732062604482015265616c616e636560d01b606482015260840161030c565b6001600160a01b03848116600081815260208181526040808320878703905593871680835291849020805487019055925185815290927fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef910160405180910390a350505050565b600060208083528351808285015260005b818110156106d5578581018301518582016040015282016106b9565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b038116811461070d57600080fd5b919050565b6000806040838503121561072557600080fd5b61072e836106f6565b946020939093013593505050565b60008060006060848603121561075157600080fd5b61075a846106f6565b9250610768602085016106f6565b9150604084013590509250925092565b60006020828403121561078a57600080fd5b610793826106f6565b9392505050565b600080604083850312156107ad57600080fd5b6107b6836106f6565b91506107c4602084016106f6565b90509250929050565b600181811c908216806107e157607f821691505b60208210810361080157634e487b7160e01b600052602260045260246000fd5b50919050565b8082018082111561024957634e487b7160e01b600052601160045260246000fdfea2646970667358221220c3255f60f0c51810d8bb2051bb29ae44f347d2cfc593b4914d93c980e341488a64736f6c63430008150033"
+
+// ERC20ABI is used to communicate with the compiled solidity code of the generated contract
+const ERC20ABI = "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals_\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"supply\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// ERC20RuntimeBin is the code deployed by ERC20Bin, with libraries left unlinked
+const ERC20RuntimeBin = "0x608060405234801561001057600080fd5b50600436106100a95760003560e01c80633950935111610071578063395093511461012957806370a082311461013c57806395d89b4114610165578063a457c2d71461016d578063a9059cbb14610180578063dd62ed3e1461019357600080fd5b806306fdde03146100ae578063095ea7b3146100cc57806318160ddd146100ef57806323b872dd14610101578063313ce56714610114575b600080fd5b6100b66101a6565b6040516100c391906106a8565b60405180910390f35b6100df6100da366004610712565b610238565b60405190151581526020016100c3565b6002545b6040519081526020016100c3565b6100df61010f36600461073c565b61024f565b60055460405160ff90911681526020016100c3565b6100df610137366004610712565b610271565b6100f361014a366004610778565b6001600160a01b031660009081526020819052604090205490565b6100b6610293565b6100df61017b366004610712565b6102a2565b6100df61018e366004610712565b61032d565b6100f36101a136600461079a565b61033a565b6060600380546101b5906107cd565b80601f01602080910402602001604051908101604052809291908181526020018280546101e1906107cd565b801561022e5780601f106102035761010080835404028352916020019161022e565b820191906000526020600020905b81548152906001019060200180831161021157829003601f168201915b5050505050905090565b6000610245338484610365565b5060015b92915050565b600061025c843384610489565b610267848484610503565b5060019392505050565b600033610267818585610284838361033a565b61028e9190610807565b610365565b6060600480546101b5906107cd565b600033816102b0828661033a565b9050838110156103155760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b60648201526084015b60405180910390fd5b6103228286868403610365565b506001949350505050565b6000610245338484610503565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b6001600160a01b0383166103c75760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161030c565b6001600160a01b0382166104285760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161030c565b6001600160a01b0383811660008181526001602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b6000610495848461033a565b905060001981146104fd57818110156104f05760405162461bcd60e51b815260206004820152601d60248201527f45524332303a20696e73756666696369656e7420616c6c6f77616e6365000000604482015260640161030c565b6104fd8484848403610365565b50505050565b6001600160a01b0383166105675760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b606482015260840161030c565b6001600160a01b0382166105c95760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b606482015260840161030c565b6001600160a01b038316600090815260208190526040902054818110156106415760405162461bcd60e51b815260206004820152602660248201527f45524332303a207472616e7366657220616d6f756e7420657863656564732062604482015265616c616e636560d01b606482015260840161030c565b6001600160a01b03848116600081815260208181526040808320878703905593871680835291849020805487019055925185815290927fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef910160405180910390a350505050565b600060208083528351808285015260005b818110156106d5578581018301518582016040015282016106b9565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b038116811461070d57600080fd5b919050565b6000806040838503121561072557600080fd5b61072e836106f6565b946020939093013593505050565b60008060006060848603121561075157600080fd5b61075a846106f6565b9250610768602085016106f6565b9150604084013590509250925092565b60006020828403121561078a57600080fd5b610793826106f6565b9392505050565b600080604083850312156107ad57600080fd5b6107b6836106f6565b91506107c4602084016106f6565b90509250929050565b600181811c908216806107e157607f821691505b60208210810361080157634e487b7160e01b600052602260045260246000fd5b50919050565b8082018082111561024957634e487b7160e01b600052601160045260246000fdfea2646970667358221220c3255f60f0c51810d8bb2051bb29ae44f347d2cfc593b4914d93c980e341488a64736f6c63430008150033"
+
+// ERC20SourceMap maps the instructions of ERC20Bin to the Solidity sources
+const ERC20SourceMap = "331:4968:0:-:0;;;683:214;;;;;;;;;;;;;;;;;;;;;;;;;;;;:::i;:::-;782:5;:13;790:5;782;:13;:::i;:::-;-1:-1:-1;805:7:0;:17;815:7;805;:17;:::i;:::-;-1:-1:-1;832:9:0;:21;;-1:-1:-1;;832:21:0;;;;;;;863:27;869:12;218:10:2;;139:96;869:12:0;883:6;863:5;:27::i;:::-;683:214;;;;331:4968;;4230:304;-1:-1:-1;;;;;4313:21:0;;4305:65;;;;-1:-1:-1;;;4305:65:0;;4561:2:3;4305:65:0;;;4543:21:3;4600:2;4580:18;;;4573:30;4639:33;4619:18;;;4612:61;4690:18;;4305:65:0;;;;;;;;4397:6;4381:12;;:22;;;;;;;:::i;:::-;;;;-1:-1:-1;;;;;;;4437:18:0;;:9;:18;;;;;;;;;;;:28;;;;;;4490:37;5092:25:3;;;4490:37:0;;5065:18:3;4490:37:0;;;;;;;4230:304;;:::o;14:127:3:-;75:10;70:3;66:20;63:1;56:31;106:4;103:1;96:15;130:4;127:1;120:15;146:840;200:5;253:3;246:4;238:6;234:17;230:27;220:55;;271:1;268;261:12;220:55;294:13;;-1:-1:-1;;;;;356:10:3;;;353:36;;;369:18;;:::i;:::-;444:2;438:9;412:2;498:13;;-1:-1:-1;;494:22:3;;;518:2;490:31;486:40;474:53;;;542:18;;;562:22;;;539:46;536:72;;;588:18;;:::i;:::-;628:10;624:2;617:22;663:2;655:6;648:18;685:4;675:14;;730:3;725:2;720;712:6;708:15;704:24;701:33;698:53;;;747:1;744;737:12;698:53;769:1;760:10;;779:133;793:2;790:1;787:9;779:133;;;881:14;;;877:23;;871:30;850:14;;;846:23;;839:63;804:10;;;;779:133;;;954:1;932:15;;;928:24;;;921:35;;;;936:6;146:840;-1:-1:-1;;;;146:840:3:o;991:774::-;1106:6;1114;1122;1130;1183:3;1171:9;1162:7;1158:23;1154:33;1151:53;;;1200:1;1197;1190:12;1151:53;1227:16;;-1:-1:-1;;;;;1292:14:3;;;1289:34;;;1319:1;1316;1309:12;1289:34;1342:61;1395:7;1386:6;1375:9;1371:22;1342:61;:::i;:::-;1332:71;;1449:2;1438:9;1434:18;1428:25;1412:41;;1478:2;1468:8;1465:16;1462:36;;;1494:1;1491;1484:12;1462:36;;1517:63;1572:7;1561:8;1550:9;1546:24;1517:63;:::i;:::-;1507:73;;;1623:2;1612:9;1608:18;1602:25;1667:4;1660:5;1656:16;1649:5;1646:27;1636:55;;1687:1;1684;1677:12;1636:55;1755:2;1740:18;;;;1734:25;991:774;;;;-1:-1:-1;;;991:774:3:o;1770:380::-;1849:1;1845:12;;;;1892;;;1913:61;;1967:4;1959:6;1955:17;1945:27;;1913:61;2020:2;2012:6;2009:14;1989:18;1986:38;1983:161;;2066:10;2061:3;2057:20;2054:1;2047:31;2101:4;2098:1;2091:15;2129:4;2126:1;2119:15;1983:161;;1770:380;;;:::o;2281:545::-;2383:2;2378:3;2375:11;2372:448;;;2419:1;2444:5;2440:2;2433:17;2489:4;2485:2;2475:19;2559:2;2547:10;2543:19;2540:1;2536:27;2530:4;2526:38;2595:4;2583:10;2580:20;2577:47;;;-1:-1:-1;2618:4:3;2577:47;2673:2;2668:3;2664:12;2661:1;2657:20;2651:4;2647:31;2637:41;;2728:82;2746:2;2739:5;2736:13;2728:82;;;2791:17;;;2772:1;2761:13;2728:82;;;2732:3;;;2372:448;2281:545;;;:::o;3002:1352::-;3122:10;;-1:-1:-1;;;;;3144:30:3;;3141:56;;;3177:18;;:::i;:::-;3206:97;3296:6;3256:38;3288:4;3282:11;3256:38;:::i;:::-;3250:4;3206:97;:::i;:::-;3358:4;;3422:2;3411:14;;3439:1;3434:663;;;;4141:1;4158:6;4155:89;;;-1:-1:-1;4210:19:3;;;4204:26;4155:89;-1:-1:-1;;2959:1:3;2955:11;;;2951:24;2947:29;2937:40;2983:1;2979:11;;;2934:57;4257:81;;3404:944;;3434:663;2228:1;2221:14;;;2265:4;2252:18;;-1:-1:-1;;3470:20:3;;;3588:236;3602:7;3599:1;3596:14;3588:236;;;3691:19;;;3685:26;3670:42;;3783:27;;;;3751:1;3739:14;;;;3618:19;;3588:236;;;3592:3;3852:6;3843:7;3840:19;3837:201;;;3913:19;;;3907:26;-1:-1:-1;;3996:1:3;3992:14;;;4008:3;3988:24;3984:37;3980:42;3965:58;3950:74;;3837:201;-1:-1:-1;;;;;4084:1:3;4068:14;;;4064:22;4051:36;;-1:-1:-1;3002:1352:3:o;4719:222::-;4784:9;;;4805:10;;;4802:133;;;4857:10;4852:3;4848:20;4845:1;4838:31;4892:4;4889:1;4882:15;4920:4;4917:1;4910:15;4802:133;4719:222;;;;:::o;4946:177::-;331:4968:0;;;;;;"
+
+// ERC20RuntimeSourceMap maps the instructions of ERC20RuntimeBin to the Solidity sources
+const ERC20RuntimeSourceMap = "331:4968:0:-:0;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;950:98;;;:::i;:::-;;;;;;;:::i;:::-;;;;;;;;2308:166;;;;;;:::i;:::-;;:::i;:::-;;;1169:14:3;;1162:22;1144:41;;1132:2;1117:18;2308:166:0;1004:187:3;1450:106:0;1537:12;;1450:106;;;1342:25:3;;;1330:2;1315:18;1450:106:0;1196:177:3;2579:221:0;;;;;;:::i;:::-;;:::i;1287:98::-;1369:9;;1287:98;;1369:9;;;;1853:36:3;;1841:2;1826:18;1287:98:0;1711:184:3;2869:234:0;;;;;;:::i;:::-;;:::i;1625:125::-;;;;;;:::i;:::-;-1:-1:-1;;;;;1725:18:0;1699:7;1725:18;;;;;;;;;;;;1625:125;1103:102;;;:::i;3177:426::-;;;;;;:::i;:::-;;:::i;1815:158::-;;;;;;:::i;:::-;;:::i;2076:149::-;;;;;;:::i;:::-;;:::i;950:98::-;1004:13;1036:5;1029:12;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;950:98;:::o;2308:166::-;2391:4;2407:39;218:10:2;2430:7:0;2439:6;2407:8;:39::i;:::-;-1:-1:-1;2463:4:0;2308:166;;;;;:::o;2579:221::-;2676:4;2692:43;2708:4;218:10:2;2728:6:0;2692:15;:43::i;:::-;2745:27;2755:4;2761:2;2765:6;2745:9;:27::i;:::-;-1:-1:-1;2789:4:0;2579:221;;;;;:::o;2869:234::-;2957:4;218:10:2;3011:64:0;218:10:2;3027:7:0;3064:10;3036:25;218:10:2;3027:7:0;3036:9;:25::i;:::-;:38;;;;:::i;:::-;3011:8;:64::i;1103:102::-;1159:13;1191:7;1184:14;;;;;:::i;3177:426::-;3270:4;218:10:2;3270:4:0;3351:25;218:10:2;3368:7:0;3351:9;:25::i;:::-;3324:52;;3414:15;3394:16;:35;;3386:85;;;;-1:-1:-1;;;3386:85:0;;3170:2:3;3386:85:0;;;3152:21:3;3209:2;3189:18;;;3182:30;3248:34;3228:18;;;3221:62;-1:-1:-1;;;3299:18:3;;;3292:35;3344:19;;3386:85:0;;;;;;;;;3505:60;3514:5;3521:7;3549:15;3530:16;:34;3505:8;:60::i;:::-;-1:-1:-1;3592:4:0;;3177:426;-1:-1:-1;;;;3177:426:0:o;1815:158::-;1894:4;1910:35;218:10:2;1934:2:0;1938:6;1910:9;:35::i;2076:149::-;-1:-1:-1;;;;;2191:18:0;;;2165:7;2191:18;;;:11;:18;;;;;;;;:27;;;;;;;;;;;;;2076:149::o;4540:340::-;-1:-1:-1;;;;;4641:19:0;;4633:68;;;;-1:-1:-1;;;4633:68:0;;3576:2:3;4633:68:0;;;3558:21:3;3615:2;3595:18;;;3588:30;3654:34;3634:18;;;3627:62;-1:-1:-1;;;3705:18:3;;;3698:34;3749:19;;4633:68:0;3374:400:3;4633:68:0;-1:-1:-1;;;;;4719:21:0;;4711:68;;;;-1:-1:-1;;;4711:68:0;;3981:2:3;4711:68:0;;;3963:21:3;4020:2;4000:18;;;3993:30;4059:34;4039:18;;;4032:62;-1:-1:-1;;;4110:18:3;;;4103:32;4152:19;;4711:68:0;3779:398:3;4711:68:0;-1:-1:-1;;;;;4790:18:0;;;;;;;:11;:18;;;;;;;;:27;;;;;;;;;;;;;:36;;;4841:32;;1342:25:3;;;4841:32:0;;1315:18:3;4841:32:0;;;;;;;4540:340;;;:::o;4886:411::-;4986:24;5013:25;5023:5;5030:7;5013:9;:25::i;:::-;4986:52;;-1:-1:-1;;5052:16:0;:37;5048:243;;5133:6;5113:16;:26;;5105:68;;;;-1:-1:-1;;;5105:68:0;;4384:2:3;5105:68:0;;;4366:21:3;4423:2;4403:18;;;4396:30;4462:31;4442:18;;;4435:59;4511:18;;5105:68:0;4182:353:3;5105:68:0;5215:51;5224:5;5231:7;5259:6;5240:16;:25;5215:8;:51::i;:::-;4976:321;4886:411;;;:::o;3609:615::-;-1:-1:-1;;;;;3705:18:0;;3697:68;;;;-1:-1:-1;;;3697:68:0;;4742:2:3;3697:68:0;;;4724:21:3;4781:2;4761:18;;;4754:30;4820:34;4800:18;;;4793:62;-1:-1:-1;;;4871:18:3;;;4864:35;4916:19;;3697:68:0;4540:401:3;3697:68:0;-1:-1:-1;;;;;3783:16:0;;3775:64;;;;-1:-1:-1;;;3775:64:0;;5148:2:3;3775:64:0;;;5130:21:3;5187:2;5167:18;;;5160:30;5226:34;5206:18;;;5199:62;-1:-1:-1;;;5277:18:3;;;5270:33;5320:19;;3775:64:0;4946:399:3;3775:64:0;-1:-1:-1;;;;;3872:15:0;;3850:19;3872:15;;;;;;;;;;;3905:21;;;;3897:72;;;;-1:-1:-1;;;3897:72:0;;5552:2:3;3897:72:0;;;5534:21:3;5591:2;5571:18;;;5564:30;5630:34;5610:18;;;5603:62;-1:-1:-1;;;5681:18:3;;;5674:36;5727:19;;3897:72:0;5350:402:3;3897:72:0;-1:-1:-1;;;;;4003:15:0;;;:9;:15;;;;;;;;;;;4021:20;;;4003:38;;4143:13;;;;;;;;;;:23;;;;;;4191:26;;1342:25:3;;;4143:13:0;;4191:26;;1315:18:3;4191:26:0;;;;;;;3687:537;3609:615;;;:::o;14:548:3:-;126:4;155:2;184;173:9;166:21;216:6;210:13;259:6;254:2;243:9;239:18;232:34;284:1;294:140;308:6;305:1;302:13;294:140;;;403:14;;;399:23;;393:30;369:17;;;388:2;365:26;358:66;323:10;;294:140;;;298:3;483:1;478:2;469:6;458:9;454:22;450:31;443:42;553:2;546;542:7;537:2;529:6;525:15;521:29;510:9;506:45;502:54;494:62;;;;14:548;;;;:::o;567:173::-;635:20;;-1:-1:-1;;;;;684:31:3;;674:42;;664:70;;730:1;727;720:12;664:70;567:173;;;:::o;745:254::-;813:6;821;874:2;862:9;853:7;849:23;845:32;842:52;;;890:1;887;880:12;842:52;913:29;932:9;913:29;:::i;:::-;903:39;989:2;974:18;;;;961:32;;-1:-1:-1;;;745:254:3:o;1378:328::-;1455:6;1463;1471;1524:2;1512:9;1503:7;1499:23;1495:32;1492:52;;;1540:1;1537;1530:12;1492:52;1563:29;1582:9;1563:29;:::i;:::-;1553:39;;1611:38;1645:2;1634:9;1630:18;1611:38;:::i;:::-;1601:48;;1696:2;1685:9;1681:18;1668:32;1658:42;;1378:328;;;;;:::o;1900:186::-;1959:6;2012:2;2000:9;1991:7;1987:23;1983:32;1980:52;;;2028:1;2025;2018:12;1980:52;2051:29;2070:9;2051:29;:::i;:::-;2041:39;1900:186;-1:-1:-1;;;1900:186:3:o;2091:260::-;2159:6;2167;2220:2;2208:9;2199:7;2195:23;2191:32;2188:52;;;2236:1;2233;2226:12;2188:52;2259:29;2278:9;2259:29;:::i;:::-;2249:39;;2307:38;2341:2;2330:9;2326:18;2307:38;:::i;:::-;2297:48;;2091:260;;;;;:::o;2356:380::-;2435:1;2431:12;;;;2478;;;2499:61;;2553:4;2545:6;2541:17;2531:27;;2499:61;2606:2;2598:6;2595:14;2575:18;2572:38;2569:161;;2652:10;2647:3;2643:20;2640:1;2633:31;2687:4;2684:1;2677:15;2715:4;2712:1;2705:15;2569:161;;2356:380;;;:::o;2741:222::-;2806:9;;;2827:10;;;2824:133;;;2879:10;2874:3;2870:20;2867:1;2860:31;2914:4;2911:1;2904:15;2942:4;2939:1;2932:15"
+
+// ERC20FuncSigs maps the 4-byte function signature to its string representation.
+var ERC20FuncSigs = map[string]string{
+	"dd62ed3e": "allowance(address,address)",
+	"095ea7b3": "approve(address,uint256)",
+	"70a08231": "balanceOf(address)",
+	"313ce567": "decimals()",
+	"a457c2d7": "decreaseAllowance(address,uint256)",
+	"39509351": "increaseAllowance(address,uint256)",
+	"06fdde03": "name()",
+	"95d89b41": "symbol()",
+	"18160ddd": "totalSupply()",
+	"a9059cbb": "transfer(address,uint256)",
+	"23b872dd": "transferFrom(address,address,uint256)",
+}
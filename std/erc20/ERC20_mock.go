@@ -0,0 +1,611 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+)
+
+// ERC20Mock is a scriptable implementation of ERC20Interface for unit tests.
+// Results are scripted per method and arguments, for example
+//
+//	mock.OnMethod(args...).Return(results..., err)
+//
+// Calls that were not scripted return zero values and an error.
+type ERC20Mock struct {
+	mu    sync.Mutex
+	calls []ERC20MockCall
+
+	onAllowance   []*ERC20AllowanceMock
+	onBalanceOf   []*ERC20BalanceOfMock
+	onDecimals    []*ERC20DecimalsMock
+	onName        []*ERC20NameMock
+	onSymbol      []*ERC20SymbolMock
+	onTotalSupply []*ERC20TotalSupplyMock
+
+	onApprove           []*ERC20ApproveMock
+	onDecreaseAllowance []*ERC20DecreaseAllowanceMock
+	onIncreaseAllowance []*ERC20IncreaseAllowanceMock
+	onTransfer          []*ERC20TransferMock
+	onTransferFrom      []*ERC20TransferFromMock
+
+	onUnpackApprovalLog []*ERC20UnpackApprovalLogMock
+	onUnpackTransferLog []*ERC20UnpackTransferLogMock
+}
+
+// This nil assignment ensures at compile time that ERC20Mock implements ERC20Interface.
+var _ ERC20Interface = (*ERC20Mock)(nil)
+
+// ERC20MockCall records a single call made to a ERC20Mock.
+type ERC20MockCall struct {
+	Method string        // Name of the called method
+	Args   []interface{} // Arguments passed to the method, excluding opts
+}
+
+// MockCalls returns every call made to the mock so far, in order.
+func (_m *ERC20Mock) MockCalls() []ERC20MockCall {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	return append([]ERC20MockCall(nil), _m.calls...)
+}
+
+// matchERC20MockArgs compares scripted and received arguments, treating big
+// integers as equal whenever they hold the same value.
+func matchERC20MockArgs(want, got []interface{}) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		w, wok := want[i].(*big.Int)
+		g, gok := got[i].(*big.Int)
+		if wok && gok && w != nil && g != nil {
+			if w.Cmp(g) != 0 {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(want[i], got[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// ERC20AllowanceMock scripts the results of ERC20Mock.Allowance.
+type ERC20AllowanceMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnAllowance scripts the results of Allowance calls made with the given arguments.
+func (_m *ERC20Mock) OnAllowance(owner common.Address, spender common.Address) *ERC20AllowanceMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20AllowanceMock{mock: _m, args: []interface{}{owner, spender}}
+	_m.onAllowance = append(_m.onAllowance, _e)
+	return _e
+}
+
+// Return sets the results of the Allowance calls matching the scripted arguments.
+func (_e *ERC20AllowanceMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Allowance records the call and returns the results scripted for its arguments.
+func (_m *ERC20Mock) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	_args := []interface{}{owner, spender}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "Allowance", Args: _args})
+	for _i := len(_m.onAllowance) - 1; _i >= 0; _i-- {
+		if _e := _m.onAllowance[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC20AllowanceMock
+	return _e.ret0, fmt.Errorf("ERC20Mock: no results scripted for Allowance%v", _args)
+}
+
+// ERC20BalanceOfMock scripts the results of ERC20Mock.BalanceOf.
+type ERC20BalanceOfMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnBalanceOf scripts the results of BalanceOf calls made with the given arguments.
+func (_m *ERC20Mock) OnBalanceOf(account common.Address) *ERC20BalanceOfMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20BalanceOfMock{mock: _m, args: []interface{}{account}}
+	_m.onBalanceOf = append(_m.onBalanceOf, _e)
+	return _e
+}
+
+// Return sets the results of the BalanceOf calls matching the scripted arguments.
+func (_e *ERC20BalanceOfMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// BalanceOf records the call and returns the results scripted for its arguments.
+func (_m *ERC20Mock) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	_args := []interface{}{account}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "BalanceOf", Args: _args})
+	for _i := len(_m.onBalanceOf) - 1; _i >= 0; _i-- {
+		if _e := _m.onBalanceOf[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC20BalanceOfMock
+	return _e.ret0, fmt.Errorf("ERC20Mock: no results scripted for BalanceOf%v", _args)
+}
+
+// ERC20DecimalsMock scripts the results of ERC20Mock.Decimals.
+type ERC20DecimalsMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	ret0 uint8
+	err  error
+}
+
+// OnDecimals scripts the results of Decimals calls made with the given arguments.
+func (_m *ERC20Mock) OnDecimals() *ERC20DecimalsMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20DecimalsMock{mock: _m, args: []interface{}{}}
+	_m.onDecimals = append(_m.onDecimals, _e)
+	return _e
+}
+
+// Return sets the results of the Decimals calls matching the scripted arguments.
+func (_e *ERC20DecimalsMock) Return(ret0 uint8, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Decimals records the call and returns the results scripted for its arguments.
+func (_m *ERC20Mock) Decimals(opts *bind.CallOpts) (uint8, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "Decimals", Args: _args})
+	for _i := len(_m.onDecimals) - 1; _i >= 0; _i-- {
+		if _e := _m.onDecimals[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC20DecimalsMock
+	return _e.ret0, fmt.Errorf("ERC20Mock: no results scripted for Decimals%v", _args)
+}
+
+// ERC20NameMock scripts the results of ERC20Mock.Name.
+type ERC20NameMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	ret0 string
+	err  error
+}
+
+// OnName scripts the results of Name calls made with the given arguments.
+func (_m *ERC20Mock) OnName() *ERC20NameMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20NameMock{mock: _m, args: []interface{}{}}
+	_m.onName = append(_m.onName, _e)
+	return _e
+}
+
+// Return sets the results of the Name calls matching the scripted arguments.
+func (_e *ERC20NameMock) Return(ret0 string, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Name records the call and returns the results scripted for its arguments.
+func (_m *ERC20Mock) Name(opts *bind.CallOpts) (string, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "Name", Args: _args})
+	for _i := len(_m.onName) - 1; _i >= 0; _i-- {
+		if _e := _m.onName[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC20NameMock
+	return _e.ret0, fmt.Errorf("ERC20Mock: no results scripted for Name%v", _args)
+}
+
+// ERC20SymbolMock scripts the results of ERC20Mock.Symbol.
+type ERC20SymbolMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	ret0 string
+	err  error
+}
+
+// OnSymbol scripts the results of Symbol calls made with the given arguments.
+func (_m *ERC20Mock) OnSymbol() *ERC20SymbolMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20SymbolMock{mock: _m, args: []interface{}{}}
+	_m.onSymbol = append(_m.onSymbol, _e)
+	return _e
+}
+
+// Return sets the results of the Symbol calls matching the scripted arguments.
+func (_e *ERC20SymbolMock) Return(ret0 string, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Symbol records the call and returns the results scripted for its arguments.
+func (_m *ERC20Mock) Symbol(opts *bind.CallOpts) (string, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "Symbol", Args: _args})
+	for _i := len(_m.onSymbol) - 1; _i >= 0; _i-- {
+		if _e := _m.onSymbol[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC20SymbolMock
+	return _e.ret0, fmt.Errorf("ERC20Mock: no results scripted for Symbol%v", _args)
+}
+
+// ERC20TotalSupplyMock scripts the results of ERC20Mock.TotalSupply.
+type ERC20TotalSupplyMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnTotalSupply scripts the results of TotalSupply calls made with the given arguments.
+func (_m *ERC20Mock) OnTotalSupply() *ERC20TotalSupplyMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20TotalSupplyMock{mock: _m, args: []interface{}{}}
+	_m.onTotalSupply = append(_m.onTotalSupply, _e)
+	return _e
+}
+
+// Return sets the results of the TotalSupply calls matching the scripted arguments.
+func (_e *ERC20TotalSupplyMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// TotalSupply records the call and returns the results scripted for its arguments.
+func (_m *ERC20Mock) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "TotalSupply", Args: _args})
+	for _i := len(_m.onTotalSupply) - 1; _i >= 0; _i-- {
+		if _e := _m.onTotalSupply[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC20TotalSupplyMock
+	return _e.ret0, fmt.Errorf("ERC20Mock: no results scripted for TotalSupply%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// ERC20ApproveMock scripts the results of ERC20Mock.Approve.
+type ERC20ApproveMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnApprove scripts the results of Approve transactions made with the given arguments.
+func (_m *ERC20Mock) OnApprove(spender common.Address, amount *big.Int) *ERC20ApproveMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20ApproveMock{mock: _m, args: []interface{}{spender, amount}}
+	_m.onApprove = append(_m.onApprove, _e)
+	return _e
+}
+
+// Return sets the results of the Approve transactions matching the scripted arguments.
+func (_e *ERC20ApproveMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Approve records the transaction and returns the results scripted for its arguments.
+func (_m *ERC20Mock) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{spender, amount}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "Approve", Args: _args})
+	for _i := len(_m.onApprove) - 1; _i >= 0; _i-- {
+		if _e := _m.onApprove[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC20Mock: no results scripted for Approve%v", _args)
+}
+
+// ERC20DecreaseAllowanceMock scripts the results of ERC20Mock.DecreaseAllowance.
+type ERC20DecreaseAllowanceMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnDecreaseAllowance scripts the results of DecreaseAllowance transactions made with the given arguments.
+func (_m *ERC20Mock) OnDecreaseAllowance(spender common.Address, subtractedValue *big.Int) *ERC20DecreaseAllowanceMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20DecreaseAllowanceMock{mock: _m, args: []interface{}{spender, subtractedValue}}
+	_m.onDecreaseAllowance = append(_m.onDecreaseAllowance, _e)
+	return _e
+}
+
+// Return sets the results of the DecreaseAllowance transactions matching the scripted arguments.
+func (_e *ERC20DecreaseAllowanceMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// DecreaseAllowance records the transaction and returns the results scripted for its arguments.
+func (_m *ERC20Mock) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{spender, subtractedValue}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "DecreaseAllowance", Args: _args})
+	for _i := len(_m.onDecreaseAllowance) - 1; _i >= 0; _i-- {
+		if _e := _m.onDecreaseAllowance[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC20Mock: no results scripted for DecreaseAllowance%v", _args)
+}
+
+// ERC20IncreaseAllowanceMock scripts the results of ERC20Mock.IncreaseAllowance.
+type ERC20IncreaseAllowanceMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnIncreaseAllowance scripts the results of IncreaseAllowance transactions made with the given arguments.
+func (_m *ERC20Mock) OnIncreaseAllowance(spender common.Address, addedValue *big.Int) *ERC20IncreaseAllowanceMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20IncreaseAllowanceMock{mock: _m, args: []interface{}{spender, addedValue}}
+	_m.onIncreaseAllowance = append(_m.onIncreaseAllowance, _e)
+	return _e
+}
+
+// Return sets the results of the IncreaseAllowance transactions matching the scripted arguments.
+func (_e *ERC20IncreaseAllowanceMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// IncreaseAllowance records the transaction and returns the results scripted for its arguments.
+func (_m *ERC20Mock) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{spender, addedValue}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "IncreaseAllowance", Args: _args})
+	for _i := len(_m.onIncreaseAllowance) - 1; _i >= 0; _i-- {
+		if _e := _m.onIncreaseAllowance[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC20Mock: no results scripted for IncreaseAllowance%v", _args)
+}
+
+// ERC20TransferMock scripts the results of ERC20Mock.Transfer.
+type ERC20TransferMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnTransfer scripts the results of Transfer transactions made with the given arguments.
+func (_m *ERC20Mock) OnTransfer(to common.Address, amount *big.Int) *ERC20TransferMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20TransferMock{mock: _m, args: []interface{}{to, amount}}
+	_m.onTransfer = append(_m.onTransfer, _e)
+	return _e
+}
+
+// Return sets the results of the Transfer transactions matching the scripted arguments.
+func (_e *ERC20TransferMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Transfer records the transaction and returns the results scripted for its arguments.
+func (_m *ERC20Mock) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{to, amount}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "Transfer", Args: _args})
+	for _i := len(_m.onTransfer) - 1; _i >= 0; _i-- {
+		if _e := _m.onTransfer[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC20Mock: no results scripted for Transfer%v", _args)
+}
+
+// ERC20TransferFromMock scripts the results of ERC20Mock.TransferFrom.
+type ERC20TransferFromMock struct {
+	mock *ERC20Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnTransferFrom scripts the results of TransferFrom transactions made with the given arguments.
+func (_m *ERC20Mock) OnTransferFrom(from common.Address, to common.Address, amount *big.Int) *ERC20TransferFromMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20TransferFromMock{mock: _m, args: []interface{}{from, to, amount}}
+	_m.onTransferFrom = append(_m.onTransferFrom, _e)
+	return _e
+}
+
+// Return sets the results of the TransferFrom transactions matching the scripted arguments.
+func (_e *ERC20TransferFromMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// TransferFrom records the transaction and returns the results scripted for its arguments.
+func (_m *ERC20Mock) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{from, to, amount}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "TransferFrom", Args: _args})
+	for _i := len(_m.onTransferFrom) - 1; _i >= 0; _i-- {
+		if _e := _m.onTransferFrom[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC20Mock: no results scripted for TransferFrom%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+// ERC20UnpackApprovalLogMock scripts the results of ERC20Mock.UnpackApprovalLog.
+type ERC20UnpackApprovalLogMock struct {
+	mock  *ERC20Mock
+	args  []interface{}
+	event *ApprovalLog
+	err   error
+}
+
+// OnUnpackApprovalLog scripts the results of unpacking the given log.
+func (_m *ERC20Mock) OnUnpackApprovalLog(log types.Log) *ERC20UnpackApprovalLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20UnpackApprovalLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackApprovalLog = append(_m.onUnpackApprovalLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC20UnpackApprovalLogMock) Return(event *ApprovalLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackApprovalLog records the call and returns the results scripted for the log.
+func (_m *ERC20Mock) UnpackApprovalLog(log types.Log) (*ApprovalLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "UnpackApprovalLog", Args: _args})
+	for _i := len(_m.onUnpackApprovalLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackApprovalLog[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC20Mock: no results scripted for UnpackApprovalLog")
+}
+
+// ERC20UnpackTransferLogMock scripts the results of ERC20Mock.UnpackTransferLog.
+type ERC20UnpackTransferLogMock struct {
+	mock  *ERC20Mock
+	args  []interface{}
+	event *TransferLog
+	err   error
+}
+
+// OnUnpackTransferLog scripts the results of unpacking the given log.
+func (_m *ERC20Mock) OnUnpackTransferLog(log types.Log) *ERC20UnpackTransferLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC20UnpackTransferLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackTransferLog = append(_m.onUnpackTransferLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC20UnpackTransferLogMock) Return(event *TransferLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackTransferLog records the call and returns the results scripted for the log.
+func (_m *ERC20Mock) UnpackTransferLog(log types.Log) (*TransferLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC20MockCall{Method: "UnpackTransferLog", Args: _args})
+	for _i := len(_m.onUnpackTransferLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackTransferLog[_i]; matchERC20MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC20Mock: no results scripted for UnpackTransferLog")
+}
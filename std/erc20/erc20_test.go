@@ -0,0 +1,76 @@
+package erc20
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/evan-forbes/buddy/sim"
+)
+
+func TestERC20(t *testing.T) {
+	accounts := sim.NewAccounts("alice", "bob", "carol")
+	backend := sim.NewSimulatedBackend(accounts.Genesis(), 10000000)
+	defer backend.Close()
+	alice, bob, carol := accounts["alice"], accounts["bob"], accounts["carol"]
+
+	supply := big.NewInt(1000)
+	_, _, token, err := DeployERC20(alice.TxOpts, backend, "Buddy", "BUD", 18, supply)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	if name, err := token.Name(nil); err != nil || name != "Buddy" {
+		t.Errorf("name = %q, %v", name, err)
+	}
+	if symbol, err := token.Symbol(nil); err != nil || symbol != "BUD" {
+		t.Errorf("symbol = %q, %v", symbol, err)
+	}
+	if decimals, err := token.Decimals(nil); err != nil || decimals != 18 {
+		t.Errorf("decimals = %d, %v", decimals, err)
+	}
+	if total, err := token.TotalSupply(nil); err != nil || total.Cmp(supply) != 0 {
+		t.Errorf("total supply = %v, %v", total, err)
+	}
+	if _, err := token.Transfer(alice.TxOpts, bob.Address, big.NewInt(300)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := token.Approve(bob.TxOpts, carol.Address, big.NewInt(200)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if _, err := token.TransferFrom(carol.TxOpts, bob.Address, carol.Address, big.NewInt(150)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	for account, want := range map[*sim.Account]int64{alice: 700, bob: 150, carol: 150} {
+		if balance, err := token.BalanceOf(nil, account.Address); err != nil || balance.Int64() != want {
+			t.Errorf("balance of %s = %v, %v, want %d", account.Name, balance, err, want)
+		}
+	}
+	if allowance, err := token.Allowance(nil, bob.Address, carol.Address); err != nil || allowance.Int64() != 50 {
+		t.Errorf("allowance = %v, %v, want 50", allowance, err)
+	}
+
+	transfers, err := token.FilterTransfer(nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var logged []int64
+	for transfers.Next() {
+		logged = append(logged, transfers.Event.Value.Int64())
+	}
+	if len(logged) != 3 || logged[0] != 1000 || logged[1] != 300 || logged[2] != 150 {
+		t.Errorf("logged transfers %v, want [1000 300 150]", logged)
+	}
+
+	var revert ERC20Revert
+	if _, err := token.TransferFrom(carol.TxOpts, bob.Address, carol.Address, big.NewInt(100)); !errors.As(err, &revert) || revert.Reason != "ERC20: insufficient allowance" {
+		t.Errorf("spending past the allowance failed with %v", err)
+	}
+	if _, err := token.Transfer(bob.TxOpts, carol.Address, big.NewInt(151)); !errors.As(err, &revert) || revert.Reason != "ERC20: transfer amount exceeds balance" {
+		t.Errorf("transferring past the balance failed with %v", err)
+	}
+}
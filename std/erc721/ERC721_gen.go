@@ -0,0 +1,2537 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package erc721
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/evan-forbes/buddy/std"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = context.Background
+	_ = errors.New
+	_ = json.Marshal
+	_ = fmt.Errorf
+	_ = big.NewInt
+	_ = reflect.ValueOf
+	_ = strings.NewReader
+	_ = sync.NewCond
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = hexutil.Encode
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ERC721 is a wrapper around BoundContract, enforcing type checking and including
+// QoL helper methods
+//
+// Title: ERC721
+//
+// A non fungible token whose tokens are minted by its owner.
+//
+// Follows the OpenZeppelin Contracts 4.9 implementation and its revert reasons, without token URIs.
+type ERC721 struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+	abi      abi.ABI             // Parsed ABI, used to replay failed transactions
+	address  common.Address      // Address the contract is deployed at
+	backend  bind.ContractBackend
+}
+
+// NewERC721 creates a new instance of ERC721, bound to a specific deployed contract.
+func NewERC721(address common.Address, backend bind.ContractBackend) (*ERC721, error) {
+	a, err := parseERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(address, a, backend, backend, backend)
+	return &ERC721{contract: contract, abi: a, address: address, backend: backend}, nil
+}
+
+// parseERC721ABI parses ERC721ABI, keying overloaded methods by their full
+// signature so that every binding calls the exact overload it was generated for.
+func parseERC721ABI() (abi.ABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(ERC721ABI))
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	overloads := make(map[string]int)
+	for _, method := range parsed.Methods {
+		overloads[method.RawName]++
+	}
+	methods := make(map[string]abi.Method, len(parsed.Methods))
+	for name, method := range parsed.Methods {
+		if overloads[method.RawName] > 1 {
+			name = method.Sig()
+		}
+		methods[name] = method
+	}
+	parsed.Methods = methods
+	return parsed, nil
+}
+
+// bound exposes the underlying BoundContract used for the low level calls
+func (_ERC721 *ERC721) bound() *bind.BoundContract {
+	return _ERC721.contract
+}
+
+// transact invokes method. Should that fail while estimating gas, the invocation
+// is replayed as a call to recover the revert data behind the failure.
+func (_ERC721 *ERC721) transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	tx, err := _ERC721.contract.Transact(opts, method, params...)
+	if err == nil || opts.GasLimit != 0 {
+		return tx, err
+	}
+	msg, perr := _ERC721.message(opts, method, params...)
+	if perr != nil {
+		return nil, err
+	}
+	if _, cerr := _ERC721.backend.CallContract(contextOfERC721(opts), msg, nil); cerr != nil {
+		if decoded := unpackERC721Error(cerr); decoded != cerr {
+			return nil, decoded
+		}
+	}
+	return nil, err
+}
+
+// estimate estimates the gas needed to invoke method with the given options.
+func (_ERC721 *ERC721) estimate(opts *bind.TransactOpts, method string, params ...interface{}) (uint64, error) {
+	msg, err := _ERC721.message(opts, method, params...)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := _ERC721.backend.EstimateGas(contextOfERC721(opts), msg)
+	return gas, unpackERC721Error(err)
+}
+
+// simulate runs method as an eth_call from opts.From against the pending state,
+// unpacking its outputs into out, if any.
+func (_ERC721 *ERC721) simulate(opts *bind.TransactOpts, out interface{}, method string, params ...interface{}) error {
+	msg, err := _ERC721.message(opts, method, params...)
+	if err != nil {
+		return err
+	}
+	var output []byte
+	if pending, ok := _ERC721.backend.(bind.PendingContractCaller); ok {
+		output, err = pending.PendingCallContract(contextOfERC721(opts), msg)
+	} else {
+		output, err = _ERC721.backend.CallContract(contextOfERC721(opts), msg, nil)
+	}
+	if err != nil {
+		return unpackERC721Error(err)
+	}
+	if out == nil {
+		return nil
+	}
+	return _ERC721.abi.Unpack(out, method, output)
+}
+
+// message packs an invocation of method into a call message sent with opts.
+func (_ERC721 *ERC721) message(opts *bind.TransactOpts, method string, params ...interface{}) (ethereum.CallMsg, error) {
+	if opts == nil {
+		return ethereum.CallMsg{}, errors.New("no transact options to invoke ERC721." + method + " with")
+	}
+	input, err := _ERC721.abi.Pack(method, params...)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	return ethereum.CallMsg{
+		From:     opts.From,
+		To:       &_ERC721.address,
+		Gas:      opts.GasLimit,
+		GasPrice: opts.GasPrice,
+		Value:    opts.Value,
+		Data:     input,
+	}, nil
+}
+
+// contextOfERC721 returns the context of opts, defaulting to the background one.
+func contextOfERC721(opts *bind.TransactOpts) context.Context {
+	if opts.Context == nil {
+		return context.Background()
+	}
+	return opts.Context
+}
+
+//////////////////////////////////////////////////////
+//		Interface
+////////////////////////////////////////////////////
+
+// ERC721Interface lists every call, transaction and log unpacker of ERC721.
+// It is regenerated along with the binding, so it can't drift from the ABI.
+type ERC721Interface interface {
+	BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error)
+	GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error)
+	IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error)
+	Name(opts *bind.CallOpts) (string, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error)
+	SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error)
+	Symbol(opts *bind.CallOpts) (string, error)
+	TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error)
+
+	Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error)
+	Mint(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error)
+	SafeTransferFromWithData(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error)
+	SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+
+	UnpackApprovalLog(log types.Log) (*ApprovalLog, error)
+	UnpackApprovalForAllLog(log types.Log) (*ApprovalForAllLog, error)
+	UnpackOwnershipTransferredLog(log types.Log) (*OwnershipTransferredLog, error)
+	UnpackTransferLog(log types.Log) (*TransferLog, error)
+}
+
+// This nil assignment ensures at compile time that ERC721 implements ERC721Interface.
+var _ ERC721Interface = (*ERC721)(nil)
+
+// This nil assignment ensures at compile time that ERC721 implements std.ERC721, the
+// standard its ABI conforms to.
+var _ std.ERC721 = (*ERC721)(nil)
+
+//////////////////////////////////////////////////////
+//		Deployment
+////////////////////////////////////////////////////
+
+// DeployERC721 deploys a new Ethereum contract, binding an instance of ERC721 to it.
+func DeployERC721(auth *bind.TransactOpts, backend bind.ContractBackend, name_ string, symbol_ string) (common.Address, *types.Transaction, *ERC721, error) {
+	parsed, err := parseERC721ABI()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(ERC721Bin), backend, name_, symbol_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC721{contract: contract, abi: parsed, address: address, backend: backend}, nil
+}
+
+// ErrERC721CodeMismatch is returned by NewERC721Verified when the code deployed
+// at an address isn't the one of ERC721.
+var ErrERC721CodeMismatch = errors.New("code does not match ERC721Bin")
+
+// NewERC721Verified binds an instance of ERC721, after checking that the code
+// deployed at address is the runtime code of ERC721Bin.
+func NewERC721Verified(ctx context.Context, address common.Address, backend bind.ContractBackend) (*ERC721, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w: %s", bind.ErrNoCode, address.Hex())
+	}
+	if !matchERC721Code(code) {
+		return nil, fmt.Errorf("%w: %s", ErrERC721CodeMismatch, address.Hex())
+	}
+	return NewERC721(address, backend)
+}
+
+// matchERC721Code reports whether code is the runtime code embedded in ERC721Bin.
+// The metadata hash is ignored, as are the zeroed regions the bytecode reserves
+// for immutables and linked library addresses.
+func matchERC721Code(code []byte) bool {
+	template := ERC721Bin
+
+	bin := common.FromHex(template)
+
+	// Strip the CBOR encoded metadata, its length being in the last two bytes
+	if n := len(code); n > 2 {
+		if meta := int(code[n-2])<<8 | int(code[n-1]); meta+2 < n && code[n-2-meta]&0xe0 == 0xa0 {
+			code = code[:n-2-meta]
+		}
+	}
+	// Mask the runs of zeros long enough to hold an address or an immutable
+	masked := make([]bool, len(bin))
+	for start := 0; start < len(bin); {
+		end := start
+		for end < len(bin) && bin[end] == 0 {
+			end++
+		}
+		if end-start >= common.AddressLength {
+			for i := start; i < end; i++ {
+				masked[i] = true
+			}
+		}
+		start = end + 1
+	}
+	// Look for the runtime code within the creation code
+	for offset := 0; len(code) > 0 && offset+len(code) <= len(bin); offset++ {
+		matched := true
+		for i := range code {
+			if code[i] != bin[offset+i] && !masked[offset+i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+// - Solidity: function balanceOf(address owner) constant returns(uint256)
+//
+// Returns the number of tokens owned by owner.
+func (_ERC721 *ERC721) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ERC721.bound().Call(opts, out, "balanceOf", owner)
+	return *ret0, unpackERC721Error(err)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+// - Solidity: function getApproved(uint256 tokenId) constant returns(address)
+//
+// Returns the account approved for tokenId, which must exist.
+func (_ERC721 *ERC721) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _ERC721.bound().Call(opts, out, "getApproved", tokenId)
+	return *ret0, unpackERC721Error(err)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+// - Solidity: function isApprovedForAll(address owner, address operator) constant returns(bool)
+//
+// Returns whether operator is allowed to manage all of the tokens of owner.
+func (_ERC721 *ERC721) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ERC721.bound().Call(opts, out, "isApprovedForAll", owner, operator)
+	return *ret0, unpackERC721Error(err)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+// - Solidity: function name() constant returns(string)
+//
+// Returns the name of the token.
+func (_ERC721 *ERC721) Name(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _ERC721.bound().Call(opts, out, "name")
+	return *ret0, unpackERC721Error(err)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+// - Solidity: function owner() constant returns(address)
+//
+// Returns the owner of the contract.
+func (_ERC721 *ERC721) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _ERC721.bound().Call(opts, out, "owner")
+	return *ret0, unpackERC721Error(err)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+// - Solidity: function ownerOf(uint256 tokenId) constant returns(address)
+//
+// Returns the owner of tokenId, which must exist.
+func (_ERC721 *ERC721) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _ERC721.bound().Call(opts, out, "ownerOf", tokenId)
+	return *ret0, unpackERC721Error(err)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+// - Solidity: function supportsInterface(bytes4 interfaceId) constant returns(bool)
+//
+// Returns whether the contract implements interfaceId.
+func (_ERC721 *ERC721) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _ERC721.bound().Call(opts, out, "supportsInterface", interfaceId)
+	return *ret0, unpackERC721Error(err)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// - Solidity: function symbol() constant returns(string)
+//
+// Returns the symbol of the token.
+func (_ERC721 *ERC721) Symbol(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _ERC721.bound().Call(opts, out, "symbol")
+	return *ret0, unpackERC721Error(err)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+// - Solidity: function tokenURI(uint256 tokenId) constant returns(string)
+//
+// Returns the URI of tokenId, which must exist. Tokens have no URI unless overridden.
+func (_ERC721 *ERC721) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _ERC721.bound().Call(opts, out, "tokenURI", tokenId)
+	return *ret0, unpackERC721Error(err)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+// - Solidity: function approve(address to, uint256 tokenId) returns()
+//
+// Approves to to transfer tokenId, clearing the approval on the next transfer.
+func (_ERC721 *ERC721) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.transact(opts, "approve", to, tokenId)
+}
+
+// EstimateApprove estimates the gas needed to invoke the contract method 0x095ea7b3.
+func (_ERC721 *ERC721) EstimateApprove(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (uint64, error) {
+	return _ERC721.estimate(opts, "approve", to, tokenId)
+}
+
+// SimulateApprove runs the contract method 0x095ea7b3 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC721 *ERC721) SimulateApprove(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) error {
+	return _ERC721.simulate(opts, nil, "approve", to, tokenId)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+// - Solidity: function mint(address to, uint256 tokenId) returns()
+//
+// Mints tokenId to to. Only the owner can mint.
+func (_ERC721 *ERC721) Mint(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.transact(opts, "mint", to, tokenId)
+}
+
+// EstimateMint estimates the gas needed to invoke the contract method 0x40c10f19.
+func (_ERC721 *ERC721) EstimateMint(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (uint64, error) {
+	return _ERC721.estimate(opts, "mint", to, tokenId)
+}
+
+// SimulateMint runs the contract method 0x40c10f19 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC721 *ERC721) SimulateMint(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) error {
+	return _ERC721.simulate(opts, nil, "mint", to, tokenId)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+// - Solidity: function renounceOwnership() returns()
+//
+// Leaves the contract without an owner, disabling the functions restricted to it.
+func (_ERC721 *ERC721) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721.transact(opts, "renounceOwnership")
+}
+
+// EstimateRenounceOwnership estimates the gas needed to invoke the contract method 0x715018a6.
+func (_ERC721 *ERC721) EstimateRenounceOwnership(opts *bind.TransactOpts) (uint64, error) {
+	return _ERC721.estimate(opts, "renounceOwnership")
+}
+
+// SimulateRenounceOwnership runs the contract method 0x715018a6 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC721 *ERC721) SimulateRenounceOwnership(opts *bind.TransactOpts) error {
+	return _ERC721.simulate(opts, nil, "renounceOwnership")
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+// - Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+//
+// Transfers tokenId from from to to, checking a contract receiving it implements onERC721Received.
+func (_ERC721 *ERC721) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.transact(opts, "safeTransferFrom(address,address,uint256)", from, to, tokenId)
+}
+
+// EstimateSafeTransferFrom estimates the gas needed to invoke the contract method 0x42842e0e.
+func (_ERC721 *ERC721) EstimateSafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (uint64, error) {
+	return _ERC721.estimate(opts, "safeTransferFrom(address,address,uint256)", from, to, tokenId)
+}
+
+// SimulateSafeTransferFrom runs the contract method 0x42842e0e as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC721 *ERC721) SimulateSafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) error {
+	return _ERC721.simulate(opts, nil, "safeTransferFrom(address,address,uint256)", from, to, tokenId)
+}
+
+// SafeTransferFromWithData is a paid mutator transaction binding the contract method 0xb88d4fde.
+// - Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+//
+// Transfers tokenId from from to to, checking a contract receiving it implements onERC721Received, which is passed data.
+func (_ERC721 *ERC721) SafeTransferFromWithData(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC721.transact(opts, "safeTransferFrom(address,address,uint256,bytes)", from, to, tokenId, data)
+}
+
+// EstimateSafeTransferFromWithData estimates the gas needed to invoke the contract method 0xb88d4fde.
+func (_ERC721 *ERC721) EstimateSafeTransferFromWithData(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (uint64, error) {
+	return _ERC721.estimate(opts, "safeTransferFrom(address,address,uint256,bytes)", from, to, tokenId, data)
+}
+
+// SimulateSafeTransferFromWithData runs the contract method 0xb88d4fde as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC721 *ERC721) SimulateSafeTransferFromWithData(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) error {
+	return _ERC721.simulate(opts, nil, "safeTransferFrom(address,address,uint256,bytes)", from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+// - Solidity: function setApprovalForAll(address operator, bool approved) returns()
+//
+// Approves or removes operator as an operator for the caller.
+func (_ERC721 *ERC721) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721.transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// EstimateSetApprovalForAll estimates the gas needed to invoke the contract method 0xa22cb465.
+func (_ERC721 *ERC721) EstimateSetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (uint64, error) {
+	return _ERC721.estimate(opts, "setApprovalForAll", operator, approved)
+}
+
+// SimulateSetApprovalForAll runs the contract method 0xa22cb465 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC721 *ERC721) SimulateSetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) error {
+	return _ERC721.simulate(opts, nil, "setApprovalForAll", operator, approved)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+// - Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+//
+// Transfers tokenId from from to to, without checking a contract receiving it can handle it.
+func (_ERC721 *ERC721) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// EstimateTransferFrom estimates the gas needed to invoke the contract method 0x23b872dd.
+func (_ERC721 *ERC721) EstimateTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (uint64, error) {
+	return _ERC721.estimate(opts, "transferFrom", from, to, tokenId)
+}
+
+// SimulateTransferFrom runs the contract method 0x23b872dd as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC721 *ERC721) SimulateTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) error {
+	return _ERC721.simulate(opts, nil, "transferFrom", from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+// - Solidity: function transferOwnership(address newOwner) returns()
+//
+// Transfers ownership of the contract to newOwner.
+func (_ERC721 *ERC721) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ERC721.transact(opts, "transferOwnership", newOwner)
+}
+
+// EstimateTransferOwnership estimates the gas needed to invoke the contract method 0xf2fde38b.
+func (_ERC721 *ERC721) EstimateTransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (uint64, error) {
+	return _ERC721.estimate(opts, "transferOwnership", newOwner)
+}
+
+// SimulateTransferOwnership runs the contract method 0xf2fde38b as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_ERC721 *ERC721) SimulateTransferOwnership(opts *bind.TransactOpts, newOwner common.Address) error {
+	return _ERC721.simulate(opts, nil, "transferOwnership", newOwner)
+}
+
+//////////////////////////////////////////////////////
+//		Sessions
+////////////////////////////////////////////////////
+
+// ERC721Session binds ERC721 to default call and transact options, exposing
+// every method without the opts argument.
+type ERC721Session struct {
+	Contract     *ERC721           // Contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC721CallerSession binds ERC721 to default call options, exposing every
+// call without the opts argument.
+type ERC721CallerSession struct {
+	Contract *ERC721       // Contract binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// ERC721TransactorSession binds ERC721 to default transact options, exposing
+// every transaction without the opts argument.
+type ERC721TransactorSession struct {
+	Contract     *ERC721           // Contract binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NewERC721Session opens a session on contract, sending transactions with auth
+// and making calls from the same account.
+func NewERC721Session(contract *ERC721, auth *bind.TransactOpts) *ERC721Session {
+	return &ERC721Session{
+		Contract:     contract,
+		CallOpts:     bind.CallOpts{From: auth.From, Context: auth.Context},
+		TransactOpts: *auth,
+	}
+}
+
+// ERC721SessionInterface is the opts-free counterpart of ERC721Interface.
+type ERC721SessionInterface interface {
+	BalanceOf(owner common.Address) (*big.Int, error)
+	GetApproved(tokenId *big.Int) (common.Address, error)
+	IsApprovedForAll(owner common.Address, operator common.Address) (bool, error)
+	Name() (string, error)
+	Owner() (common.Address, error)
+	OwnerOf(tokenId *big.Int) (common.Address, error)
+	SupportsInterface(interfaceId [4]byte) (bool, error)
+	Symbol() (string, error)
+	TokenURI(tokenId *big.Int) (string, error)
+
+	Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error)
+	Mint(to common.Address, tokenId *big.Int) (*types.Transaction, error)
+	RenounceOwnership() (*types.Transaction, error)
+	SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error)
+	SafeTransferFromWithData(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error)
+	SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error)
+	TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error)
+	TransferOwnership(newOwner common.Address) (*types.Transaction, error)
+}
+
+// This nil assignment ensures at compile time that ERC721Session implements ERC721SessionInterface.
+var _ ERC721SessionInterface = (*ERC721Session)(nil)
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+func (_ERC721 *ERC721Session) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721.Contract.BalanceOf(&_ERC721.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+func (_ERC721 *ERC721CallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721.Contract.BalanceOf(&_ERC721.CallOpts, owner)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+func (_ERC721 *ERC721Session) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721.Contract.GetApproved(&_ERC721.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+func (_ERC721 *ERC721CallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721.Contract.GetApproved(&_ERC721.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+func (_ERC721 *ERC721Session) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721.Contract.IsApprovedForAll(&_ERC721.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+func (_ERC721 *ERC721CallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721.Contract.IsApprovedForAll(&_ERC721.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+func (_ERC721 *ERC721Session) Name() (string, error) {
+	return _ERC721.Contract.Name(&_ERC721.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+func (_ERC721 *ERC721CallerSession) Name() (string, error) {
+	return _ERC721.Contract.Name(&_ERC721.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+func (_ERC721 *ERC721Session) Owner() (common.Address, error) {
+	return _ERC721.Contract.Owner(&_ERC721.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+func (_ERC721 *ERC721CallerSession) Owner() (common.Address, error) {
+	return _ERC721.Contract.Owner(&_ERC721.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+func (_ERC721 *ERC721Session) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721.Contract.OwnerOf(&_ERC721.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+func (_ERC721 *ERC721CallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721.Contract.OwnerOf(&_ERC721.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+func (_ERC721 *ERC721Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721.Contract.SupportsInterface(&_ERC721.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+func (_ERC721 *ERC721CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721.Contract.SupportsInterface(&_ERC721.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+func (_ERC721 *ERC721Session) Symbol() (string, error) {
+	return _ERC721.Contract.Symbol(&_ERC721.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+func (_ERC721 *ERC721CallerSession) Symbol() (string, error) {
+	return _ERC721.Contract.Symbol(&_ERC721.CallOpts)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+func (_ERC721 *ERC721Session) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721.Contract.TokenURI(&_ERC721.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+func (_ERC721 *ERC721CallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721.Contract.TokenURI(&_ERC721.CallOpts, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+func (_ERC721 *ERC721Session) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.Approve(&_ERC721.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+func (_ERC721 *ERC721TransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.Approve(&_ERC721.TransactOpts, to, tokenId)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+func (_ERC721 *ERC721Session) Mint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.Mint(&_ERC721.TransactOpts, to, tokenId)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+func (_ERC721 *ERC721TransactorSession) Mint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.Mint(&_ERC721.TransactOpts, to, tokenId)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+func (_ERC721 *ERC721Session) RenounceOwnership() (*types.Transaction, error) {
+	return _ERC721.Contract.RenounceOwnership(&_ERC721.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+func (_ERC721 *ERC721TransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ERC721.Contract.RenounceOwnership(&_ERC721.TransactOpts)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+func (_ERC721 *ERC721Session) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.SafeTransferFrom(&_ERC721.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+func (_ERC721 *ERC721TransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.SafeTransferFrom(&_ERC721.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFromWithData is a paid mutator transaction binding the contract method 0xb88d4fde.
+func (_ERC721 *ERC721Session) SafeTransferFromWithData(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC721.Contract.SafeTransferFromWithData(&_ERC721.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFromWithData is a paid mutator transaction binding the contract method 0xb88d4fde.
+func (_ERC721 *ERC721TransactorSession) SafeTransferFromWithData(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC721.Contract.SafeTransferFromWithData(&_ERC721.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+func (_ERC721 *ERC721Session) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721.Contract.SetApprovalForAll(&_ERC721.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+func (_ERC721 *ERC721TransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721.Contract.SetApprovalForAll(&_ERC721.TransactOpts, operator, approved)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+func (_ERC721 *ERC721Session) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.TransferFrom(&_ERC721.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+func (_ERC721 *ERC721TransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721.Contract.TransferFrom(&_ERC721.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+func (_ERC721 *ERC721Session) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ERC721.Contract.TransferOwnership(&_ERC721.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+func (_ERC721 *ERC721TransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ERC721.Contract.TransferOwnership(&_ERC721.TransactOpts, newOwner)
+}
+
+//////////////////////////////////////////////////////
+//		Calldata
+////////////////////////////////////////////////////
+
+var (
+	parsedERC721ABIOnce sync.Once
+	parsedERC721ABI     abi.ABI
+	parsedERC721ABIErr  error
+)
+
+// loadERC721ABI parses ERC721ABI once, sharing it between the calldata helpers.
+func loadERC721ABI() (abi.ABI, error) {
+	parsedERC721ABIOnce.Do(func() {
+		parsedERC721ABI, parsedERC721ABIErr = parseERC721ABI()
+	})
+	return parsedERC721ABI, parsedERC721ABIErr
+}
+
+// unnamedERC721Args names unnamed arguments the way the input structs do, so
+// they can be unpacked into them.
+func unnamedERC721Args(args abi.Arguments) abi.Arguments {
+	named := make(abi.Arguments, len(args))
+	for i, arg := range args {
+		if arg.Name == "" {
+			arg.Name = fmt.Sprintf("arg%d", i)
+		}
+		named[i] = arg
+	}
+	return named
+}
+
+// ERC721BalanceOfInput holds the arguments of a ERC721.BalanceOf invocation.
+type ERC721BalanceOfInput struct {
+	Owner common.Address
+}
+
+// PackERC721BalanceOf packs the calldata invoking the contract method 0x70a08231.
+func PackERC721BalanceOf(owner common.Address) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("balanceOf", owner)
+}
+
+// UnpackERC721BalanceOfInput unpacks the calldata of a transaction invoking the
+// contract method 0x70a08231, selector included.
+func UnpackERC721BalanceOfInput(data []byte) (*ERC721BalanceOfInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "70a08231" {
+		return nil, errors.New("calldata does not invoke balanceOf(address)")
+	}
+	input := new(ERC721BalanceOfInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["balanceOf"].Inputs)
+	if err := args.Unpack(&input.Owner, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC721BalanceOfOutput unpacks the data returned by the contract method 0x70a08231,
+// as ERC721.BalanceOf returns it.
+func UnpackERC721BalanceOfOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadERC721ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "balanceOf", data)
+	}
+	return *ret0, err
+}
+
+// ERC721GetApprovedInput holds the arguments of a ERC721.GetApproved invocation.
+type ERC721GetApprovedInput struct {
+	TokenId *big.Int
+}
+
+// PackERC721GetApproved packs the calldata invoking the contract method 0x081812fc.
+func PackERC721GetApproved(tokenId *big.Int) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("getApproved", tokenId)
+}
+
+// UnpackERC721GetApprovedInput unpacks the calldata of a transaction invoking the
+// contract method 0x081812fc, selector included.
+func UnpackERC721GetApprovedInput(data []byte) (*ERC721GetApprovedInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "081812fc" {
+		return nil, errors.New("calldata does not invoke getApproved(uint256)")
+	}
+	input := new(ERC721GetApprovedInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["getApproved"].Inputs)
+	if err := args.Unpack(&input.TokenId, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC721GetApprovedOutput unpacks the data returned by the contract method 0x081812fc,
+// as ERC721.GetApproved returns it.
+func UnpackERC721GetApprovedOutput(data []byte) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	parsed, err := loadERC721ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "getApproved", data)
+	}
+	return *ret0, err
+}
+
+// ERC721IsApprovedForAllInput holds the arguments of a ERC721.IsApprovedForAll invocation.
+type ERC721IsApprovedForAllInput struct {
+	Owner    common.Address
+	Operator common.Address
+}
+
+// PackERC721IsApprovedForAll packs the calldata invoking the contract method 0xe985e9c5.
+func PackERC721IsApprovedForAll(owner common.Address, operator common.Address) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("isApprovedForAll", owner, operator)
+}
+
+// UnpackERC721IsApprovedForAllInput unpacks the calldata of a transaction invoking the
+// contract method 0xe985e9c5, selector included.
+func UnpackERC721IsApprovedForAllInput(data []byte) (*ERC721IsApprovedForAllInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "e985e9c5" {
+		return nil, errors.New("calldata does not invoke isApprovedForAll(address,address)")
+	}
+	input := new(ERC721IsApprovedForAllInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["isApprovedForAll"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC721IsApprovedForAllOutput unpacks the data returned by the contract method 0xe985e9c5,
+// as ERC721.IsApprovedForAll returns it.
+func UnpackERC721IsApprovedForAllOutput(data []byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	parsed, err := loadERC721ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "isApprovedForAll", data)
+	}
+	return *ret0, err
+}
+
+// ERC721NameInput holds the arguments of a ERC721.Name invocation.
+type ERC721NameInput struct{}
+
+// PackERC721Name packs the calldata invoking the contract method 0x06fdde03.
+func PackERC721Name() ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("name")
+}
+
+// UnpackERC721NameInput unpacks the calldata of a transaction invoking the
+// contract method 0x06fdde03, selector included.
+func UnpackERC721NameInput(data []byte) (*ERC721NameInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "06fdde03" {
+		return nil, errors.New("calldata does not invoke name()")
+	}
+	input := new(ERC721NameInput)
+
+	return input, nil
+}
+
+// UnpackERC721NameOutput unpacks the data returned by the contract method 0x06fdde03,
+// as ERC721.Name returns it.
+func UnpackERC721NameOutput(data []byte) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	parsed, err := loadERC721ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "name", data)
+	}
+	return *ret0, err
+}
+
+// ERC721OwnerInput holds the arguments of a ERC721.Owner invocation.
+type ERC721OwnerInput struct{}
+
+// PackERC721Owner packs the calldata invoking the contract method 0x8da5cb5b.
+func PackERC721Owner() ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("owner")
+}
+
+// UnpackERC721OwnerInput unpacks the calldata of a transaction invoking the
+// contract method 0x8da5cb5b, selector included.
+func UnpackERC721OwnerInput(data []byte) (*ERC721OwnerInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "8da5cb5b" {
+		return nil, errors.New("calldata does not invoke owner()")
+	}
+	input := new(ERC721OwnerInput)
+
+	return input, nil
+}
+
+// UnpackERC721OwnerOutput unpacks the data returned by the contract method 0x8da5cb5b,
+// as ERC721.Owner returns it.
+func UnpackERC721OwnerOutput(data []byte) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	parsed, err := loadERC721ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "owner", data)
+	}
+	return *ret0, err
+}
+
+// ERC721OwnerOfInput holds the arguments of a ERC721.OwnerOf invocation.
+type ERC721OwnerOfInput struct {
+	TokenId *big.Int
+}
+
+// PackERC721OwnerOf packs the calldata invoking the contract method 0x6352211e.
+func PackERC721OwnerOf(tokenId *big.Int) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("ownerOf", tokenId)
+}
+
+// UnpackERC721OwnerOfInput unpacks the calldata of a transaction invoking the
+// contract method 0x6352211e, selector included.
+func UnpackERC721OwnerOfInput(data []byte) (*ERC721OwnerOfInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "6352211e" {
+		return nil, errors.New("calldata does not invoke ownerOf(uint256)")
+	}
+	input := new(ERC721OwnerOfInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["ownerOf"].Inputs)
+	if err := args.Unpack(&input.TokenId, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC721OwnerOfOutput unpacks the data returned by the contract method 0x6352211e,
+// as ERC721.OwnerOf returns it.
+func UnpackERC721OwnerOfOutput(data []byte) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	parsed, err := loadERC721ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "ownerOf", data)
+	}
+	return *ret0, err
+}
+
+// ERC721SupportsInterfaceInput holds the arguments of a ERC721.SupportsInterface invocation.
+type ERC721SupportsInterfaceInput struct {
+	InterfaceId [4]byte
+}
+
+// PackERC721SupportsInterface packs the calldata invoking the contract method 0x01ffc9a7.
+func PackERC721SupportsInterface(interfaceId [4]byte) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("supportsInterface", interfaceId)
+}
+
+// UnpackERC721SupportsInterfaceInput unpacks the calldata of a transaction invoking the
+// contract method 0x01ffc9a7, selector included.
+func UnpackERC721SupportsInterfaceInput(data []byte) (*ERC721SupportsInterfaceInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "01ffc9a7" {
+		return nil, errors.New("calldata does not invoke supportsInterface(bytes4)")
+	}
+	input := new(ERC721SupportsInterfaceInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["supportsInterface"].Inputs)
+	if err := args.Unpack(&input.InterfaceId, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC721SupportsInterfaceOutput unpacks the data returned by the contract method 0x01ffc9a7,
+// as ERC721.SupportsInterface returns it.
+func UnpackERC721SupportsInterfaceOutput(data []byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	parsed, err := loadERC721ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "supportsInterface", data)
+	}
+	return *ret0, err
+}
+
+// ERC721SymbolInput holds the arguments of a ERC721.Symbol invocation.
+type ERC721SymbolInput struct{}
+
+// PackERC721Symbol packs the calldata invoking the contract method 0x95d89b41.
+func PackERC721Symbol() ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("symbol")
+}
+
+// UnpackERC721SymbolInput unpacks the calldata of a transaction invoking the
+// contract method 0x95d89b41, selector included.
+func UnpackERC721SymbolInput(data []byte) (*ERC721SymbolInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "95d89b41" {
+		return nil, errors.New("calldata does not invoke symbol()")
+	}
+	input := new(ERC721SymbolInput)
+
+	return input, nil
+}
+
+// UnpackERC721SymbolOutput unpacks the data returned by the contract method 0x95d89b41,
+// as ERC721.Symbol returns it.
+func UnpackERC721SymbolOutput(data []byte) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	parsed, err := loadERC721ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "symbol", data)
+	}
+	return *ret0, err
+}
+
+// ERC721TokenURIInput holds the arguments of a ERC721.TokenURI invocation.
+type ERC721TokenURIInput struct {
+	TokenId *big.Int
+}
+
+// PackERC721TokenURI packs the calldata invoking the contract method 0xc87b56dd.
+func PackERC721TokenURI(tokenId *big.Int) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("tokenURI", tokenId)
+}
+
+// UnpackERC721TokenURIInput unpacks the calldata of a transaction invoking the
+// contract method 0xc87b56dd, selector included.
+func UnpackERC721TokenURIInput(data []byte) (*ERC721TokenURIInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "c87b56dd" {
+		return nil, errors.New("calldata does not invoke tokenURI(uint256)")
+	}
+	input := new(ERC721TokenURIInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["tokenURI"].Inputs)
+	if err := args.Unpack(&input.TokenId, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackERC721TokenURIOutput unpacks the data returned by the contract method 0xc87b56dd,
+// as ERC721.TokenURI returns it.
+func UnpackERC721TokenURIOutput(data []byte) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	parsed, err := loadERC721ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "tokenURI", data)
+	}
+	return *ret0, err
+}
+
+// ERC721ApproveInput holds the arguments of a ERC721.Approve invocation.
+type ERC721ApproveInput struct {
+	To      common.Address
+	TokenId *big.Int
+}
+
+// PackERC721Approve packs the calldata invoking the contract method 0x095ea7b3.
+func PackERC721Approve(to common.Address, tokenId *big.Int) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("approve", to, tokenId)
+}
+
+// UnpackERC721ApproveInput unpacks the calldata of a transaction invoking the
+// contract method 0x095ea7b3, selector included.
+func UnpackERC721ApproveInput(data []byte) (*ERC721ApproveInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "095ea7b3" {
+		return nil, errors.New("calldata does not invoke approve(address,uint256)")
+	}
+	input := new(ERC721ApproveInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["approve"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC721MintInput holds the arguments of a ERC721.Mint invocation.
+type ERC721MintInput struct {
+	To      common.Address
+	TokenId *big.Int
+}
+
+// PackERC721Mint packs the calldata invoking the contract method 0x40c10f19.
+func PackERC721Mint(to common.Address, tokenId *big.Int) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("mint", to, tokenId)
+}
+
+// UnpackERC721MintInput unpacks the calldata of a transaction invoking the
+// contract method 0x40c10f19, selector included.
+func UnpackERC721MintInput(data []byte) (*ERC721MintInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "40c10f19" {
+		return nil, errors.New("calldata does not invoke mint(address,uint256)")
+	}
+	input := new(ERC721MintInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["mint"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC721RenounceOwnershipInput holds the arguments of a ERC721.RenounceOwnership invocation.
+type ERC721RenounceOwnershipInput struct{}
+
+// PackERC721RenounceOwnership packs the calldata invoking the contract method 0x715018a6.
+func PackERC721RenounceOwnership() ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("renounceOwnership")
+}
+
+// UnpackERC721RenounceOwnershipInput unpacks the calldata of a transaction invoking the
+// contract method 0x715018a6, selector included.
+func UnpackERC721RenounceOwnershipInput(data []byte) (*ERC721RenounceOwnershipInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "715018a6" {
+		return nil, errors.New("calldata does not invoke renounceOwnership()")
+	}
+	input := new(ERC721RenounceOwnershipInput)
+
+	return input, nil
+}
+
+// ERC721SafeTransferFromInput holds the arguments of a ERC721.SafeTransferFrom invocation.
+type ERC721SafeTransferFromInput struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+}
+
+// PackERC721SafeTransferFrom packs the calldata invoking the contract method 0x42842e0e.
+func PackERC721SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("safeTransferFrom(address,address,uint256)", from, to, tokenId)
+}
+
+// UnpackERC721SafeTransferFromInput unpacks the calldata of a transaction invoking the
+// contract method 0x42842e0e, selector included.
+func UnpackERC721SafeTransferFromInput(data []byte) (*ERC721SafeTransferFromInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "42842e0e" {
+		return nil, errors.New("calldata does not invoke safeTransferFrom(address,address,uint256)")
+	}
+	input := new(ERC721SafeTransferFromInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["safeTransferFrom(address,address,uint256)"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC721SafeTransferFromWithDataInput holds the arguments of a ERC721.SafeTransferFromWithData invocation.
+type ERC721SafeTransferFromWithDataInput struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Data    []byte
+}
+
+// PackERC721SafeTransferFromWithData packs the calldata invoking the contract method 0xb88d4fde.
+func PackERC721SafeTransferFromWithData(from common.Address, to common.Address, tokenId *big.Int, data []byte) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("safeTransferFrom(address,address,uint256,bytes)", from, to, tokenId, data)
+}
+
+// UnpackERC721SafeTransferFromWithDataInput unpacks the calldata of a transaction invoking the
+// contract method 0xb88d4fde, selector included.
+func UnpackERC721SafeTransferFromWithDataInput(data []byte) (*ERC721SafeTransferFromWithDataInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "b88d4fde" {
+		return nil, errors.New("calldata does not invoke safeTransferFrom(address,address,uint256,bytes)")
+	}
+	input := new(ERC721SafeTransferFromWithDataInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["safeTransferFrom(address,address,uint256,bytes)"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC721SetApprovalForAllInput holds the arguments of a ERC721.SetApprovalForAll invocation.
+type ERC721SetApprovalForAllInput struct {
+	Operator common.Address
+	Approved bool
+}
+
+// PackERC721SetApprovalForAll packs the calldata invoking the contract method 0xa22cb465.
+func PackERC721SetApprovalForAll(operator common.Address, approved bool) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("setApprovalForAll", operator, approved)
+}
+
+// UnpackERC721SetApprovalForAllInput unpacks the calldata of a transaction invoking the
+// contract method 0xa22cb465, selector included.
+func UnpackERC721SetApprovalForAllInput(data []byte) (*ERC721SetApprovalForAllInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "a22cb465" {
+		return nil, errors.New("calldata does not invoke setApprovalForAll(address,bool)")
+	}
+	input := new(ERC721SetApprovalForAllInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["setApprovalForAll"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC721TransferFromInput holds the arguments of a ERC721.TransferFrom invocation.
+type ERC721TransferFromInput struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+}
+
+// PackERC721TransferFrom packs the calldata invoking the contract method 0x23b872dd.
+func PackERC721TransferFrom(from common.Address, to common.Address, tokenId *big.Int) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("transferFrom", from, to, tokenId)
+}
+
+// UnpackERC721TransferFromInput unpacks the calldata of a transaction invoking the
+// contract method 0x23b872dd, selector included.
+func UnpackERC721TransferFromInput(data []byte) (*ERC721TransferFromInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "23b872dd" {
+		return nil, errors.New("calldata does not invoke transferFrom(address,address,uint256)")
+	}
+	input := new(ERC721TransferFromInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["transferFrom"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ERC721TransferOwnershipInput holds the arguments of a ERC721.TransferOwnership invocation.
+type ERC721TransferOwnershipInput struct {
+	NewOwner common.Address
+}
+
+// PackERC721TransferOwnership packs the calldata invoking the contract method 0xf2fde38b.
+func PackERC721TransferOwnership(newOwner common.Address) ([]byte, error) {
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("transferOwnership", newOwner)
+}
+
+// UnpackERC721TransferOwnershipInput unpacks the calldata of a transaction invoking the
+// contract method 0xf2fde38b, selector included.
+func UnpackERC721TransferOwnershipInput(data []byte) (*ERC721TransferOwnershipInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "f2fde38b" {
+		return nil, errors.New("calldata does not invoke transferOwnership(address)")
+	}
+	input := new(ERC721TransferOwnershipInput)
+	parsed, err := loadERC721ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedERC721Args(parsed.Methods["transferOwnership"].Inputs)
+	if err := args.Unpack(&input.NewOwner, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ErrERC721UnknownMethod is returned by DecodeERC721Call for calldata that doesn't invoke a ERC721 method.
+var ErrERC721UnknownMethod = errors.New("unknown ERC721 method")
+
+// DecodeERC721Call identifies the method invoked by calldata through its 4-byte
+// selector, returning the matching *MethodInput holding its arguments.
+func DecodeERC721Call(data []byte) (interface{}, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("%w: calldata too short", ErrERC721UnknownMethod)
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "70a08231":
+		input, err := UnpackERC721BalanceOfInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "081812fc":
+		input, err := UnpackERC721GetApprovedInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "e985e9c5":
+		input, err := UnpackERC721IsApprovedForAllInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "06fdde03":
+		input, err := UnpackERC721NameInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "8da5cb5b":
+		input, err := UnpackERC721OwnerInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "6352211e":
+		input, err := UnpackERC721OwnerOfInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "01ffc9a7":
+		input, err := UnpackERC721SupportsInterfaceInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "95d89b41":
+		input, err := UnpackERC721SymbolInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "c87b56dd":
+		input, err := UnpackERC721TokenURIInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "095ea7b3":
+		input, err := UnpackERC721ApproveInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "40c10f19":
+		input, err := UnpackERC721MintInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "715018a6":
+		input, err := UnpackERC721RenounceOwnershipInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "42842e0e":
+		input, err := UnpackERC721SafeTransferFromInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "b88d4fde":
+		input, err := UnpackERC721SafeTransferFromWithDataInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "a22cb465":
+		input, err := UnpackERC721SetApprovalForAllInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "23b872dd":
+		input, err := UnpackERC721TransferFromInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "f2fde38b":
+		input, err := UnpackERC721TransferOwnershipInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	}
+	return nil, fmt.Errorf("%w: selector %x", ErrERC721UnknownMethod, data[:4])
+}
+
+//////////////////////////////////////////////////////
+//		Errors
+////////////////////////////////////////////////////
+
+// ERC721Revert is a revert with a reason string, as raised by require and revert.
+type ERC721Revert struct {
+	Reason string
+}
+
+func (e ERC721Revert) Error() string {
+	return "ERC721: execution reverted: " + e.Reason
+}
+
+// ERC721Panic is raised by failed assertions, arithmetic overflows and the like.
+type ERC721Panic struct {
+	Code *big.Int
+}
+
+func (e ERC721Panic) Error() string {
+	return fmt.Sprintf("ERC721: panic code %#x", e.Code)
+}
+
+// ERC721ErrorsABI describes the errors ERC721 can revert with, each as a function
+// returning the error arguments.
+const ERC721ErrorsABI = "[{\"type\":\"function\",\"name\":\"Error\",\"outputs\":[{\"name\":\"reason\",\"type\":\"string\"}]},{\"type\":\"function\",\"name\":\"Panic\",\"outputs\":[{\"name\":\"code\",\"type\":\"uint256\"}]}]"
+
+// DecodeERC721Revert decodes revert data into a ERC721Revert, a ERC721Panic
+// or one of the custom errors of ERC721. It returns nil for unknown data.
+func DecodeERC721Revert(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(ERC721ErrorsABI))
+	if err != nil {
+		return nil
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "08c379a0":
+		var e ERC721Revert
+		if parsed.Unpack(&e, "Error", data[4:]) == nil {
+			return e
+		}
+	case "4e487b71":
+		var e ERC721Panic
+		if parsed.Unpack(&e, "Panic", data[4:]) == nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// unpackERC721Error replaces an error carrying revert data with the typed error
+// decoded from it, leaving any other error untouched. Only errors exposing the
+// data through ErrorData, like sim.RevertError, carry it; RPC errors don't.
+func unpackERC721Error(err error) error {
+	var reverted interface{ ErrorData() interface{} }
+	if !errors.As(err, &reverted) {
+		return err
+	}
+	var data []byte
+	switch d := reverted.ErrorData().(type) {
+	case string:
+		data = common.FromHex(d)
+	case []byte:
+		data = d
+	}
+	if decoded := DecodeERC721Revert(data); decoded != nil {
+		return decoded
+	}
+	return err
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+// logERC721JSON is the JSON encoding shared by the events of ERC721.
+type logERC721JSON struct {
+	Event       string          `json:"event"`
+	Contract    string          `json:"contract"`
+	Fields      json.RawMessage `json:"fields"`
+	Address     common.Address  `json:"address"`
+	Topics      []common.Hash   `json:"topics"`
+	Data        hexutil.Bytes   `json:"data"`
+	BlockNumber uint64          `json:"blockNumber"`
+	BlockHash   common.Hash     `json:"blockHash"`
+	TxHash      common.Hash     `json:"transactionHash"`
+	TxIndex     uint            `json:"transactionIndex"`
+	LogIndex    uint            `json:"logIndex"`
+	Removed     bool            `json:"removed"`
+}
+
+// marshalLogERC721 encodes the decoded fields of an event along with its log.
+func marshalLogERC721(name string, fields interface{}, log types.Log) ([]byte, error) {
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(logERC721JSON{
+		Event:       name,
+		Contract:    "ERC721",
+		Fields:      encoded,
+		Address:     log.Address,
+		Topics:      log.Topics,
+		Data:        log.Data,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Removed:     log.Removed,
+	})
+}
+
+// unmarshalLogERC721 decodes the JSON encoding of an event, checking its name
+// and returning the raw log.
+func unmarshalLogERC721(input []byte, name string, fields interface{}) (types.Log, error) {
+	var dec logERC721JSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return types.Log{}, err
+	}
+	if dec.Event != name || dec.Contract != "ERC721" {
+		return types.Log{}, fmt.Errorf("cannot unmarshal %s.%s into a ERC721.%s event", dec.Contract, dec.Event, name)
+	}
+	if err := json.Unmarshal(dec.Fields, fields); err != nil {
+		return types.Log{}, err
+	}
+	return types.Log{
+		Address:     dec.Address,
+		Topics:      dec.Topics,
+		Data:        dec.Data,
+		BlockNumber: dec.BlockNumber,
+		BlockHash:   dec.BlockHash,
+		TxHash:      dec.TxHash,
+		TxIndex:     dec.TxIndex,
+		Index:       dec.LogIndex,
+		Removed:     dec.Removed,
+	}, nil
+}
+
+//////// Approval ////////
+
+// ApprovalID is the hex of the Topic Hash
+const ERC721ApprovalID = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+
+// ApprovalLog represents a Approval event raised by the ERC721 contract.
+type ApprovalLog struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e ApprovalLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Owner    common.Address `json:"owner"`
+		Approved common.Address `json:"approved"`
+		TokenId  string         `json:"tokenId"`
+	}
+	fields.Owner = e.Owner
+	fields.Approved = e.Approved
+	if e.TokenId != nil {
+		fields.TokenId = e.TokenId.String()
+	}
+	return marshalLogERC721("Approval", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *ApprovalLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Owner    common.Address `json:"owner"`
+		Approved common.Address `json:"approved"`
+		TokenId  string         `json:"tokenId"`
+	}
+	raw, err := unmarshalLogERC721(input, "Approval", &fields)
+	if err != nil {
+		return err
+	}
+	e.Owner = fields.Owner
+	e.Approved = fields.Approved
+	if fields.TokenId != "" {
+		value, ok := new(big.Int).SetString(fields.TokenId, 10)
+		if !ok {
+			return fmt.Errorf("invalid tokenId %q", fields.TokenId)
+		}
+		e.TokenId = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackApprovalLog is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721 *ERC721) UnpackApprovalLog(log types.Log) (*ApprovalLog, error) {
+	event := new(ApprovalLog)
+	if err := _ERC721.bound().UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ApprovalLogIterator is returned from FilterApproval and is used to iterate over
+// the raw logs and unpacked data for Approval events raised by the ERC721 contract.
+type ApprovalLogIterator struct {
+	Event *ApprovalLog // Event containing the contract specifics and raw log
+
+	contract *ERC721               // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ApprovalLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackApprovalLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackApprovalLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ApprovalLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ApprovalLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721 *ERC721) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*ApprovalLogIterator, error) {
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721.bound().FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ApprovalLogIterator{contract: _ERC721, logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721 *ERC721) WatchApproval(opts *bind.WatchOpts, sink chan<- *ApprovalLog, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721.bound().WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC721.UnpackApprovalLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// ApprovalForAll ////////
+
+// ApprovalForAllID is the hex of the Topic Hash
+const ERC721ApprovalForAllID = "0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31"
+
+// ApprovalForAllLog represents a ApprovalForAll event raised by the ERC721 contract.
+type ApprovalForAllLog struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e ApprovalForAllLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Owner    common.Address `json:"owner"`
+		Operator common.Address `json:"operator"`
+		Approved bool           `json:"approved"`
+	}
+	fields.Owner = e.Owner
+	fields.Operator = e.Operator
+	fields.Approved = e.Approved
+	return marshalLogERC721("ApprovalForAll", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *ApprovalForAllLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Owner    common.Address `json:"owner"`
+		Operator common.Address `json:"operator"`
+		Approved bool           `json:"approved"`
+	}
+	raw, err := unmarshalLogERC721(input, "ApprovalForAll", &fields)
+	if err != nil {
+		return err
+	}
+	e.Owner = fields.Owner
+	e.Operator = fields.Operator
+	e.Approved = fields.Approved
+	e.Raw = raw
+	return nil
+}
+
+// UnpackApprovalForAllLog is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721 *ERC721) UnpackApprovalForAllLog(log types.Log) (*ApprovalForAllLog, error) {
+	event := new(ApprovalForAllLog)
+	if err := _ERC721.bound().UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ApprovalForAllLogIterator is returned from FilterApprovalForAll and is used to iterate over
+// the raw logs and unpacked data for ApprovalForAll events raised by the ERC721 contract.
+type ApprovalForAllLogIterator struct {
+	Event *ApprovalForAllLog // Event containing the contract specifics and raw log
+
+	contract *ERC721               // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ApprovalForAllLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackApprovalForAllLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackApprovalForAllLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ApprovalForAllLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ApprovalForAllLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721 *ERC721) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*ApprovalForAllLogIterator, error) {
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721.bound().FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ApprovalForAllLogIterator{contract: _ERC721, logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721 *ERC721) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *ApprovalForAllLog, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721.bound().WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC721.UnpackApprovalForAllLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// OwnershipTransferred ////////
+
+// OwnershipTransferredID is the hex of the Topic Hash
+const ERC721OwnershipTransferredID = "0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"
+
+// OwnershipTransferredLog represents a OwnershipTransferred event raised by the ERC721 contract.
+type OwnershipTransferredLog struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e OwnershipTransferredLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		PreviousOwner common.Address `json:"previousOwner"`
+		NewOwner      common.Address `json:"newOwner"`
+	}
+	fields.PreviousOwner = e.PreviousOwner
+	fields.NewOwner = e.NewOwner
+	return marshalLogERC721("OwnershipTransferred", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *OwnershipTransferredLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		PreviousOwner common.Address `json:"previousOwner"`
+		NewOwner      common.Address `json:"newOwner"`
+	}
+	raw, err := unmarshalLogERC721(input, "OwnershipTransferred", &fields)
+	if err != nil {
+		return err
+	}
+	e.PreviousOwner = fields.PreviousOwner
+	e.NewOwner = fields.NewOwner
+	e.Raw = raw
+	return nil
+}
+
+// UnpackOwnershipTransferredLog is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC721 *ERC721) UnpackOwnershipTransferredLog(log types.Log) (*OwnershipTransferredLog, error) {
+	event := new(OwnershipTransferredLog)
+	if err := _ERC721.bound().UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OwnershipTransferredLogIterator is returned from FilterOwnershipTransferred and is used to iterate over
+// the raw logs and unpacked data for OwnershipTransferred events raised by the ERC721 contract.
+type OwnershipTransferredLogIterator struct {
+	Event *OwnershipTransferredLog // Event containing the contract specifics and raw log
+
+	contract *ERC721               // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OwnershipTransferredLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackOwnershipTransferredLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackOwnershipTransferredLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OwnershipTransferredLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OwnershipTransferredLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC721 *ERC721) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*OwnershipTransferredLogIterator, error) {
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ERC721.bound().FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnershipTransferredLogIterator{contract: _ERC721, logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC721 *ERC721) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *OwnershipTransferredLog, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ERC721.bound().WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC721.UnpackOwnershipTransferredLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// Transfer ////////
+
+// TransferID is the hex of the Topic Hash
+const ERC721TransferID = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// TransferLog represents a Transfer event raised by the ERC721 contract.
+type TransferLog struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e TransferLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		From    common.Address `json:"from"`
+		To      common.Address `json:"to"`
+		TokenId string         `json:"tokenId"`
+	}
+	fields.From = e.From
+	fields.To = e.To
+	if e.TokenId != nil {
+		fields.TokenId = e.TokenId.String()
+	}
+	return marshalLogERC721("Transfer", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *TransferLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		From    common.Address `json:"from"`
+		To      common.Address `json:"to"`
+		TokenId string         `json:"tokenId"`
+	}
+	raw, err := unmarshalLogERC721(input, "Transfer", &fields)
+	if err != nil {
+		return err
+	}
+	e.From = fields.From
+	e.To = fields.To
+	if fields.TokenId != "" {
+		value, ok := new(big.Int).SetString(fields.TokenId, 10)
+		if !ok {
+			return fmt.Errorf("invalid tokenId %q", fields.TokenId)
+		}
+		e.TokenId = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackTransferLog is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721 *ERC721) UnpackTransferLog(log types.Log) (*TransferLog, error) {
+	event := new(TransferLog)
+	if err := _ERC721.bound().UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TransferLogIterator is returned from FilterTransfer and is used to iterate over
+// the raw logs and unpacked data for Transfer events raised by the ERC721 contract.
+type TransferLogIterator struct {
+	Event *TransferLog // Event containing the contract specifics and raw log
+
+	contract *ERC721               // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TransferLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackTransferLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackTransferLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TransferLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TransferLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721 *ERC721) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*TransferLogIterator, error) {
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721.bound().FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferLogIterator{contract: _ERC721, logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721 *ERC721) WatchTransfer(opts *bind.WatchOpts, sink chan<- *TransferLog, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721.bound().WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _ERC721.UnpackTransferLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////////////////////////////////////////////////////
+//		Event Dispatch
+////////////////////////////////////////////////////
+
+// ERC721EventHandler handles each of the events raised by the ERC721 contract.
+type ERC721EventHandler interface {
+	HandleApproval(event *ApprovalLog) error
+	HandleApprovalForAll(event *ApprovalForAllLog) error
+	HandleOwnershipTransferred(event *OwnershipTransferredLog) error
+	HandleTransfer(event *TransferLog) error
+}
+
+// ErrERC721UnknownEvent is returned by Dispatch for logs that weren't raised by a ERC721 event.
+var ErrERC721UnknownEvent = errors.New("unknown ERC721 event")
+
+// Dispatch unpacks the log according to its first topic and passes it on to the
+// matching method of the handler.
+func (_ERC721 *ERC721) Dispatch(log types.Log, h ERC721EventHandler) error {
+	if len(log.Topics) == 0 {
+		return fmt.Errorf("%w: log has no topics", ErrERC721UnknownEvent)
+	}
+	switch log.Topics[0].Hex() {
+	case ERC721ApprovalID:
+		event, err := _ERC721.UnpackApprovalLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleApproval(event)
+
+	case ERC721ApprovalForAllID:
+		event, err := _ERC721.UnpackApprovalForAllLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleApprovalForAll(event)
+
+	case ERC721OwnershipTransferredID:
+		event, err := _ERC721.UnpackOwnershipTransferredLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleOwnershipTransferred(event)
+
+	case ERC721TransferID:
+		event, err := _ERC721.UnpackTransferLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleTransfer(event)
+
+	}
+	return fmt.Errorf("%w: topic %s", ErrERC721UnknownEvent, log.Topics[0].Hex())
+}
+
+//////////////////////////////////////////////////////
+//		Bin and ABI
+////////////////////////////////////////////////////
+
+// ERC721Bin is used to deploy the generated contract
+const ERC721Bin = "0x60806040523480156200001157600080fd5b506040516200153a3803806200153a83398101604081905262000034916200017a565b6200003f3362000065565b60016200004d838262000273565b5060026200005c828262000273565b5050506200033f565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b634e487b7160e01b600052604160045260246000fd5b600082601f830112620000dd57600080fd5b81516001600160401b0380821115620000fa57620000fa620000b5565b604051601f8301601f19908116603f01168101908282118183101715620001255762000125620000b5565b816040528381526020925086838588010111156200014257600080fd5b600091505b8382101562000166578582018301518183018401529082019062000147565b600093810190920192909252949350505050565b600080604083850312156200018e57600080fd5b82516001600160401b0380821115620001a657600080fd5b620001b486838701620000cb565b93506020850151915080821115620001cb57600080fd5b50620001da85828601620000cb565b9150509250929050565b600181811c90821680620001f957607f821691505b6020821081036200021a57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200026e57600081815260208120601f850160051c81016020861015620002495750805b601f850160051c820191505b818110156200026a5782815560010162000255565b5050505b505050565b81516001600160401b038111156200028f576200028f620000b5565b620002a781620002a08454620001e4565b8462000220565b602080601f831160018114620002df5760008415620002c65750858301515b600019600386901b1c1916600185901b1785556200026a565b600085815260208120601f198616915b828110156200031057888601518255948401946001909101908401620002ef565b50858210156200032f5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6111eb806200034f6000396000f3fe608060405234801561001057600080fd5b506004361061010b5760003560e01c806370a08231116100a2578063a22cb46511610071578063a22cb4651461021b578063b88d4fde1461022e578063c87b56dd14610241578063e985e9c514610254578063f2fde38b1461029057600080fd5b806370a08231146101d9578063715018a6146101fa5780638da5cb5b1461020257806395d89b411461021357600080fd5b806323b872dd116100de57806323b872dd1461018d57806340c10f19146101a057806342842e0e146101b35780636352211e146101c657600080fd5b806301ffc9a71461011057806306fdde0314610138578063081812fc1461014d578063095ea7b314610178575b600080fd5b61012361011e366004610db9565b6102a3565b60405190151581526020015b60405180910390f35b6101406102f5565b60405161012f9190610e23565b61016061015b366004610e36565b610387565b6040516001600160a01b03909116815260200161012f565b61018b610186366004610e6b565b6103ae565b005b61018b61019b366004610e95565b6104c8565b61018b6101ae366004610e6b565b6104f9565b61018b6101c1366004610e95565b610531565b6101606101d4366004610e36565b61054c565b6101ec6101e7366004610ed1565b6105ac565b60405190815260200161012f565b61018b610632565b6000546001600160a01b0316610160565b610140610668565b61018b610229366004610eec565b610677565b61018b61023c366004610f3e565b610682565b61014061024f366004610e36565b6106ba565b61012361026236600461101a565b6001600160a01b03918216600090815260066020908152604080832093909416825291909152205460ff1690565b61018b61029e366004610ed1565b6106d9565b60006001600160e01b031982166380ac58cd60e01b14806102d457506001600160e01b03198216635b5e139f60e01b145b806102ef57506301ffc9a760e01b6001600160e01b03198316145b92915050565b6060600180546103049061104d565b80601f01602080910402602001604051908101604052809291908181526020018280546103309061104d565b801561037d5780601f106103525761010080835404028352916020019161037d565b820191906000526020600020905b81548152906001019060200180831161036057829003601f168201915b5050505050905090565b600061039282610774565b506000908152600560205260409020546001600160a01b031690565b60006103b98261054c565b9050806001600160a01b0316836001600160a01b03160361042b5760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b60648201526084015b60405180910390fd5b336001600160a01b038216148061044757506104478133610262565b6104b95760405162461bcd60e51b815260206004820152603d60248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f7420746f60448201527f6b656e206f776e6572206f7220617070726f76656420666f7220616c6c0000006064820152608401610422565b6104c383836107d3565b505050565b6104d23382610841565b6104ee5760405162461bcd60e51b815260040161042290611087565b6104c38383836108c0565b6000546001600160a01b031633146105235760405162461bcd60e51b8152600401610422906110d4565b61052d8282610a29565b5050565b6104c383838360405180602001604052806000815250610682565b6000818152600360205260408120546001600160a01b0316806102ef5760405162461bcd60e51b8152602060048201526018602482015277115490cdcc8c4e881a5b9d985b1a59081d1bdad95b88125160421b6044820152606401610422565b60006001600160a01b0382166106165760405162461bcd60e51b815260206004820152602960248201527f4552433732313a2061646472657373207a65726f206973206e6f7420612076616044820152683634b21037bbb732b960b91b6064820152608401610422565b506001600160a01b031660009081526004602052604090205490565b6000546001600160a01b0316331461065c5760405162461bcd60e51b8152600401610422906110d4565b6106666000610b4f565b565b6060600280546103049061104d565b61052d338383610b9f565b61068c3383610841565b6106a85760405162461bcd60e51b815260040161042290611087565b6106b484848484610c6d565b50505050565b60606106c582610774565b505060408051602081019091526000815290565b6000546001600160a01b031633146107035760405162461bcd60e51b8152600401610422906110d4565b6001600160a01b0381166107685760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610422565b61077181610b4f565b50565b6000818152600360205260409020546001600160a01b03166107715760405162461bcd60e51b8152602060048201526018602482015277115490cdcc8c4e881a5b9d985b1a59081d1bdad95b88125160421b6044820152606401610422565b600081815260056020526040902080546001600160a01b0319166001600160a01b03841690811790915581906108088261054c565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b60008061084d8361054c565b9050806001600160a01b0316846001600160a01b0316148061089457506001600160a01b0380821660009081526006602090815260408083209388168352929052205460ff165b806108b85750836001600160a01b03166108ad84610387565b6001600160a01b0316145b949350505050565b826001600160a01b03166108d38261054c565b6001600160a01b0316146109375760405162461bcd60e51b815260206004820152602560248201527f4552433732313a207472616e736665722066726f6d20696e636f72726563742060448201526437bbb732b960d91b6064820152608401610422565b6001600160a01b0382166109995760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b6064820152608401610422565b600081815260056020908152604080832080546001600160a01b03199081169091556001600160a01b0387811680865260048552838620805460001901905590871680865283862080546001019055868652600390945282852080549092168417909155905184937fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b6001600160a01b038216610a7f5760405162461bcd60e51b815260206004820181905260248201527f4552433732313a206d696e7420746f20746865207a65726f20616464726573736044820152606401610422565b6000818152600360205260409020546001600160a01b031615610ae45760405162461bcd60e51b815260206004820152601c60248201527f4552433732313a20746f6b656e20616c7265616479206d696e746564000000006044820152606401610422565b6001600160a01b038216600081815260046020908152604080832080546001019055848352600390915280822080546001600160a01b0319168417905551839291907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908290a45050565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b816001600160a01b0316836001600160a01b031603610c005760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c6572000000000000006044820152606401610422565b6001600160a01b03838116600081815260066020908152604080832094871680845294825291829020805460ff191686151590811790915591519182527f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a3505050565b610c788484846108c0565b610c8484848484610ca0565b6106b45760405162461bcd60e51b815260040161042290611109565b6000836001600160a01b03163b600003610cbc575060016108b8565b604051630a85bd0160e11b81526001600160a01b0385169063150b7a0290610cee90339089908890889060040161115b565b6020604051808303816000875af1925050508015610d29575060408051601f3d908101601f19168201909252610d2691810190611198565b60015b610d86573d808015610d57576040519150601f19603f3d011682016040523d82523d6000602084013e610d5c565b606091505b508051600003610d7e5760405162461bcd60e51b815260040161042290611109565b805181602001fd5b6001600160e01b031916630a85bd0160e11b149050949350505050565b6001600160e01b03198116811461077157600080fd5b600060208284031215610dcb57600080fd5b8135610dd681610da3565b9392505050565b6000815180845260005b81811015610e0357602081850181015186830182015201610de7565b506000602082860101526020601f19601f83011685010191505092915050565b602081526000610dd66020830184610ddd565b600060208284031215610e4857600080fd5b5035919050565b80356001600160a01b0381168114610e6657600080fd5b919050565b60008060408385031215610e7e57600080fd5b610e8783610e4f565b946020939093013593505050565b600080600060608486031215610eaa57600080fd5b610eb384610e4f565b9250610ec160208501610e4f565b9150604084013590509250925092565b600060208284031215610ee357600080fd5b610dd682610e4f565b60008060408385031215610eff57600080fd5b610f0883610e4f565b915060208301358015158114610f1d57600080fd5b809150509250929050565b634e487b7160e01b600052604160045260246000fd5b60008060008060808587031215610f5457600080fd5b610f5d85610e4f565b9350610f6b60208601610e4f565b925060408501359150606085013567ffffffffffffffff80821115610f8f57600080fd5b818701915087601f830112610fa357600080fd5b813581811115610fb557610fb5610f28565b604051601f8201601f19908116603f01168101908382118183101715610fdd57610fdd610f28565b816040528281528a6020848701011115610ff657600080fd5b82602086016020830137600060208483010152809550505050505092959194509250565b6000806040838503121561102d57600080fd5b61103683610e4f565b915061104460208401610e4f565b90509250929050565b600181811c9082168061106157607f821691505b60208210810361108157634e487b7160e01b600052602260045260246000fd5b50919050565b6020808252602d908201527f4552433732313a2063616c6c6572206973206e6f7420746f6b656e206f776e6560408201526c1c881bdc88185c1c1c9bdd9959609a1b606082015260800190565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b6001600160a01b038581168252841660208201526040810183905260806060820181905260009061118e90830184610ddd565b9695505050505050565b6000602082840312156111aa57600080fd5b8151610dd681610da356fea264697066735822122077cba65cbb8cbbcc2eb7b84c76ef85b678a6780d05762a027f0faf16eabb232f64736f6c63430008150033"
+
+// ERC721ABI is used to communicate with the compiled solidity code of the generated contract
+const ERC721ABI = "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// ERC721RuntimeBin is the code deployed by ERC721Bin, with libraries left unlinked
+const ERC721RuntimeBin = "0x608060405234801561001057600080fd5b506004361061010b5760003560e01c806370a08231116100a2578063a22cb46511610071578063a22cb4651461021b578063b88d4fde1461022e578063c87b56dd14610241578063e985e9c514610254578063f2fde38b1461029057600080fd5b806370a08231146101d9578063715018a6146101fa5780638da5cb5b1461020257806395d89b411461021357600080fd5b806323b872dd116100de57806323b872dd1461018d57806340c10f19146101a057806342842e0e146101b35780636352211e146101c657600080fd5b806301ffc9a71461011057806306fdde0314610138578063081812fc1461014d578063095ea7b314610178575b600080fd5b61012361011e366004610db9565b6102a3565b60405190151581526020015b60405180910390f35b6101406102f5565b60405161012f9190610e23565b61016061015b366004610e36565b610387565b6040516001600160a01b03909116815260200161012f565b61018b610186366004610e6b565b6103ae565b005b61018b61019b366004610e95565b6104c8565b61018b6101ae366004610e6b565b6104f9565b61018b6101c1366004610e95565b610531565b6101606101d4366004610e36565b61054c565b6101ec6101e7366004610ed1565b6105ac565b60405190815260200161012f565b61018b610632565b6000546001600160a01b0316610160565b610140610668565b61018b610229366004610eec565b610677565b61018b61023c366004610f3e565b610682565b61014061024f366004610e36565b6106ba565b61012361026236600461101a565b6001600160a01b03918216600090815260066020908152604080832093909416825291909152205460ff1690565b61018b61029e366004610ed1565b6106d9565b60006001600160e01b031982166380ac58cd60e01b14806102d457506001600160e01b03198216635b5e139f60e01b145b806102ef57506301ffc9a760e01b6001600160e01b03198316145b92915050565b6060600180546103049061104d565b80601f01602080910402602001604051908101604052809291908181526020018280546103309061104d565b801561037d5780601f106103525761010080835404028352916020019161037d565b820191906000526020600020905b81548152906001019060200180831161036057829003601f168201915b5050505050905090565b600061039282610774565b506000908152600560205260409020546001600160a01b031690565b60006103b98261054c565b9050806001600160a01b0316836001600160a01b03160361042b5760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b60648201526084015b60405180910390fd5b336001600160a01b038216148061044757506104478133610262565b6104b95760405162461bcd60e51b815260206004820152603d60248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f7420746f60448201527f6b656e206f776e6572206f7220617070726f76656420666f7220616c6c0000006064820152608401610422565b6104c383836107d3565b505050565b6104d23382610841565b6104ee5760405162461bcd60e51b815260040161042290611087565b6104c38383836108c0565b6000546001600160a01b031633146105235760405162461bcd60e51b8152600401610422906110d4565b61052d8282610a29565b5050565b6104c383838360405180602001604052806000815250610682565b6000818152600360205260408120546001600160a01b0316806102ef5760405162461bcd60e51b8152602060048201526018602482015277115490cdcc8c4e881a5b9d985b1a59081d1bdad95b88125160421b6044820152606401610422565b60006001600160a01b0382166106165760405162461bcd60e51b815260206004820152602960248201527f4552433732313a2061646472657373207a65726f206973206e6f7420612076616044820152683634b21037bbb732b960b91b6064820152608401610422565b506001600160a01b031660009081526004602052604090205490565b6000546001600160a01b0316331461065c5760405162461bcd60e51b8152600401610422906110d4565b6106666000610b4f565b565b6060600280546103049061104d565b61052d338383610b9f565b61068c3383610841565b6106a85760405162461bcd60e51b815260040161042290611087565b6106b484848484610c6d565b50505050565b60606106c582610774565b505060408051602081019091526000815290565b6000546001600160a01b031633146107035760405162461bcd60e51b8152600401610422906110d4565b6001600160a01b0381166107685760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610422565b61077181610b4f565b50565b6000818152600360205260409020546001600160a01b03166107715760405162461bcd60e51b8152602060048201526018602482015277115490cdcc8c4e881a5b9d985b1a59081d1bdad95b88125160421b6044820152606401610422565b600081815260056020526040902080546001600160a01b0319166001600160a01b03841690811790915581906108088261054c565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b60008061084d8361054c565b9050806001600160a01b0316846001600160a01b0316148061089457506001600160a01b0380821660009081526006602090815260408083209388168352929052205460ff165b806108b85750836001600160a01b03166108ad84610387565b6001600160a01b0316145b949350505050565b826001600160a01b03166108d38261054c565b6001600160a01b0316146109375760405162461bcd60e51b815260206004820152602560248201527f4552433732313a207472616e736665722066726f6d20696e636f72726563742060448201526437bbb732b960d91b6064820152608401610422565b6001600160a01b0382166109995760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b6064820152608401610422565b600081815260056020908152604080832080546001600160a01b03199081169091556001600160a01b0387811680865260048552838620805460001901905590871680865283862080546001019055868652600390945282852080549092168417909155905184937fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b6001600160a01b038216610a7f5760405162461bcd60e51b815260206004820181905260248201527f4552433732313a206d696e7420746f20746865207a65726f20616464726573736044820152606401610422565b6000818152600360205260409020546001600160a01b031615610ae45760405162461bcd60e51b815260206004820152601c60248201527f4552433732313a20746f6b656e20616c7265616479206d696e746564000000006044820152606401610422565b6001600160a01b038216600081815260046020908152604080832080546001019055848352600390915280822080546001600160a01b0319168417905551839291907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908290a45050565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b816001600160a01b0316836001600160a01b031603610c005760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c6572000000000000006044820152606401610422565b6001600160a01b03838116600081815260066020908152604080832094871680845294825291829020805460ff191686151590811790915591519182527f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a3505050565b610c788484846108c0565b610c8484848484610ca0565b6106b45760405162461bcd60e51b815260040161042290611109565b6000836001600160a01b03163b600003610cbc575060016108b8565b604051630a85bd0160e11b81526001600160a01b0385169063150b7a0290610cee90339089908890889060040161115b565b6020604051808303816000875af1925050508015610d29575060408051601f3d908101601f19168201909252610d2691810190611198565b60015b610d86573d808015610d57576040519150601f19603f3d011682016040523d82523d6000602084013e610d5c565b606091505b508051600003610d7e5760405162461bcd60e51b815260040161042290611109565b805181602001fd5b6001600160e01b031916630a85bd0160e11b149050949350505050565b6001600160e01b03198116811461077157600080fd5b600060208284031215610dcb57600080fd5b8135610dd681610da3565b9392505050565b6000815180845260005b81811015610e0357602081850181015186830182015201610de7565b506000602082860101526020601f19601f83011685010191505092915050565b602081526000610dd66020830184610ddd565b600060208284031215610e4857600080fd5b5035919050565b80356001600160a01b0381168114610e6657600080fd5b919050565b60008060408385031215610e7e57600080fd5b610e8783610e4f565b946020939093013593505050565b600080600060608486031215610eaa57600080fd5b610eb384610e4f565b9250610ec160208501610e4f565b9150604084013590509250925092565b600060208284031215610ee357600080fd5b610dd682610e4f565b60008060408385031215610eff57600080fd5b610f0883610e4f565b915060208301358015158114610f1d57600080fd5b809150509250929050565b634e487b7160e01b600052604160045260246000fd5b60008060008060808587031215610f5457600080fd5b610f5d85610e4f565b9350610f6b60208601610e4f565b925060408501359150606085013567ffffffffffffffff80821115610f8f57600080fd5b818701915087601f830112610fa357600080fd5b813581811115610fb557610fb5610f28565b604051601f8201601f19908116603f01168101908382118183101715610fdd57610fdd610f28565b816040528281528a6020848701011115610ff657600080fd5b82602086016020830137600060208483010152809550505050505092959194509250565b6000806040838503121561102d57600080fd5b61103683610e4f565b915061104460208401610e4f565b90509250929050565b600181811c9082168061106157607f821691505b60208210810361108157634e487b7160e01b600052602260045260246000fd5b50919050565b6020808252602d908201527f4552433732313a2063616c6c6572206973206e6f7420746f6b656e206f776e6560408201526c1c881bdc88185c1c1c9bdd9959609a1b606082015260800190565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b6001600160a01b038581168252841660208201526040810183905260806060820181905260009061118e90830184610ddd565b9695505050505050565b6000602082840312156111aa57600080fd5b8151610dd681610da356fea264697066735822122077cba65cbb8cbbcc2eb7b84c76ef85b678a6780d05762a027f0faf16eabb232f64736f6c63430008150033"
+
+// ERC721SourceMap maps the instructions of ERC721Bin to the Solidity sources
+const ERC721SourceMap = "352:7395:0:-:0;;;718:113;;;;;;;;;;;;;;;;;;;;;;;;;;;;:::i;:::-;355:32:5;218:10:3;355:18:5;:32::i;:::-;784:5:0;:13;792:5;784;:13;:::i;:::-;-1:-1:-1;807:7:0;:17;817:7;807;:17;:::i;:::-;;718:113;;352:7395;;1145:187:5;1218:16;1237:6;;-1:-1:-1;;;;;1253:17:5;;;-1:-1:-1;;;;;;1253:17:5;;;;;;1285:40;;1237:6;;;;;;;1285:40;;1218:16;1285:40;1208:124;1145:187;:::o;14:127:6:-;75:10;70:3;66:20;63:1;56:31;106:4;103:1;96:15;130:4;127:1;120:15;146:840;200:5;253:3;246:4;238:6;234:17;230:27;220:55;;271:1;268;261:12;220:55;294:13;;-1:-1:-1;;;;;356:10:6;;;353:36;;;369:18;;:::i;:::-;444:2;438:9;412:2;498:13;;-1:-1:-1;;494:22:6;;;518:2;490:31;486:40;474:53;;;542:18;;;562:22;;;539:46;536:72;;;588:18;;:::i;:::-;628:10;624:2;617:22;663:2;655:6;648:18;685:4;675:14;;730:3;725:2;720;712:6;708:15;704:24;701:33;698:53;;;747:1;744;737:12;698:53;769:1;760:10;;779:133;793:2;790:1;787:9;779:133;;;881:14;;;877:23;;871:30;850:14;;;846:23;;839:63;804:10;;;;779:133;;;954:1;932:15;;;928:24;;;921:35;;;;936:6;146:840;-1:-1:-1;;;;146:840:6:o;991:562::-;1090:6;1098;1151:2;1139:9;1130:7;1126:23;1122:32;1119:52;;;1167:1;1164;1157:12;1119:52;1194:16;;-1:-1:-1;;;;;1259:14:6;;;1256:34;;;1286:1;1283;1276:12;1256:34;1309:61;1362:7;1353:6;1342:9;1338:22;1309:61;:::i;:::-;1299:71;;1416:2;1405:9;1401:18;1395:25;1379:41;;1445:2;1435:8;1432:16;1429:36;;;1461:1;1458;1451:12;1429:36;;1484:63;1539:7;1528:8;1517:9;1513:24;1484:63;:::i;:::-;1474:73;;;991:562;;;;;:::o;1558:380::-;1637:1;1633:12;;;;1680;;;1701:61;;1755:4;1747:6;1743:17;1733:27;;1701:61;1808:2;1800:6;1797:14;1777:18;1774:38;1771:161;;1854:10;1849:3;1845:20;1842:1;1835:31;1889:4;1886:1;1879:15;1917:4;1914:1;1907:15;1771:161;;1558:380;;;:::o;2069:545::-;2171:2;2166:3;2163:11;2160:448;;;2207:1;2232:5;2228:2;2221:17;2277:4;2273:2;2263:19;2347:2;2335:10;2331:19;2328:1;2324:27;2318:4;2314:38;2383:4;2371:10;2368:20;2365:47;;;-1:-1:-1;2406:4:6;2365:47;2461:2;2456:3;2452:12;2449:1;2445:20;2439:4;2435:31;2425:41;;2516:82;2534:2;2527:5;2524:13;2516:82;;;2579:17;;;2560:1;2549:13;2516:82;;;2520:3;;;2160:448;2069:545;;;:::o;2790:1352::-;2910:10;;-1:-1:-1;;;;;2932:30:6;;2929:56;;;2965:18;;:::i;:::-;2994:97;3084:6;3044:38;3076:4;3070:11;3044:38;:::i;:::-;3038:4;2994:97;:::i;:::-;3146:4;;3210:2;3199:14;;3227:1;3222:663;;;;3929:1;3946:6;3943:89;;;-1:-1:-1;3998:19:6;;;3992:26;3943:89;-1:-1:-1;;2747:1:6;2743:11;;;2739:24;2735:29;2725:40;2771:1;2767:11;;;2722:57;4045:81;;3192:944;;3222:663;2016:1;2009:14;;;2053:4;2040:18;;-1:-1:-1;;3258:20:6;;;3376:236;3390:7;3387:1;3384:14;3376:236;;;3479:19;;;3473:26;3458:42;;3571:27;;;;3539:1;3527:14;;;;3406:19;;3376:236;;;3380:3;3640:6;3631:7;3628:19;3625:201;;;3701:19;;;3695:26;-1:-1:-1;;3784:1:6;3780:14;;;3796:3;3776:24;3772:37;3768:42;3753:58;3738:74;;3625:201;-1:-1:-1;;;;;3872:1:6;3856:14;;;3852:22;3839:36;;-1:-1:-1;2790:1352:6:o;:::-;352:7395:0;;;;;;"
+
+// ERC721RuntimeSourceMap maps the instructions of ERC721RuntimeBin to the Solidity sources
+const ERC721RuntimeSourceMap = "352:7395:0:-:0;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;906:300;;;;;;:::i;:::-;;:::i;:::-;;;565:14:6;;558:22;540:41;;528:2;513:18;906:300:0;;;;;;;;1819:98;;;:::i;:::-;;;;;;;:::i;3092:166::-;;;;;;:::i;:::-;;:::i;:::-;;;-1:-1:-1;;;;;1594:32:6;;;1576:51;;1564:2;1549:18;3092:166:0;1430:203:6;2613:397:0;;;;;;:::i;:::-;;:::i;:::-;;3880:243;;;;;;:::i;:::-;;:::i;2403:103::-;;;;;;:::i;:::-;;:::i;4250:149::-;;;;;;:::i;:::-;;:::i;1547:219::-;;;;;;:::i;:::-;;:::i;1273:204::-;;;;;;:::i;:::-;;:::i;:::-;;;2745:25:6;;;2733:2;2718:18;1273:204:0;2599:177:6;769:101:5;;;:::i;574:85::-;620:7;646:6;-1:-1:-1;;;;;646:6:5;574:85;;1972:102:0;;;:::i;3340:153::-;;;;;;:::i;:::-;;:::i;4548:276::-;;;;;;:::i;:::-;;:::i;2188:147::-;;;;;;:::i;:::-;;:::i;3597:162::-;;;;;;:::i;:::-;-1:-1:-1;;;;;3717:25:0;;;3694:4;3717:25;;;:18;:25;;;;;;;;:35;;;;;;;;;;;;;;;3597:162;941:198:5;;;;;;:::i;:::-;;:::i;906:300:0:-;1008:4;-1:-1:-1;;;;;;1043:40:0;;-1:-1:-1;;;1043:40:0;;:104;;-1:-1:-1;;;;;;;1099:48:0;;-1:-1:-1;;;1099:48:0;1043:104;:156;;;-1:-1:-1;;;;;;;;;;390:40:4;;;1163:36:0;1024:175;906:300;-1:-1:-1;;906:300:0:o;1819:98::-;1873:13;1905:5;1898:12;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;1819:98;:::o;3092:166::-;3168:7;3187:23;3202:7;3187:14;:23::i;:::-;-1:-1:-1;3227:24:0;;;;:15;:24;;;;;;-1:-1:-1;;;;;3227:24:0;;3092:166::o;2613:397::-;2693:13;2709:16;2717:7;2709;:16::i;:::-;2693:32;;2749:5;-1:-1:-1;;;;;2743:11:0;:2;-1:-1:-1;;;;;2743:11:0;;2735:57;;;;-1:-1:-1;;;2735:57:0;;5260:2:6;2735:57:0;;;5242:21:6;5299:2;5279:18;;;5272:30;5338:34;5318:18;;;5311:62;-1:-1:-1;;;5389:18:6;;;5382:31;5430:19;;2735:57:0;;;;;;;;;218:10:3;-1:-1:-1;;;;;2823:21:0;;;;:62;;-1:-1:-1;2848:37:0;2865:5;218:10:3;3597:162:0;:::i;2848:37::-;2802:170;;;;-1:-1:-1;;;2802:170:0;;5662:2:6;2802:170:0;;;5644:21:6;5701:2;5681:18;;;5674:30;5740:34;5720:18;;;5713:62;5811:31;5791:18;;;5784:59;5860:19;;2802:170:0;5460:425:6;2802:170:0;2982:21;2991:2;2995:7;2982:8;:21::i;:::-;2683:327;2613:397;;:::o;3880:243::-;3987:41;218:10:3;4020:7:0;3987:18;:41::i;:::-;3979:99;;;;-1:-1:-1;;;3979:99:0;;;;;;;:::i;:::-;4088:28;4098:4;4104:2;4108:7;4088:9;:28::i;2403:103::-;620:7:5;646:6;-1:-1:-1;;;;;646:6:5;218:10:3;439:23:5;431:68;;;;-1:-1:-1;;;431:68:5;;;;;;;:::i;:::-;2481:18:0::1;2487:2;2491:7;2481:5;:18::i;:::-;2403:103:::0;;:::o;4250:149::-;4353:39;4370:4;4376:2;4380:7;4353:39;;;;;;;;;;;;:16;:39::i;1547:219::-;1619:7;5195:16;;;:7;:16;;;;;;-1:-1:-1;;;;;5195:16:0;;1681:56;;;;-1:-1:-1;;;1681:56:0;;6867:2:6;1681:56:0;;;6849:21:6;6906:2;6886:18;;;6879:30;-1:-1:-1;;;6925:18:6;;;6918:54;6989:18;;1681:56:0;6665:348:6;1273:204:0;1345:7;-1:-1:-1;;;;;1372:19:0;;1364:73;;;;-1:-1:-1;;;1364:73:0;;7220:2:6;1364:73:0;;;7202:21:6;7259:2;7239:18;;;7232:30;7298:34;7278:18;;;7271:62;-1:-1:-1;;;7349:18:6;;;7342:39;7398:19;;1364:73:0;7018:405:6;1364:73:0;-1:-1:-1;;;;;;1454:16:0;;;;;:9;:16;;;;;;;1273:204::o;769:101:5:-;620:7;646:6;-1:-1:-1;;;;;646:6:5;218:10:3;439:23:5;431:68;;;;-1:-1:-1;;;431:68:5;;;;;;;:::i;:::-;833:30:::1;860:1;833:18;:30::i;:::-;769:101::o:0;1972:102:0:-;2028:13;2060:7;2053:14;;;;;:::i;3340:153::-;3434:52;218:10:3;3467:8:0;3477;3434:18;:52::i;4548:276::-;4678:41;218:10:3;4711:7:0;4678:18;:41::i;:::-;4670:99;;;;-1:-1:-1;;;4670:99:0;;;;;;;:::i;:::-;4779:38;4793:4;4799:2;4803:7;4812:4;4779:13;:38::i;:::-;4548:276;;;;:::o;2188:147::-;2261:13;2286:23;2301:7;2286:14;:23::i;:::-;-1:-1:-1;;2319:9:0;;;;;;;;;-1:-1:-1;2319:9:0;;;2188:147::o;941:198:5:-;620:7;646:6;-1:-1:-1;;;;;646:6:5;218:10:3;439:23:5;431:68;;;;-1:-1:-1;;;431:68:5;;;;;;;:::i;:::-;-1:-1:-1;;;;;1029:22:5;::::1;1021:73;;;::::0;-1:-1:-1;;;1021:73:5;;7630:2:6;1021:73:5::1;::::0;::::1;7612:21:6::0;7669:2;7649:18;;;7642:30;7708:34;7688:18;;;7681:62;-1:-1:-1;;;7759:18:6;;;7752:36;7805:19;;1021:73:5::1;7428:402:6::0;1021:73:5::1;1104:28;1123:8;1104:18;:28::i;:::-;941:198:::0;:::o;6958:133:0:-;5289:4;5195:16;;;:7;:16;;;;;;-1:-1:-1;;;;;5195:16:0;7031:53;;;;-1:-1:-1;;;7031:53:0;;6867:2:6;7031:53:0;;;6849:21:6;6906:2;6886:18;;;6879:30;-1:-1:-1;;;6925:18:6;;;6918:54;6989:18;;7031:53:0;6665:348:6;6505:164:0;6579:24;;;;:15;:24;;;;;:29;;-1:-1:-1;;;;;;6579:29:0;-1:-1:-1;;;;;6579:29:0;;;;;;;;:24;;6632:16;6579:24;6632:7;:16::i;:::-;-1:-1:-1;;;;;6623:39:0;;;;;;;;;;;6505:164;;:::o;5356:254::-;5449:4;5465:13;5481:16;5489:7;5481;:16::i;:::-;5465:32;;5526:5;-1:-1:-1;;;;;5515:16:0;:7;-1:-1:-1;;;;;5515:16:0;;:52;;;-1:-1:-1;;;;;;3717:25:0;;;3694:4;3717:25;;;:18;:25;;;;;;;;:35;;;;;;;;;;;;5535:32;5515:87;;;;5595:7;-1:-1:-1;;;;;5571:31:0;:20;5583:7;5571:11;:20::i;:::-;-1:-1:-1;;;;;5571:31:0;;5515:87;5507:96;5356:254;-1:-1:-1;;;;5356:254:0:o;6043:456::-;6160:4;-1:-1:-1;;;;;6140:24:0;:16;6148:7;6140;:16::i;:::-;-1:-1:-1;;;;;6140:24:0;;6132:74;;;;-1:-1:-1;;;6132:74:0;;8037:2:6;6132:74:0;;;8019:21:6;8076:2;8056:18;;;8049:30;8115:34;8095:18;;;8088:62;-1:-1:-1;;;8166:18:6;;;8159:35;8211:19;;6132:74:0;7835:401:6;6132:74:0;-1:-1:-1;;;;;6224:16:0;;6216:65;;;;-1:-1:-1;;;6216:65:0;;8443:2:6;6216:65:0;;;8425:21:6;8482:2;8462:18;;;8455:30;8521:34;8501:18;;;8494:62;-1:-1:-1;;;8572:18:6;;;8565:34;8616:19;;6216:65:0;8241:400:6;6216:65:0;6299:24;;;;:15;:24;;;;;;;;6292:31;;-1:-1:-1;;;;;;6292:31:0;;;;;;-1:-1:-1;;;;;6357:15:0;;;;;;:9;:15;;;;;:20;;-1:-1:-1;;6357:20:0;;;6391:13;;;;;;;;;:18;;6292:31;6391:18;;;6429:16;;;:7;:16;;;;;;:21;;;;;;;;;;6465:27;;6315:7;;6465:27;;;6043:456;;;:::o;5616:421::-;-1:-1:-1;;;;;5695:16:0;;5687:61;;;;-1:-1:-1;;;5687:61:0;;8848:2:6;5687:61:0;;;8830:21:6;;;8867:18;;;8860:30;8926:34;8906:18;;;8899:62;8978:18;;5687:61:0;8646:356:6;5687:61:0;5289:4;5195:16;;;:7;:16;;;;;;-1:-1:-1;;;;;5195:16:0;5312:31;5758:58;;;;-1:-1:-1;;;5758:58:0;;9209:2:6;5758:58:0;;;9191:21:6;9248:2;9228:18;;;9221:30;9287;9267:18;;;9260:58;9335:18;;5758:58:0;9007:352:6;5758:58:0;-1:-1:-1;;;;;5923:13:0;;;;;;:9;:13;;;;;;;;:18;;5940:1;5923:18;;;5961:16;;;:7;:16;;;;;;:21;;-1:-1:-1;;;;;;5961:21:0;;;;;5997:33;5969:7;;5923:13;;5997:33;;5923:13;;5997:33;5616:421;;:::o;1145:187:5:-;1218:16;1237:6;;-1:-1:-1;;;;;1253:17:5;;;-1:-1:-1;;;;;;1253:17:5;;;;;;1285:40;;1237:6;;;;;;;1285:40;;1218:16;1285:40;1208:124;1145:187;:::o;6675:277:0:-;6795:8;-1:-1:-1;;;;;6786:17:0;:5;-1:-1:-1;;;;;6786:17:0;;6778:55;;;;-1:-1:-1;;;6778:55:0;;9566:2:6;6778:55:0;;;9548:21:6;9605:2;9585:18;;;9578:30;9644:27;9624:18;;;9617:55;9689:18;;6778:55:0;9364:349:6;6778:55:0;-1:-1:-1;;;;;6843:25:0;;;;;;;:18;:25;;;;;;;;:35;;;;;;;;;;;;;:46;;-1:-1:-1;;6843:46:0;;;;;;;;;;6904:41;;540::6;;;6904::0;;513:18:6;6904:41:0;;;;;;;6675:277;;;:::o;4830:267::-;4942:28;4952:4;4958:2;4962:7;4942:9;:28::i;:::-;4988:47;5011:4;5017:2;5021:7;5030:4;4988:22;:47::i;:::-;4980:110;;;;-1:-1:-1;;;4980:110:0;;;;;;;:::i;7097:648::-;7208:4;7228:2;-1:-1:-1;;;;;7228:14:0;;7246:1;7228:19;7224:61;;-1:-1:-1;7270:4:0;7263:11;;7224:61;7298:71;;-1:-1:-1;;;7298:71:0;;-1:-1:-1;;;;;7298:36:0;;;;;:71;;218:10:3;;7349:4:0;;7355:7;;7364:4;;7298:71;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;-1:-1:-1;7298:71:0;;;;;;;;-1:-1:-1;;7298:71:0;;;;;;;;;;;;:::i;:::-;;;7294:445;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;7524:6;:13;7541:1;7524:18;7520:117;;7562:60;;-1:-1:-1;;;7562:60:0;;;;;;;:::i;7520:117::-;7707:6;7701:13;7692:6;7688:2;7684:15;7677:38;7294:445;-1:-1:-1;;;;;;7415:51:0;-1:-1:-1;;;7415:51:0;;-1:-1:-1;7097:648:0;;;;;;:::o;14:131:6:-;-1:-1:-1;;;;;;88:32:6;;78:43;;68:71;;135:1;132;125:12;150:245;208:6;261:2;249:9;240:7;236:23;232:32;229:52;;;277:1;274;267:12;229:52;316:9;303:23;335:30;359:5;335:30;:::i;:::-;384:5;150:245;-1:-1:-1;;;150:245:6:o;592:423::-;634:3;672:5;666:12;699:6;694:3;687:19;724:1;734:162;748:6;745:1;742:13;734:162;;;810:4;866:13;;;862:22;;856:29;838:11;;;834:20;;827:59;763:12;734:162;;;738:3;941:1;934:4;925:6;920:3;916:16;912:27;905:38;1004:4;997:2;993:7;988:2;980:6;976:15;972:29;967:3;963:39;959:50;952:57;;;592:423;;;;:::o;1020:220::-;1169:2;1158:9;1151:21;1132:4;1189:45;1230:2;1219:9;1215:18;1207:6;1189:45;:::i;1245:180::-;1304:6;1357:2;1345:9;1336:7;1332:23;1328:32;1325:52;;;1373:1;1370;1363:12;1325:52;-1:-1:-1;1396:23:6;;1245:180;-1:-1:-1;1245:180:6:o;1638:173::-;1706:20;;-1:-1:-1;;;;;1755:31:6;;1745:42;;1735:70;;1801:1;1798;1791:12;1735:70;1638:173;;;:::o;1816:254::-;1884:6;1892;1945:2;1933:9;1924:7;1920:23;1916:32;1913:52;;;1961:1;1958;1951:12;1913:52;1984:29;2003:9;1984:29;:::i;:::-;1974:39;2060:2;2045:18;;;;2032:32;;-1:-1:-1;;;1816:254:6:o;2075:328::-;2152:6;2160;2168;2221:2;2209:9;2200:7;2196:23;2192:32;2189:52;;;2237:1;2234;2227:12;2189:52;2260:29;2279:9;2260:29;:::i;:::-;2250:39;;2308:38;2342:2;2331:9;2327:18;2308:38;:::i;:::-;2298:48;;2393:2;2382:9;2378:18;2365:32;2355:42;;2075:328;;;;;:::o;2408:186::-;2467:6;2520:2;2508:9;2499:7;2495:23;2491:32;2488:52;;;2536:1;2533;2526:12;2488:52;2559:29;2578:9;2559:29;:::i;2781:347::-;2846:6;2854;2907:2;2895:9;2886:7;2882:23;2878:32;2875:52;;;2923:1;2920;2913:12;2875:52;2946:29;2965:9;2946:29;:::i;:::-;2936:39;;3025:2;3014:9;3010:18;2997:32;3072:5;3065:13;3058:21;3051:5;3048:32;3038:60;;3094:1;3091;3084:12;3038:60;3117:5;3107:15;;;2781:347;;;;;:::o;3133:127::-;3194:10;3189:3;3185:20;3182:1;3175:31;3225:4;3222:1;3215:15;3249:4;3246:1;3239:15;3265:1138;3360:6;3368;3376;3384;3437:3;3425:9;3416:7;3412:23;3408:33;3405:53;;;3454:1;3451;3444:12;3405:53;3477:29;3496:9;3477:29;:::i;:::-;3467:39;;3525:38;3559:2;3548:9;3544:18;3525:38;:::i;:::-;3515:48;;3610:2;3599:9;3595:18;3582:32;3572:42;;3665:2;3654:9;3650:18;3637:32;3688:18;3729:2;3721:6;3718:14;3715:34;;;3745:1;3742;3735:12;3715:34;3783:6;3772:9;3768:22;3758:32;;3828:7;3821:4;3817:2;3813:13;3809:27;3799:55;;3850:1;3847;3840:12;3799:55;3886:2;3873:16;3908:2;3904;3901:10;3898:36;;;3914:18;;:::i;:::-;3989:2;3983:9;3957:2;4043:13;;-1:-1:-1;;4039:22:6;;;4063:2;4035:31;4031:40;4019:53;;;4087:18;;;4107:22;;;4084:46;4081:72;;;4133:18;;:::i;:::-;4173:10;4169:2;4162:22;4208:2;4200:6;4193:18;4248:7;4243:2;4238;4234;4230:11;4226:20;4223:33;4220:53;;;4269:1;4266;4259:12;4220:53;4325:2;4320;4316;4312:11;4307:2;4299:6;4295:15;4282:46;4370:1;4365:2;4360;4352:6;4348:15;4344:24;4337:35;4391:6;4381:16;;;;;;;3265:1138;;;;;;;:::o;4408:260::-;4476:6;4484;4537:2;4525:9;4516:7;4512:23;4508:32;4505:52;;;4553:1;4550;4543:12;4505:52;4576:29;4595:9;4576:29;:::i;:::-;4566:39;;4624:38;4658:2;4647:9;4643:18;4624:38;:::i;:::-;4614:48;;4408:260;;;;;:::o;4673:380::-;4752:1;4748:12;;;;4795;;;4816:61;;4870:4;4862:6;4858:17;4848:27;;4816:61;4923:2;4915:6;4912:14;4892:18;4889:38;4886:161;;4969:10;4964:3;4960:20;4957:1;4950:31;5004:4;5001:1;4994:15;5032:4;5029:1;5022:15;4886:161;;4673:380;;;:::o;5890:409::-;6092:2;6074:21;;;6131:2;6111:18;;;6104:30;6170:34;6165:2;6150:18;;6143:62;-1:-1:-1;;;6236:2:6;6221:18;;6214:43;6289:3;6274:19;;5890:409::o;6304:356::-;6506:2;6488:21;;;6525:18;;;6518:30;6584:34;6579:2;6564:18;;6557:62;6651:2;6636:18;;6304:356::o;9718:414::-;9920:2;9902:21;;;9959:2;9939:18;;;9932:30;9998:34;9993:2;9978:18;;9971:62;-1:-1:-1;;;10064:2:6;10049:18;;10042:48;10122:3;10107:19;;9718:414::o;10137:489::-;-1:-1:-1;;;;;10406:15:6;;;10388:34;;10458:15;;10453:2;10438:18;;10431:43;10505:2;10490:18;;10483:34;;;10553:3;10548:2;10533:18;;10526:31;;;10331:4;;10574:46;;10600:19;;10592:6;10574:46;:::i;:::-;10566:54;10137:489;-1:-1:-1;;;;;;10137:489:6:o;10631:249::-;10700:6;10753:2;10741:9;10732:7;10728:23;10724:32;10721:52;;;10769:1;10766;10759:12;10721:52;10801:9;10795:16;10820:30;10844:5;10820:30;:::i"
+
+// ERC721FuncSigs maps the 4-byte function signature to its string representation.
+var ERC721FuncSigs = map[string]string{
+	"095ea7b3": "approve(address,uint256)",
+	"70a08231": "balanceOf(address)",
+	"081812fc": "getApproved(uint256)",
+	"e985e9c5": "isApprovedForAll(address,address)",
+	"40c10f19": "mint(address,uint256)",
+	"06fdde03": "name()",
+	"8da5cb5b": "owner()",
+	"6352211e": "ownerOf(uint256)",
+	"715018a6": "renounceOwnership()",
+	"42842e0e": "safeTransferFrom(address,address,uint256)",
+	"b88d4fde": "safeTransferFrom(address,address,uint256,bytes)",
+	"a22cb465": "setApprovalForAll(address,bool)",
+	"01ffc9a7": "supportsInterface(bytes4)",
+	"95d89b41": "symbol()",
+	"c87b56dd": "tokenURI(uint256)",
+	"23b872dd": "transferFrom(address,address,uint256)",
+	"f2fde38b": "transferOwnership(address)",
+}
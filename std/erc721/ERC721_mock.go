@@ -0,0 +1,932 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package erc721
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+)
+
+// ERC721Mock is a scriptable implementation of ERC721Interface for unit tests.
+// Results are scripted per method and arguments, for example
+//
+//	mock.OnMethod(args...).Return(results..., err)
+//
+// Calls that were not scripted return zero values and an error.
+type ERC721Mock struct {
+	mu    sync.Mutex
+	calls []ERC721MockCall
+
+	onBalanceOf         []*ERC721BalanceOfMock
+	onGetApproved       []*ERC721GetApprovedMock
+	onIsApprovedForAll  []*ERC721IsApprovedForAllMock
+	onName              []*ERC721NameMock
+	onOwner             []*ERC721OwnerMock
+	onOwnerOf           []*ERC721OwnerOfMock
+	onSupportsInterface []*ERC721SupportsInterfaceMock
+	onSymbol            []*ERC721SymbolMock
+	onTokenURI          []*ERC721TokenURIMock
+
+	onApprove                  []*ERC721ApproveMock
+	onMint                     []*ERC721MintMock
+	onRenounceOwnership        []*ERC721RenounceOwnershipMock
+	onSafeTransferFrom         []*ERC721SafeTransferFromMock
+	onSafeTransferFromWithData []*ERC721SafeTransferFromWithDataMock
+	onSetApprovalForAll        []*ERC721SetApprovalForAllMock
+	onTransferFrom             []*ERC721TransferFromMock
+	onTransferOwnership        []*ERC721TransferOwnershipMock
+
+	onUnpackApprovalLog             []*ERC721UnpackApprovalLogMock
+	onUnpackApprovalForAllLog       []*ERC721UnpackApprovalForAllLogMock
+	onUnpackOwnershipTransferredLog []*ERC721UnpackOwnershipTransferredLogMock
+	onUnpackTransferLog             []*ERC721UnpackTransferLogMock
+}
+
+// This nil assignment ensures at compile time that ERC721Mock implements ERC721Interface.
+var _ ERC721Interface = (*ERC721Mock)(nil)
+
+// ERC721MockCall records a single call made to a ERC721Mock.
+type ERC721MockCall struct {
+	Method string        // Name of the called method
+	Args   []interface{} // Arguments passed to the method, excluding opts
+}
+
+// MockCalls returns every call made to the mock so far, in order.
+func (_m *ERC721Mock) MockCalls() []ERC721MockCall {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	return append([]ERC721MockCall(nil), _m.calls...)
+}
+
+// matchERC721MockArgs compares scripted and received arguments, treating big
+// integers as equal whenever they hold the same value.
+func matchERC721MockArgs(want, got []interface{}) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		w, wok := want[i].(*big.Int)
+		g, gok := got[i].(*big.Int)
+		if wok && gok && w != nil && g != nil {
+			if w.Cmp(g) != 0 {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(want[i], got[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// ERC721BalanceOfMock scripts the results of ERC721Mock.BalanceOf.
+type ERC721BalanceOfMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnBalanceOf scripts the results of BalanceOf calls made with the given arguments.
+func (_m *ERC721Mock) OnBalanceOf(owner common.Address) *ERC721BalanceOfMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721BalanceOfMock{mock: _m, args: []interface{}{owner}}
+	_m.onBalanceOf = append(_m.onBalanceOf, _e)
+	return _e
+}
+
+// Return sets the results of the BalanceOf calls matching the scripted arguments.
+func (_e *ERC721BalanceOfMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// BalanceOf records the call and returns the results scripted for its arguments.
+func (_m *ERC721Mock) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	_args := []interface{}{owner}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "BalanceOf", Args: _args})
+	for _i := len(_m.onBalanceOf) - 1; _i >= 0; _i-- {
+		if _e := _m.onBalanceOf[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC721BalanceOfMock
+	return _e.ret0, fmt.Errorf("ERC721Mock: no results scripted for BalanceOf%v", _args)
+}
+
+// ERC721GetApprovedMock scripts the results of ERC721Mock.GetApproved.
+type ERC721GetApprovedMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	ret0 common.Address
+	err  error
+}
+
+// OnGetApproved scripts the results of GetApproved calls made with the given arguments.
+func (_m *ERC721Mock) OnGetApproved(tokenId *big.Int) *ERC721GetApprovedMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721GetApprovedMock{mock: _m, args: []interface{}{tokenId}}
+	_m.onGetApproved = append(_m.onGetApproved, _e)
+	return _e
+}
+
+// Return sets the results of the GetApproved calls matching the scripted arguments.
+func (_e *ERC721GetApprovedMock) Return(ret0 common.Address, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// GetApproved records the call and returns the results scripted for its arguments.
+func (_m *ERC721Mock) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	_args := []interface{}{tokenId}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "GetApproved", Args: _args})
+	for _i := len(_m.onGetApproved) - 1; _i >= 0; _i-- {
+		if _e := _m.onGetApproved[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC721GetApprovedMock
+	return _e.ret0, fmt.Errorf("ERC721Mock: no results scripted for GetApproved%v", _args)
+}
+
+// ERC721IsApprovedForAllMock scripts the results of ERC721Mock.IsApprovedForAll.
+type ERC721IsApprovedForAllMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	ret0 bool
+	err  error
+}
+
+// OnIsApprovedForAll scripts the results of IsApprovedForAll calls made with the given arguments.
+func (_m *ERC721Mock) OnIsApprovedForAll(owner common.Address, operator common.Address) *ERC721IsApprovedForAllMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721IsApprovedForAllMock{mock: _m, args: []interface{}{owner, operator}}
+	_m.onIsApprovedForAll = append(_m.onIsApprovedForAll, _e)
+	return _e
+}
+
+// Return sets the results of the IsApprovedForAll calls matching the scripted arguments.
+func (_e *ERC721IsApprovedForAllMock) Return(ret0 bool, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// IsApprovedForAll records the call and returns the results scripted for its arguments.
+func (_m *ERC721Mock) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	_args := []interface{}{owner, operator}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "IsApprovedForAll", Args: _args})
+	for _i := len(_m.onIsApprovedForAll) - 1; _i >= 0; _i-- {
+		if _e := _m.onIsApprovedForAll[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC721IsApprovedForAllMock
+	return _e.ret0, fmt.Errorf("ERC721Mock: no results scripted for IsApprovedForAll%v", _args)
+}
+
+// ERC721NameMock scripts the results of ERC721Mock.Name.
+type ERC721NameMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	ret0 string
+	err  error
+}
+
+// OnName scripts the results of Name calls made with the given arguments.
+func (_m *ERC721Mock) OnName() *ERC721NameMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721NameMock{mock: _m, args: []interface{}{}}
+	_m.onName = append(_m.onName, _e)
+	return _e
+}
+
+// Return sets the results of the Name calls matching the scripted arguments.
+func (_e *ERC721NameMock) Return(ret0 string, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Name records the call and returns the results scripted for its arguments.
+func (_m *ERC721Mock) Name(opts *bind.CallOpts) (string, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "Name", Args: _args})
+	for _i := len(_m.onName) - 1; _i >= 0; _i-- {
+		if _e := _m.onName[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC721NameMock
+	return _e.ret0, fmt.Errorf("ERC721Mock: no results scripted for Name%v", _args)
+}
+
+// ERC721OwnerMock scripts the results of ERC721Mock.Owner.
+type ERC721OwnerMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	ret0 common.Address
+	err  error
+}
+
+// OnOwner scripts the results of Owner calls made with the given arguments.
+func (_m *ERC721Mock) OnOwner() *ERC721OwnerMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721OwnerMock{mock: _m, args: []interface{}{}}
+	_m.onOwner = append(_m.onOwner, _e)
+	return _e
+}
+
+// Return sets the results of the Owner calls matching the scripted arguments.
+func (_e *ERC721OwnerMock) Return(ret0 common.Address, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Owner records the call and returns the results scripted for its arguments.
+func (_m *ERC721Mock) Owner(opts *bind.CallOpts) (common.Address, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "Owner", Args: _args})
+	for _i := len(_m.onOwner) - 1; _i >= 0; _i-- {
+		if _e := _m.onOwner[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC721OwnerMock
+	return _e.ret0, fmt.Errorf("ERC721Mock: no results scripted for Owner%v", _args)
+}
+
+// ERC721OwnerOfMock scripts the results of ERC721Mock.OwnerOf.
+type ERC721OwnerOfMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	ret0 common.Address
+	err  error
+}
+
+// OnOwnerOf scripts the results of OwnerOf calls made with the given arguments.
+func (_m *ERC721Mock) OnOwnerOf(tokenId *big.Int) *ERC721OwnerOfMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721OwnerOfMock{mock: _m, args: []interface{}{tokenId}}
+	_m.onOwnerOf = append(_m.onOwnerOf, _e)
+	return _e
+}
+
+// Return sets the results of the OwnerOf calls matching the scripted arguments.
+func (_e *ERC721OwnerOfMock) Return(ret0 common.Address, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// OwnerOf records the call and returns the results scripted for its arguments.
+func (_m *ERC721Mock) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	_args := []interface{}{tokenId}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "OwnerOf", Args: _args})
+	for _i := len(_m.onOwnerOf) - 1; _i >= 0; _i-- {
+		if _e := _m.onOwnerOf[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC721OwnerOfMock
+	return _e.ret0, fmt.Errorf("ERC721Mock: no results scripted for OwnerOf%v", _args)
+}
+
+// ERC721SupportsInterfaceMock scripts the results of ERC721Mock.SupportsInterface.
+type ERC721SupportsInterfaceMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	ret0 bool
+	err  error
+}
+
+// OnSupportsInterface scripts the results of SupportsInterface calls made with the given arguments.
+func (_m *ERC721Mock) OnSupportsInterface(interfaceId [4]byte) *ERC721SupportsInterfaceMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721SupportsInterfaceMock{mock: _m, args: []interface{}{interfaceId}}
+	_m.onSupportsInterface = append(_m.onSupportsInterface, _e)
+	return _e
+}
+
+// Return sets the results of the SupportsInterface calls matching the scripted arguments.
+func (_e *ERC721SupportsInterfaceMock) Return(ret0 bool, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// SupportsInterface records the call and returns the results scripted for its arguments.
+func (_m *ERC721Mock) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	_args := []interface{}{interfaceId}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "SupportsInterface", Args: _args})
+	for _i := len(_m.onSupportsInterface) - 1; _i >= 0; _i-- {
+		if _e := _m.onSupportsInterface[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC721SupportsInterfaceMock
+	return _e.ret0, fmt.Errorf("ERC721Mock: no results scripted for SupportsInterface%v", _args)
+}
+
+// ERC721SymbolMock scripts the results of ERC721Mock.Symbol.
+type ERC721SymbolMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	ret0 string
+	err  error
+}
+
+// OnSymbol scripts the results of Symbol calls made with the given arguments.
+func (_m *ERC721Mock) OnSymbol() *ERC721SymbolMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721SymbolMock{mock: _m, args: []interface{}{}}
+	_m.onSymbol = append(_m.onSymbol, _e)
+	return _e
+}
+
+// Return sets the results of the Symbol calls matching the scripted arguments.
+func (_e *ERC721SymbolMock) Return(ret0 string, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Symbol records the call and returns the results scripted for its arguments.
+func (_m *ERC721Mock) Symbol(opts *bind.CallOpts) (string, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "Symbol", Args: _args})
+	for _i := len(_m.onSymbol) - 1; _i >= 0; _i-- {
+		if _e := _m.onSymbol[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC721SymbolMock
+	return _e.ret0, fmt.Errorf("ERC721Mock: no results scripted for Symbol%v", _args)
+}
+
+// ERC721TokenURIMock scripts the results of ERC721Mock.TokenURI.
+type ERC721TokenURIMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	ret0 string
+	err  error
+}
+
+// OnTokenURI scripts the results of TokenURI calls made with the given arguments.
+func (_m *ERC721Mock) OnTokenURI(tokenId *big.Int) *ERC721TokenURIMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721TokenURIMock{mock: _m, args: []interface{}{tokenId}}
+	_m.onTokenURI = append(_m.onTokenURI, _e)
+	return _e
+}
+
+// Return sets the results of the TokenURI calls matching the scripted arguments.
+func (_e *ERC721TokenURIMock) Return(ret0 string, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// TokenURI records the call and returns the results scripted for its arguments.
+func (_m *ERC721Mock) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	_args := []interface{}{tokenId}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "TokenURI", Args: _args})
+	for _i := len(_m.onTokenURI) - 1; _i >= 0; _i-- {
+		if _e := _m.onTokenURI[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e ERC721TokenURIMock
+	return _e.ret0, fmt.Errorf("ERC721Mock: no results scripted for TokenURI%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// ERC721ApproveMock scripts the results of ERC721Mock.Approve.
+type ERC721ApproveMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnApprove scripts the results of Approve transactions made with the given arguments.
+func (_m *ERC721Mock) OnApprove(to common.Address, tokenId *big.Int) *ERC721ApproveMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721ApproveMock{mock: _m, args: []interface{}{to, tokenId}}
+	_m.onApprove = append(_m.onApprove, _e)
+	return _e
+}
+
+// Return sets the results of the Approve transactions matching the scripted arguments.
+func (_e *ERC721ApproveMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Approve records the transaction and returns the results scripted for its arguments.
+func (_m *ERC721Mock) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{to, tokenId}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "Approve", Args: _args})
+	for _i := len(_m.onApprove) - 1; _i >= 0; _i-- {
+		if _e := _m.onApprove[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for Approve%v", _args)
+}
+
+// ERC721MintMock scripts the results of ERC721Mock.Mint.
+type ERC721MintMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnMint scripts the results of Mint transactions made with the given arguments.
+func (_m *ERC721Mock) OnMint(to common.Address, tokenId *big.Int) *ERC721MintMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721MintMock{mock: _m, args: []interface{}{to, tokenId}}
+	_m.onMint = append(_m.onMint, _e)
+	return _e
+}
+
+// Return sets the results of the Mint transactions matching the scripted arguments.
+func (_e *ERC721MintMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Mint records the transaction and returns the results scripted for its arguments.
+func (_m *ERC721Mock) Mint(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{to, tokenId}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "Mint", Args: _args})
+	for _i := len(_m.onMint) - 1; _i >= 0; _i-- {
+		if _e := _m.onMint[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for Mint%v", _args)
+}
+
+// ERC721RenounceOwnershipMock scripts the results of ERC721Mock.RenounceOwnership.
+type ERC721RenounceOwnershipMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnRenounceOwnership scripts the results of RenounceOwnership transactions made with the given arguments.
+func (_m *ERC721Mock) OnRenounceOwnership() *ERC721RenounceOwnershipMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721RenounceOwnershipMock{mock: _m, args: []interface{}{}}
+	_m.onRenounceOwnership = append(_m.onRenounceOwnership, _e)
+	return _e
+}
+
+// Return sets the results of the RenounceOwnership transactions matching the scripted arguments.
+func (_e *ERC721RenounceOwnershipMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// RenounceOwnership records the transaction and returns the results scripted for its arguments.
+func (_m *ERC721Mock) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "RenounceOwnership", Args: _args})
+	for _i := len(_m.onRenounceOwnership) - 1; _i >= 0; _i-- {
+		if _e := _m.onRenounceOwnership[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for RenounceOwnership%v", _args)
+}
+
+// ERC721SafeTransferFromMock scripts the results of ERC721Mock.SafeTransferFrom.
+type ERC721SafeTransferFromMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnSafeTransferFrom scripts the results of SafeTransferFrom transactions made with the given arguments.
+func (_m *ERC721Mock) OnSafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) *ERC721SafeTransferFromMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721SafeTransferFromMock{mock: _m, args: []interface{}{from, to, tokenId}}
+	_m.onSafeTransferFrom = append(_m.onSafeTransferFrom, _e)
+	return _e
+}
+
+// Return sets the results of the SafeTransferFrom transactions matching the scripted arguments.
+func (_e *ERC721SafeTransferFromMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// SafeTransferFrom records the transaction and returns the results scripted for its arguments.
+func (_m *ERC721Mock) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{from, to, tokenId}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "SafeTransferFrom", Args: _args})
+	for _i := len(_m.onSafeTransferFrom) - 1; _i >= 0; _i-- {
+		if _e := _m.onSafeTransferFrom[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for SafeTransferFrom%v", _args)
+}
+
+// ERC721SafeTransferFromWithDataMock scripts the results of ERC721Mock.SafeTransferFromWithData.
+type ERC721SafeTransferFromWithDataMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnSafeTransferFromWithData scripts the results of SafeTransferFromWithData transactions made with the given arguments.
+func (_m *ERC721Mock) OnSafeTransferFromWithData(from common.Address, to common.Address, tokenId *big.Int, data []byte) *ERC721SafeTransferFromWithDataMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721SafeTransferFromWithDataMock{mock: _m, args: []interface{}{from, to, tokenId, data}}
+	_m.onSafeTransferFromWithData = append(_m.onSafeTransferFromWithData, _e)
+	return _e
+}
+
+// Return sets the results of the SafeTransferFromWithData transactions matching the scripted arguments.
+func (_e *ERC721SafeTransferFromWithDataMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// SafeTransferFromWithData records the transaction and returns the results scripted for its arguments.
+func (_m *ERC721Mock) SafeTransferFromWithData(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	_args := []interface{}{from, to, tokenId, data}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "SafeTransferFromWithData", Args: _args})
+	for _i := len(_m.onSafeTransferFromWithData) - 1; _i >= 0; _i-- {
+		if _e := _m.onSafeTransferFromWithData[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for SafeTransferFromWithData%v", _args)
+}
+
+// ERC721SetApprovalForAllMock scripts the results of ERC721Mock.SetApprovalForAll.
+type ERC721SetApprovalForAllMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnSetApprovalForAll scripts the results of SetApprovalForAll transactions made with the given arguments.
+func (_m *ERC721Mock) OnSetApprovalForAll(operator common.Address, approved bool) *ERC721SetApprovalForAllMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721SetApprovalForAllMock{mock: _m, args: []interface{}{operator, approved}}
+	_m.onSetApprovalForAll = append(_m.onSetApprovalForAll, _e)
+	return _e
+}
+
+// Return sets the results of the SetApprovalForAll transactions matching the scripted arguments.
+func (_e *ERC721SetApprovalForAllMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// SetApprovalForAll records the transaction and returns the results scripted for its arguments.
+func (_m *ERC721Mock) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	_args := []interface{}{operator, approved}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "SetApprovalForAll", Args: _args})
+	for _i := len(_m.onSetApprovalForAll) - 1; _i >= 0; _i-- {
+		if _e := _m.onSetApprovalForAll[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for SetApprovalForAll%v", _args)
+}
+
+// ERC721TransferFromMock scripts the results of ERC721Mock.TransferFrom.
+type ERC721TransferFromMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnTransferFrom scripts the results of TransferFrom transactions made with the given arguments.
+func (_m *ERC721Mock) OnTransferFrom(from common.Address, to common.Address, tokenId *big.Int) *ERC721TransferFromMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721TransferFromMock{mock: _m, args: []interface{}{from, to, tokenId}}
+	_m.onTransferFrom = append(_m.onTransferFrom, _e)
+	return _e
+}
+
+// Return sets the results of the TransferFrom transactions matching the scripted arguments.
+func (_e *ERC721TransferFromMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// TransferFrom records the transaction and returns the results scripted for its arguments.
+func (_m *ERC721Mock) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{from, to, tokenId}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "TransferFrom", Args: _args})
+	for _i := len(_m.onTransferFrom) - 1; _i >= 0; _i-- {
+		if _e := _m.onTransferFrom[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for TransferFrom%v", _args)
+}
+
+// ERC721TransferOwnershipMock scripts the results of ERC721Mock.TransferOwnership.
+type ERC721TransferOwnershipMock struct {
+	mock *ERC721Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnTransferOwnership scripts the results of TransferOwnership transactions made with the given arguments.
+func (_m *ERC721Mock) OnTransferOwnership(newOwner common.Address) *ERC721TransferOwnershipMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721TransferOwnershipMock{mock: _m, args: []interface{}{newOwner}}
+	_m.onTransferOwnership = append(_m.onTransferOwnership, _e)
+	return _e
+}
+
+// Return sets the results of the TransferOwnership transactions matching the scripted arguments.
+func (_e *ERC721TransferOwnershipMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// TransferOwnership records the transaction and returns the results scripted for its arguments.
+func (_m *ERC721Mock) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	_args := []interface{}{newOwner}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "TransferOwnership", Args: _args})
+	for _i := len(_m.onTransferOwnership) - 1; _i >= 0; _i-- {
+		if _e := _m.onTransferOwnership[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for TransferOwnership%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+// ERC721UnpackApprovalLogMock scripts the results of ERC721Mock.UnpackApprovalLog.
+type ERC721UnpackApprovalLogMock struct {
+	mock  *ERC721Mock
+	args  []interface{}
+	event *ApprovalLog
+	err   error
+}
+
+// OnUnpackApprovalLog scripts the results of unpacking the given log.
+func (_m *ERC721Mock) OnUnpackApprovalLog(log types.Log) *ERC721UnpackApprovalLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721UnpackApprovalLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackApprovalLog = append(_m.onUnpackApprovalLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC721UnpackApprovalLogMock) Return(event *ApprovalLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackApprovalLog records the call and returns the results scripted for the log.
+func (_m *ERC721Mock) UnpackApprovalLog(log types.Log) (*ApprovalLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "UnpackApprovalLog", Args: _args})
+	for _i := len(_m.onUnpackApprovalLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackApprovalLog[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for UnpackApprovalLog")
+}
+
+// ERC721UnpackApprovalForAllLogMock scripts the results of ERC721Mock.UnpackApprovalForAllLog.
+type ERC721UnpackApprovalForAllLogMock struct {
+	mock  *ERC721Mock
+	args  []interface{}
+	event *ApprovalForAllLog
+	err   error
+}
+
+// OnUnpackApprovalForAllLog scripts the results of unpacking the given log.
+func (_m *ERC721Mock) OnUnpackApprovalForAllLog(log types.Log) *ERC721UnpackApprovalForAllLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721UnpackApprovalForAllLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackApprovalForAllLog = append(_m.onUnpackApprovalForAllLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC721UnpackApprovalForAllLogMock) Return(event *ApprovalForAllLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackApprovalForAllLog records the call and returns the results scripted for the log.
+func (_m *ERC721Mock) UnpackApprovalForAllLog(log types.Log) (*ApprovalForAllLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "UnpackApprovalForAllLog", Args: _args})
+	for _i := len(_m.onUnpackApprovalForAllLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackApprovalForAllLog[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for UnpackApprovalForAllLog")
+}
+
+// ERC721UnpackOwnershipTransferredLogMock scripts the results of ERC721Mock.UnpackOwnershipTransferredLog.
+type ERC721UnpackOwnershipTransferredLogMock struct {
+	mock  *ERC721Mock
+	args  []interface{}
+	event *OwnershipTransferredLog
+	err   error
+}
+
+// OnUnpackOwnershipTransferredLog scripts the results of unpacking the given log.
+func (_m *ERC721Mock) OnUnpackOwnershipTransferredLog(log types.Log) *ERC721UnpackOwnershipTransferredLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721UnpackOwnershipTransferredLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackOwnershipTransferredLog = append(_m.onUnpackOwnershipTransferredLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC721UnpackOwnershipTransferredLogMock) Return(event *OwnershipTransferredLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackOwnershipTransferredLog records the call and returns the results scripted for the log.
+func (_m *ERC721Mock) UnpackOwnershipTransferredLog(log types.Log) (*OwnershipTransferredLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "UnpackOwnershipTransferredLog", Args: _args})
+	for _i := len(_m.onUnpackOwnershipTransferredLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackOwnershipTransferredLog[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for UnpackOwnershipTransferredLog")
+}
+
+// ERC721UnpackTransferLogMock scripts the results of ERC721Mock.UnpackTransferLog.
+type ERC721UnpackTransferLogMock struct {
+	mock  *ERC721Mock
+	args  []interface{}
+	event *TransferLog
+	err   error
+}
+
+// OnUnpackTransferLog scripts the results of unpacking the given log.
+func (_m *ERC721Mock) OnUnpackTransferLog(log types.Log) *ERC721UnpackTransferLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &ERC721UnpackTransferLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackTransferLog = append(_m.onUnpackTransferLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *ERC721UnpackTransferLogMock) Return(event *TransferLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackTransferLog records the call and returns the results scripted for the log.
+func (_m *ERC721Mock) UnpackTransferLog(log types.Log) (*TransferLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, ERC721MockCall{Method: "UnpackTransferLog", Args: _args})
+	for _i := len(_m.onUnpackTransferLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackTransferLog[_i]; matchERC721MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("ERC721Mock: no results scripted for UnpackTransferLog")
+}
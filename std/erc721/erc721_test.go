@@ -0,0 +1,119 @@
+package erc721
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evan-forbes/buddy/sim"
+	"github.com/evan-forbes/buddy/std/internal/receiver"
+)
+
+func TestERC721(t *testing.T) {
+	accounts := sim.NewAccounts("alice", "bob", "carol")
+	backend := sim.NewSimulatedBackend(accounts.Genesis(), 10000000)
+	defer backend.Close()
+	alice, bob, carol := accounts["alice"], accounts["bob"], accounts["carol"]
+
+	address, _, token, err := DeployERC721(alice.TxOpts, backend, "Buddy", "BUD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiverAddress, _, hooks, err := receiver.DeployReceiver(alice.TxOpts, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	var revert ERC721Revert
+	if _, err := token.Mint(bob.TxOpts, bob.Address, big.NewInt(1)); !errors.As(err, &revert) || revert.Reason != "Ownable: caller is not the owner" {
+		t.Errorf("minting as bob failed with %v", err)
+	}
+	for id, to := range map[int64]common.Address{1: alice.Address, 2: bob.Address} {
+		if _, err := token.Mint(alice.TxOpts, to, big.NewInt(id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	backend.Commit()
+
+	// carol moves token 1 once approved, which clears the approval
+	if _, err := token.Approve(alice.TxOpts, carol.Address, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if approved, err := token.GetApproved(nil, big.NewInt(1)); err != nil || approved != carol.Address {
+		t.Errorf("approved = %x, %v", approved, err)
+	}
+	if _, err := token.TransferFrom(carol.TxOpts, alice.Address, bob.Address, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if approved, err := token.GetApproved(nil, big.NewInt(1)); err != nil || approved != (common.Address{}) {
+		t.Errorf("approved = %x, %v after a transfer", approved, err)
+	}
+	if _, err := token.TransferFrom(carol.TxOpts, bob.Address, carol.Address, big.NewInt(1)); !errors.As(err, &revert) || revert.Reason != "ERC721: caller is not token owner or approved" {
+		t.Errorf("transferring unapproved failed with %v", err)
+	}
+
+	// safe transfers check contracts implement the receiver hook
+	if _, err := token.SafeTransferFromWithData(bob.TxOpts, bob.Address, address, big.NewInt(1), []byte("buddy")); !errors.As(err, &revert) || revert.Reason != "ERC721: transfer to non ERC721Receiver implementer" {
+		t.Errorf("transferring to a non receiver failed with %v", err)
+	}
+	if _, err := token.SetApprovalForAll(bob.TxOpts, carol.Address, true); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if _, err := token.SafeTransferFromWithData(carol.TxOpts, bob.Address, receiverAddress, big.NewInt(1), []byte("buddy")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := token.SafeTransferFrom(carol.TxOpts, bob.Address, receiverAddress, big.NewInt(2)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	for _, id := range []int64{1, 2} {
+		if owner, err := token.OwnerOf(nil, big.NewInt(id)); err != nil || owner != receiverAddress {
+			t.Errorf("owner of %d = %x, %v", id, owner, err)
+		}
+	}
+	for account, want := range map[common.Address]int64{alice.Address: 0, bob.Address: 0, receiverAddress: 2} {
+		if balance, err := token.BalanceOf(nil, account); err != nil || balance.Int64() != want {
+			t.Errorf("balance of %x = %v, %v, want %d", account, balance, err, want)
+		}
+	}
+
+	// the receiver logged the arguments of both hooks
+	received, err := hooks.FilterERC721Received(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []string{"buddy", ""} {
+		if !received.Next() {
+			t.Fatalf("receiver was called %d times, want 2", i)
+		}
+		hook := received.Event
+		if hook.Operator != carol.Address || hook.From != bob.Address || hook.TokenId.Int64() != int64(i+1) || string(hook.Data) != want {
+			t.Errorf("unexpected hook %+v", hook)
+		}
+	}
+	if _, err := token.OwnerOf(nil, big.NewInt(3)); !errors.As(err, &revert) || revert.Reason != "ERC721: invalid token ID" {
+		t.Errorf("finding the owner of an unminted token failed with %v", err)
+	}
+	transfers, err := token.FilterTransfer(nil, nil, nil, []*big.Int{big.NewInt(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hops int
+	for transfers.Next() {
+		hops++
+	}
+	if hops != 3 {
+		t.Errorf("token 1 was logged moving %d times, want 3", hops)
+	}
+	for id, want := range map[[4]byte]bool{{0x01, 0xff, 0xc9, 0xa7}: true, {0x80, 0xac, 0x58, 0xcd}: true, {0xd9, 0xb6, 0x7a, 0x26}: false} {
+		if supported, err := token.SupportsInterface(nil, id); err != nil || supported != want {
+			t.Errorf("supports %x = %v, %v", id, supported, err)
+		}
+	}
+}
@@ -0,0 +1,1568 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package receiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = context.Background
+	_ = errors.New
+	_ = json.Marshal
+	_ = fmt.Errorf
+	_ = big.NewInt
+	_ = reflect.ValueOf
+	_ = strings.NewReader
+	_ = sync.NewCond
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = hexutil.Encode
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// Receiver is a wrapper around BoundContract, enforcing type checking and including
+// QoL helper methods
+//
+// Title: Receiver
+//
+// Accepts every ERC721 and ERC1155 token, logging the arguments of the hooks it is called with. Used by the tests of the std bindings.
+type Receiver struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+	abi      abi.ABI             // Parsed ABI, used to replay failed transactions
+	address  common.Address      // Address the contract is deployed at
+	backend  bind.ContractBackend
+}
+
+// NewReceiver creates a new instance of Receiver, bound to a specific deployed contract.
+func NewReceiver(address common.Address, backend bind.ContractBackend) (*Receiver, error) {
+	a, err := parseReceiverABI()
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(address, a, backend, backend, backend)
+	return &Receiver{contract: contract, abi: a, address: address, backend: backend}, nil
+}
+
+// parseReceiverABI parses ReceiverABI, keying overloaded methods by their full
+// signature so that every binding calls the exact overload it was generated for.
+func parseReceiverABI() (abi.ABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(ReceiverABI))
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	overloads := make(map[string]int)
+	for _, method := range parsed.Methods {
+		overloads[method.RawName]++
+	}
+	methods := make(map[string]abi.Method, len(parsed.Methods))
+	for name, method := range parsed.Methods {
+		if overloads[method.RawName] > 1 {
+			name = method.Sig()
+		}
+		methods[name] = method
+	}
+	parsed.Methods = methods
+	return parsed, nil
+}
+
+// bound exposes the underlying BoundContract used for the low level calls
+func (_Receiver *Receiver) bound() *bind.BoundContract {
+	return _Receiver.contract
+}
+
+// transact invokes method. Should that fail while estimating gas, the invocation
+// is replayed as a call to recover the revert data behind the failure.
+func (_Receiver *Receiver) transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	tx, err := _Receiver.contract.Transact(opts, method, params...)
+	if err == nil || opts.GasLimit != 0 {
+		return tx, err
+	}
+	msg, perr := _Receiver.message(opts, method, params...)
+	if perr != nil {
+		return nil, err
+	}
+	if _, cerr := _Receiver.backend.CallContract(contextOfReceiver(opts), msg, nil); cerr != nil {
+		if decoded := unpackReceiverError(cerr); decoded != cerr {
+			return nil, decoded
+		}
+	}
+	return nil, err
+}
+
+// estimate estimates the gas needed to invoke method with the given options.
+func (_Receiver *Receiver) estimate(opts *bind.TransactOpts, method string, params ...interface{}) (uint64, error) {
+	msg, err := _Receiver.message(opts, method, params...)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := _Receiver.backend.EstimateGas(contextOfReceiver(opts), msg)
+	return gas, unpackReceiverError(err)
+}
+
+// simulate runs method as an eth_call from opts.From against the pending state,
+// unpacking its outputs into out, if any.
+func (_Receiver *Receiver) simulate(opts *bind.TransactOpts, out interface{}, method string, params ...interface{}) error {
+	msg, err := _Receiver.message(opts, method, params...)
+	if err != nil {
+		return err
+	}
+	var output []byte
+	if pending, ok := _Receiver.backend.(bind.PendingContractCaller); ok {
+		output, err = pending.PendingCallContract(contextOfReceiver(opts), msg)
+	} else {
+		output, err = _Receiver.backend.CallContract(contextOfReceiver(opts), msg, nil)
+	}
+	if err != nil {
+		return unpackReceiverError(err)
+	}
+	if out == nil {
+		return nil
+	}
+	return _Receiver.abi.Unpack(out, method, output)
+}
+
+// message packs an invocation of method into a call message sent with opts.
+func (_Receiver *Receiver) message(opts *bind.TransactOpts, method string, params ...interface{}) (ethereum.CallMsg, error) {
+	if opts == nil {
+		return ethereum.CallMsg{}, errors.New("no transact options to invoke Receiver." + method + " with")
+	}
+	input, err := _Receiver.abi.Pack(method, params...)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	return ethereum.CallMsg{
+		From:     opts.From,
+		To:       &_Receiver.address,
+		Gas:      opts.GasLimit,
+		GasPrice: opts.GasPrice,
+		Value:    opts.Value,
+		Data:     input,
+	}, nil
+}
+
+// contextOfReceiver returns the context of opts, defaulting to the background one.
+func contextOfReceiver(opts *bind.TransactOpts) context.Context {
+	if opts.Context == nil {
+		return context.Background()
+	}
+	return opts.Context
+}
+
+//////////////////////////////////////////////////////
+//		Interface
+////////////////////////////////////////////////////
+
+// ReceiverInterface lists every call, transaction and log unpacker of Receiver.
+// It is regenerated along with the binding, so it can't drift from the ABI.
+type ReceiverInterface interface {
+	SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error)
+
+	OnERC1155BatchReceived(opts *bind.TransactOpts, operator common.Address, from common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error)
+	OnERC1155Received(opts *bind.TransactOpts, operator common.Address, from common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error)
+	OnERC721Received(opts *bind.TransactOpts, operator common.Address, from common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error)
+
+	UnpackERC1155BatchReceivedLog(log types.Log) (*ERC1155BatchReceivedLog, error)
+	UnpackERC1155ReceivedLog(log types.Log) (*ERC1155ReceivedLog, error)
+	UnpackERC721ReceivedLog(log types.Log) (*ERC721ReceivedLog, error)
+}
+
+// This nil assignment ensures at compile time that Receiver implements ReceiverInterface.
+var _ ReceiverInterface = (*Receiver)(nil)
+
+//////////////////////////////////////////////////////
+//		Deployment
+////////////////////////////////////////////////////
+
+// DeployReceiver deploys a new Ethereum contract, binding an instance of Receiver to it.
+func DeployReceiver(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Receiver, error) {
+	parsed, err := parseReceiverABI()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(ReceiverBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Receiver{contract: contract, abi: parsed, address: address, backend: backend}, nil
+}
+
+// ErrReceiverCodeMismatch is returned by NewReceiverVerified when the code deployed
+// at an address isn't the one of Receiver.
+var ErrReceiverCodeMismatch = errors.New("code does not match ReceiverBin")
+
+// NewReceiverVerified binds an instance of Receiver, after checking that the code
+// deployed at address is the runtime code of ReceiverBin.
+func NewReceiverVerified(ctx context.Context, address common.Address, backend bind.ContractBackend) (*Receiver, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w: %s", bind.ErrNoCode, address.Hex())
+	}
+	if !matchReceiverCode(code) {
+		return nil, fmt.Errorf("%w: %s", ErrReceiverCodeMismatch, address.Hex())
+	}
+	return NewReceiver(address, backend)
+}
+
+// matchReceiverCode reports whether code is the runtime code embedded in ReceiverBin.
+// The metadata hash is ignored, as are the zeroed regions the bytecode reserves
+// for immutables and linked library addresses.
+func matchReceiverCode(code []byte) bool {
+	template := ReceiverBin
+
+	bin := common.FromHex(template)
+
+	// Strip the CBOR encoded metadata, its length being in the last two bytes
+	if n := len(code); n > 2 {
+		if meta := int(code[n-2])<<8 | int(code[n-1]); meta+2 < n && code[n-2-meta]&0xe0 == 0xa0 {
+			code = code[:n-2-meta]
+		}
+	}
+	// Mask the runs of zeros long enough to hold an address or an immutable
+	masked := make([]bool, len(bin))
+	for start := 0; start < len(bin); {
+		end := start
+		for end < len(bin) && bin[end] == 0 {
+			end++
+		}
+		if end-start >= common.AddressLength {
+			for i := start; i < end; i++ {
+				masked[i] = true
+			}
+		}
+		start = end + 1
+	}
+	// Look for the runtime code within the creation code
+	for offset := 0; len(code) > 0 && offset+len(code) <= len(bin); offset++ {
+		matched := true
+		for i := range code {
+			if code[i] != bin[offset+i] && !masked[offset+i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+// - Solidity: function supportsInterface(bytes4 interfaceId) constant returns(bool)
+//
+// Returns true if this contract implements the interface defined by interfaceId.
+func (_Receiver *Receiver) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _Receiver.bound().Call(opts, out, "supportsInterface", interfaceId)
+	return *ret0, unpackReceiverError(err)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// OnERC1155BatchReceived is a paid mutator transaction binding the contract method 0xbc197c81.
+// - Solidity: function onERC1155BatchReceived(address operator, address from, uint256[] ids, uint256[] values, bytes data) returns(bytes4)
+//
+// Returns its own selector to accept the tokens.
+func (_Receiver *Receiver) OnERC1155BatchReceived(opts *bind.TransactOpts, operator common.Address, from common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error) {
+	return _Receiver.transact(opts, "onERC1155BatchReceived", operator, from, ids, values, data)
+}
+
+// EstimateOnERC1155BatchReceived estimates the gas needed to invoke the contract method 0xbc197c81.
+func (_Receiver *Receiver) EstimateOnERC1155BatchReceived(opts *bind.TransactOpts, operator common.Address, from common.Address, ids []*big.Int, values []*big.Int, data []byte) (uint64, error) {
+	return _Receiver.estimate(opts, "onERC1155BatchReceived", operator, from, ids, values, data)
+}
+
+// SimulateOnERC1155BatchReceived runs the contract method 0xbc197c81 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_Receiver *Receiver) SimulateOnERC1155BatchReceived(opts *bind.TransactOpts, operator common.Address, from common.Address, ids []*big.Int, values []*big.Int, data []byte) ([4]byte, error) {
+	var (
+		ret0 = new([4]byte)
+	)
+	out := ret0
+	err := _Receiver.simulate(opts, out, "onERC1155BatchReceived", operator, from, ids, values, data)
+	return *ret0, err
+}
+
+// OnERC1155Received is a paid mutator transaction binding the contract method 0xf23a6e61.
+// - Solidity: function onERC1155Received(address operator, address from, uint256 id, uint256 value, bytes data) returns(bytes4)
+//
+// Returns its own selector to accept the tokens.
+func (_Receiver *Receiver) OnERC1155Received(opts *bind.TransactOpts, operator common.Address, from common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _Receiver.transact(opts, "onERC1155Received", operator, from, id, value, data)
+}
+
+// EstimateOnERC1155Received estimates the gas needed to invoke the contract method 0xf23a6e61.
+func (_Receiver *Receiver) EstimateOnERC1155Received(opts *bind.TransactOpts, operator common.Address, from common.Address, id *big.Int, value *big.Int, data []byte) (uint64, error) {
+	return _Receiver.estimate(opts, "onERC1155Received", operator, from, id, value, data)
+}
+
+// SimulateOnERC1155Received runs the contract method 0xf23a6e61 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_Receiver *Receiver) SimulateOnERC1155Received(opts *bind.TransactOpts, operator common.Address, from common.Address, id *big.Int, value *big.Int, data []byte) ([4]byte, error) {
+	var (
+		ret0 = new([4]byte)
+	)
+	out := ret0
+	err := _Receiver.simulate(opts, out, "onERC1155Received", operator, from, id, value, data)
+	return *ret0, err
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+// - Solidity: function onERC721Received(address operator, address from, uint256 tokenId, bytes data) returns(bytes4)
+//
+// Returns its own selector to accept the token.
+func (_Receiver *Receiver) OnERC721Received(opts *bind.TransactOpts, operator common.Address, from common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _Receiver.transact(opts, "onERC721Received", operator, from, tokenId, data)
+}
+
+// EstimateOnERC721Received estimates the gas needed to invoke the contract method 0x150b7a02.
+func (_Receiver *Receiver) EstimateOnERC721Received(opts *bind.TransactOpts, operator common.Address, from common.Address, tokenId *big.Int, data []byte) (uint64, error) {
+	return _Receiver.estimate(opts, "onERC721Received", operator, from, tokenId, data)
+}
+
+// SimulateOnERC721Received runs the contract method 0x150b7a02 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_Receiver *Receiver) SimulateOnERC721Received(opts *bind.TransactOpts, operator common.Address, from common.Address, tokenId *big.Int, data []byte) ([4]byte, error) {
+	var (
+		ret0 = new([4]byte)
+	)
+	out := ret0
+	err := _Receiver.simulate(opts, out, "onERC721Received", operator, from, tokenId, data)
+	return *ret0, err
+}
+
+//////////////////////////////////////////////////////
+//		Sessions
+////////////////////////////////////////////////////
+
+// ReceiverSession binds Receiver to default call and transact options, exposing
+// every method without the opts argument.
+type ReceiverSession struct {
+	Contract     *Receiver         // Contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ReceiverCallerSession binds Receiver to default call options, exposing every
+// call without the opts argument.
+type ReceiverCallerSession struct {
+	Contract *Receiver     // Contract binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// ReceiverTransactorSession binds Receiver to default transact options, exposing
+// every transaction without the opts argument.
+type ReceiverTransactorSession struct {
+	Contract     *Receiver         // Contract binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NewReceiverSession opens a session on contract, sending transactions with auth
+// and making calls from the same account.
+func NewReceiverSession(contract *Receiver, auth *bind.TransactOpts) *ReceiverSession {
+	return &ReceiverSession{
+		Contract:     contract,
+		CallOpts:     bind.CallOpts{From: auth.From, Context: auth.Context},
+		TransactOpts: *auth,
+	}
+}
+
+// ReceiverSessionInterface is the opts-free counterpart of ReceiverInterface.
+type ReceiverSessionInterface interface {
+	SupportsInterface(interfaceId [4]byte) (bool, error)
+
+	OnERC1155BatchReceived(operator common.Address, from common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error)
+	OnERC1155Received(operator common.Address, from common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error)
+	OnERC721Received(operator common.Address, from common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error)
+}
+
+// This nil assignment ensures at compile time that ReceiverSession implements ReceiverSessionInterface.
+var _ ReceiverSessionInterface = (*ReceiverSession)(nil)
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+func (_Receiver *ReceiverSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _Receiver.Contract.SupportsInterface(&_Receiver.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+func (_Receiver *ReceiverCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _Receiver.Contract.SupportsInterface(&_Receiver.CallOpts, interfaceId)
+}
+
+// OnERC1155BatchReceived is a paid mutator transaction binding the contract method 0xbc197c81.
+func (_Receiver *ReceiverSession) OnERC1155BatchReceived(operator common.Address, from common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error) {
+	return _Receiver.Contract.OnERC1155BatchReceived(&_Receiver.TransactOpts, operator, from, ids, values, data)
+}
+
+// OnERC1155BatchReceived is a paid mutator transaction binding the contract method 0xbc197c81.
+func (_Receiver *ReceiverTransactorSession) OnERC1155BatchReceived(operator common.Address, from common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error) {
+	return _Receiver.Contract.OnERC1155BatchReceived(&_Receiver.TransactOpts, operator, from, ids, values, data)
+}
+
+// OnERC1155Received is a paid mutator transaction binding the contract method 0xf23a6e61.
+func (_Receiver *ReceiverSession) OnERC1155Received(operator common.Address, from common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _Receiver.Contract.OnERC1155Received(&_Receiver.TransactOpts, operator, from, id, value, data)
+}
+
+// OnERC1155Received is a paid mutator transaction binding the contract method 0xf23a6e61.
+func (_Receiver *ReceiverTransactorSession) OnERC1155Received(operator common.Address, from common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _Receiver.Contract.OnERC1155Received(&_Receiver.TransactOpts, operator, from, id, value, data)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+func (_Receiver *ReceiverSession) OnERC721Received(operator common.Address, from common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _Receiver.Contract.OnERC721Received(&_Receiver.TransactOpts, operator, from, tokenId, data)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+func (_Receiver *ReceiverTransactorSession) OnERC721Received(operator common.Address, from common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _Receiver.Contract.OnERC721Received(&_Receiver.TransactOpts, operator, from, tokenId, data)
+}
+
+//////////////////////////////////////////////////////
+//		Calldata
+////////////////////////////////////////////////////
+
+var (
+	parsedReceiverABIOnce sync.Once
+	parsedReceiverABI     abi.ABI
+	parsedReceiverABIErr  error
+)
+
+// loadReceiverABI parses ReceiverABI once, sharing it between the calldata helpers.
+func loadReceiverABI() (abi.ABI, error) {
+	parsedReceiverABIOnce.Do(func() {
+		parsedReceiverABI, parsedReceiverABIErr = parseReceiverABI()
+	})
+	return parsedReceiverABI, parsedReceiverABIErr
+}
+
+// unnamedReceiverArgs names unnamed arguments the way the input structs do, so
+// they can be unpacked into them.
+func unnamedReceiverArgs(args abi.Arguments) abi.Arguments {
+	named := make(abi.Arguments, len(args))
+	for i, arg := range args {
+		if arg.Name == "" {
+			arg.Name = fmt.Sprintf("arg%d", i)
+		}
+		named[i] = arg
+	}
+	return named
+}
+
+// ReceiverSupportsInterfaceInput holds the arguments of a Receiver.SupportsInterface invocation.
+type ReceiverSupportsInterfaceInput struct {
+	InterfaceId [4]byte
+}
+
+// PackReceiverSupportsInterface packs the calldata invoking the contract method 0x01ffc9a7.
+func PackReceiverSupportsInterface(interfaceId [4]byte) ([]byte, error) {
+	parsed, err := loadReceiverABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("supportsInterface", interfaceId)
+}
+
+// UnpackReceiverSupportsInterfaceInput unpacks the calldata of a transaction invoking the
+// contract method 0x01ffc9a7, selector included.
+func UnpackReceiverSupportsInterfaceInput(data []byte) (*ReceiverSupportsInterfaceInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "01ffc9a7" {
+		return nil, errors.New("calldata does not invoke supportsInterface(bytes4)")
+	}
+	input := new(ReceiverSupportsInterfaceInput)
+	parsed, err := loadReceiverABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedReceiverArgs(parsed.Methods["supportsInterface"].Inputs)
+	if err := args.Unpack(&input.InterfaceId, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackReceiverSupportsInterfaceOutput unpacks the data returned by the contract method 0x01ffc9a7,
+// as Receiver.SupportsInterface returns it.
+func UnpackReceiverSupportsInterfaceOutput(data []byte) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	parsed, err := loadReceiverABI()
+	if err == nil {
+		err = parsed.Unpack(out, "supportsInterface", data)
+	}
+	return *ret0, err
+}
+
+// ReceiverOnERC1155BatchReceivedInput holds the arguments of a Receiver.OnERC1155BatchReceived invocation.
+type ReceiverOnERC1155BatchReceivedInput struct {
+	Operator common.Address
+	From     common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+	Data     []byte
+}
+
+// PackReceiverOnERC1155BatchReceived packs the calldata invoking the contract method 0xbc197c81.
+func PackReceiverOnERC1155BatchReceived(operator common.Address, from common.Address, ids []*big.Int, values []*big.Int, data []byte) ([]byte, error) {
+	parsed, err := loadReceiverABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("onERC1155BatchReceived", operator, from, ids, values, data)
+}
+
+// UnpackReceiverOnERC1155BatchReceivedInput unpacks the calldata of a transaction invoking the
+// contract method 0xbc197c81, selector included.
+func UnpackReceiverOnERC1155BatchReceivedInput(data []byte) (*ReceiverOnERC1155BatchReceivedInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "bc197c81" {
+		return nil, errors.New("calldata does not invoke onERC1155BatchReceived(address,address,uint256[],uint256[],bytes)")
+	}
+	input := new(ReceiverOnERC1155BatchReceivedInput)
+	parsed, err := loadReceiverABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedReceiverArgs(parsed.Methods["onERC1155BatchReceived"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ReceiverOnERC1155ReceivedInput holds the arguments of a Receiver.OnERC1155Received invocation.
+type ReceiverOnERC1155ReceivedInput struct {
+	Operator common.Address
+	From     common.Address
+	Id       *big.Int
+	Value    *big.Int
+	Data     []byte
+}
+
+// PackReceiverOnERC1155Received packs the calldata invoking the contract method 0xf23a6e61.
+func PackReceiverOnERC1155Received(operator common.Address, from common.Address, id *big.Int, value *big.Int, data []byte) ([]byte, error) {
+	parsed, err := loadReceiverABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("onERC1155Received", operator, from, id, value, data)
+}
+
+// UnpackReceiverOnERC1155ReceivedInput unpacks the calldata of a transaction invoking the
+// contract method 0xf23a6e61, selector included.
+func UnpackReceiverOnERC1155ReceivedInput(data []byte) (*ReceiverOnERC1155ReceivedInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "f23a6e61" {
+		return nil, errors.New("calldata does not invoke onERC1155Received(address,address,uint256,uint256,bytes)")
+	}
+	input := new(ReceiverOnERC1155ReceivedInput)
+	parsed, err := loadReceiverABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedReceiverArgs(parsed.Methods["onERC1155Received"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ReceiverOnERC721ReceivedInput holds the arguments of a Receiver.OnERC721Received invocation.
+type ReceiverOnERC721ReceivedInput struct {
+	Operator common.Address
+	From     common.Address
+	TokenId  *big.Int
+	Data     []byte
+}
+
+// PackReceiverOnERC721Received packs the calldata invoking the contract method 0x150b7a02.
+func PackReceiverOnERC721Received(operator common.Address, from common.Address, tokenId *big.Int, data []byte) ([]byte, error) {
+	parsed, err := loadReceiverABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("onERC721Received", operator, from, tokenId, data)
+}
+
+// UnpackReceiverOnERC721ReceivedInput unpacks the calldata of a transaction invoking the
+// contract method 0x150b7a02, selector included.
+func UnpackReceiverOnERC721ReceivedInput(data []byte) (*ReceiverOnERC721ReceivedInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "150b7a02" {
+		return nil, errors.New("calldata does not invoke onERC721Received(address,address,uint256,bytes)")
+	}
+	input := new(ReceiverOnERC721ReceivedInput)
+	parsed, err := loadReceiverABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedReceiverArgs(parsed.Methods["onERC721Received"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ErrReceiverUnknownMethod is returned by DecodeReceiverCall for calldata that doesn't invoke a Receiver method.
+var ErrReceiverUnknownMethod = errors.New("unknown Receiver method")
+
+// DecodeReceiverCall identifies the method invoked by calldata through its 4-byte
+// selector, returning the matching *MethodInput holding its arguments.
+func DecodeReceiverCall(data []byte) (interface{}, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("%w: calldata too short", ErrReceiverUnknownMethod)
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "01ffc9a7":
+		input, err := UnpackReceiverSupportsInterfaceInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "bc197c81":
+		input, err := UnpackReceiverOnERC1155BatchReceivedInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "f23a6e61":
+		input, err := UnpackReceiverOnERC1155ReceivedInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "150b7a02":
+		input, err := UnpackReceiverOnERC721ReceivedInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	}
+	return nil, fmt.Errorf("%w: selector %x", ErrReceiverUnknownMethod, data[:4])
+}
+
+//////////////////////////////////////////////////////
+//		Errors
+////////////////////////////////////////////////////
+
+// ReceiverRevert is a revert with a reason string, as raised by require and revert.
+type ReceiverRevert struct {
+	Reason string
+}
+
+func (e ReceiverRevert) Error() string {
+	return "Receiver: execution reverted: " + e.Reason
+}
+
+// ReceiverPanic is raised by failed assertions, arithmetic overflows and the like.
+type ReceiverPanic struct {
+	Code *big.Int
+}
+
+func (e ReceiverPanic) Error() string {
+	return fmt.Sprintf("Receiver: panic code %#x", e.Code)
+}
+
+// ReceiverErrorsABI describes the errors Receiver can revert with, each as a function
+// returning the error arguments.
+const ReceiverErrorsABI = "[{\"type\":\"function\",\"name\":\"Error\",\"outputs\":[{\"name\":\"reason\",\"type\":\"string\"}]},{\"type\":\"function\",\"name\":\"Panic\",\"outputs\":[{\"name\":\"code\",\"type\":\"uint256\"}]}]"
+
+// DecodeReceiverRevert decodes revert data into a ReceiverRevert, a ReceiverPanic
+// or one of the custom errors of Receiver. It returns nil for unknown data.
+func DecodeReceiverRevert(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(ReceiverErrorsABI))
+	if err != nil {
+		return nil
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "08c379a0":
+		var e ReceiverRevert
+		if parsed.Unpack(&e, "Error", data[4:]) == nil {
+			return e
+		}
+	case "4e487b71":
+		var e ReceiverPanic
+		if parsed.Unpack(&e, "Panic", data[4:]) == nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// unpackReceiverError replaces an error carrying revert data with the typed error
+// decoded from it, leaving any other error untouched. Only errors exposing the
+// data through ErrorData, like sim.RevertError, carry it; RPC errors don't.
+func unpackReceiverError(err error) error {
+	var reverted interface{ ErrorData() interface{} }
+	if !errors.As(err, &reverted) {
+		return err
+	}
+	var data []byte
+	switch d := reverted.ErrorData().(type) {
+	case string:
+		data = common.FromHex(d)
+	case []byte:
+		data = d
+	}
+	if decoded := DecodeReceiverRevert(data); decoded != nil {
+		return decoded
+	}
+	return err
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+// logReceiverJSON is the JSON encoding shared by the events of Receiver.
+type logReceiverJSON struct {
+	Event       string          `json:"event"`
+	Contract    string          `json:"contract"`
+	Fields      json.RawMessage `json:"fields"`
+	Address     common.Address  `json:"address"`
+	Topics      []common.Hash   `json:"topics"`
+	Data        hexutil.Bytes   `json:"data"`
+	BlockNumber uint64          `json:"blockNumber"`
+	BlockHash   common.Hash     `json:"blockHash"`
+	TxHash      common.Hash     `json:"transactionHash"`
+	TxIndex     uint            `json:"transactionIndex"`
+	LogIndex    uint            `json:"logIndex"`
+	Removed     bool            `json:"removed"`
+}
+
+// marshalLogReceiver encodes the decoded fields of an event along with its log.
+func marshalLogReceiver(name string, fields interface{}, log types.Log) ([]byte, error) {
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(logReceiverJSON{
+		Event:       name,
+		Contract:    "Receiver",
+		Fields:      encoded,
+		Address:     log.Address,
+		Topics:      log.Topics,
+		Data:        log.Data,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Removed:     log.Removed,
+	})
+}
+
+// encodeJSONReceiver encodes an event field as JSON, writing big numbers as
+// decimal strings and byte arrays as hex down through slices, arrays and
+// structs. Struct fields are keyed by their json tag.
+func encodeJSONReceiver(v reflect.Value) (json.RawMessage, error) {
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		if value == nil {
+			return json.RawMessage("null"), nil
+		}
+		return json.Marshal(value.String())
+	case []byte:
+		return json.Marshal(hexutil.Bytes(value))
+	case common.Address, common.Hash:
+		return json.Marshal(value)
+	}
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		if v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8 {
+			encoded := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(encoded), v)
+			return json.Marshal(hexutil.Bytes(encoded))
+		}
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+		items := make([]json.RawMessage, v.Len())
+		for i := range items {
+			item, err := encodeJSONReceiver(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return json.Marshal(items)
+	case reflect.Struct:
+		encoded := []byte{'{'}
+		for i := 0; i < v.NumField(); i++ {
+			key, err := json.Marshal(jsonKeyReceiver(v.Type().Field(i)))
+			if err != nil {
+				return nil, err
+			}
+			value, err := encodeJSONReceiver(v.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			if i > 0 {
+				encoded = append(encoded, ',')
+			}
+			encoded = append(append(append(encoded, key...), ':'), value...)
+		}
+		return append(encoded, '}'), nil
+	}
+	return json.Marshal(v.Interface())
+}
+
+// decodeJSONReceiver decodes an event field encoded by encodeJSONReceiver into v.
+func decodeJSONReceiver(input json.RawMessage, v reflect.Value) error {
+	switch v.Interface().(type) {
+	case *big.Int:
+		var dec *string
+		if err := json.Unmarshal(input, &dec); err != nil {
+			return err
+		}
+		if dec == nil {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		value, ok := new(big.Int).SetString(*dec, 10)
+		if !ok {
+			return fmt.Errorf("invalid number %q", *dec)
+		}
+		v.Set(reflect.ValueOf(value))
+		return nil
+	case []byte:
+		var dec hexutil.Bytes
+		if err := json.Unmarshal(input, &dec); err != nil {
+			return err
+		}
+		v.SetBytes(dec)
+		return nil
+	case common.Address, common.Hash:
+		return json.Unmarshal(input, v.Addr().Interface())
+	}
+	switch v.Kind() {
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			var dec hexutil.Bytes
+			if err := json.Unmarshal(input, &dec); err != nil {
+				return err
+			}
+			if len(dec) != v.Len() {
+				return fmt.Errorf("invalid length %d, want %d", len(dec), v.Len())
+			}
+			reflect.Copy(v, reflect.ValueOf([]byte(dec)))
+			return nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(input, &items); err != nil {
+			return err
+		}
+		if len(items) != v.Len() {
+			return fmt.Errorf("invalid length %d, want %d", len(items), v.Len())
+		}
+		for i, item := range items {
+			if err := decodeJSONReceiver(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		var items []json.RawMessage
+		if err := json.Unmarshal(input, &items); err != nil {
+			return err
+		}
+		if items == nil {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		v.Set(reflect.MakeSlice(v.Type(), len(items), len(items)))
+		for i, item := range items {
+			if err := decodeJSONReceiver(item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(input, &fields); err != nil {
+			return err
+		}
+		for i := 0; i < v.NumField(); i++ {
+			key := jsonKeyReceiver(v.Type().Field(i))
+			if field, ok := fields[key]; ok {
+				if err := decodeJSONReceiver(field, v.Field(i)); err != nil {
+					return fmt.Errorf("invalid %s: %v", key, err)
+				}
+			}
+		}
+		return nil
+	}
+	return json.Unmarshal(input, v.Addr().Interface())
+}
+
+// jsonKeyReceiver returns the JSON key of a struct field.
+func jsonKeyReceiver(field reflect.StructField) string {
+	if key := field.Tag.Get("json"); key != "" {
+		return key
+	}
+	return field.Name
+}
+
+// unmarshalLogReceiver decodes the JSON encoding of an event, checking its name
+// and returning the raw log.
+func unmarshalLogReceiver(input []byte, name string, fields interface{}) (types.Log, error) {
+	var dec logReceiverJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return types.Log{}, err
+	}
+	if dec.Event != name || dec.Contract != "Receiver" {
+		return types.Log{}, fmt.Errorf("cannot unmarshal %s.%s into a Receiver.%s event", dec.Contract, dec.Event, name)
+	}
+	if err := json.Unmarshal(dec.Fields, fields); err != nil {
+		return types.Log{}, err
+	}
+	return types.Log{
+		Address:     dec.Address,
+		Topics:      dec.Topics,
+		Data:        dec.Data,
+		BlockNumber: dec.BlockNumber,
+		BlockHash:   dec.BlockHash,
+		TxHash:      dec.TxHash,
+		TxIndex:     dec.TxIndex,
+		Index:       dec.LogIndex,
+		Removed:     dec.Removed,
+	}, nil
+}
+
+//////// ERC1155BatchReceived ////////
+
+// ERC1155BatchReceivedID is the hex of the Topic Hash
+const ReceiverERC1155BatchReceivedID = "0x2360e6b28d144bcde27ca99baa660f822a59e919f243c01dfaec5a55986df5f5"
+
+// ERC1155BatchReceivedLog represents a ERC1155BatchReceived event raised by the Receiver contract.
+type ERC1155BatchReceivedLog struct {
+	Operator common.Address
+	From     common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+	Data     []byte
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e ERC1155BatchReceivedLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Operator common.Address  `json:"operator"`
+		From     common.Address  `json:"from"`
+		Ids      json.RawMessage `json:"ids"`
+		Values   json.RawMessage `json:"values"`
+		Data     hexutil.Bytes   `json:"data"`
+	}
+	fields.Operator = e.Operator
+	fields.From = e.From
+	encodedIds, err := encodeJSONReceiver(reflect.ValueOf(e.Ids))
+	if err != nil {
+		return nil, err
+	}
+	fields.Ids = encodedIds
+	encodedValues, err := encodeJSONReceiver(reflect.ValueOf(e.Values))
+	if err != nil {
+		return nil, err
+	}
+	fields.Values = encodedValues
+	fields.Data = e.Data
+	return marshalLogReceiver("ERC1155BatchReceived", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *ERC1155BatchReceivedLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Operator common.Address  `json:"operator"`
+		From     common.Address  `json:"from"`
+		Ids      json.RawMessage `json:"ids"`
+		Values   json.RawMessage `json:"values"`
+		Data     hexutil.Bytes   `json:"data"`
+	}
+	raw, err := unmarshalLogReceiver(input, "ERC1155BatchReceived", &fields)
+	if err != nil {
+		return err
+	}
+	e.Operator = fields.Operator
+	e.From = fields.From
+	if len(fields.Ids) > 0 {
+		if err := decodeJSONReceiver(fields.Ids, reflect.ValueOf(&e.Ids).Elem()); err != nil {
+			return fmt.Errorf("invalid ids: %v", err)
+		}
+	}
+	if len(fields.Values) > 0 {
+		if err := decodeJSONReceiver(fields.Values, reflect.ValueOf(&e.Values).Elem()); err != nil {
+			return fmt.Errorf("invalid values: %v", err)
+		}
+	}
+	e.Data = fields.Data
+	e.Raw = raw
+	return nil
+}
+
+// UnpackERC1155BatchReceivedLog is a log parse operation binding the contract event 0x2360e6b28d144bcde27ca99baa660f822a59e919f243c01dfaec5a55986df5f5
+// Solidity: event ERC1155BatchReceived(address operator, address from, uint256[] ids, uint256[] values, bytes data)
+func (_Receiver *Receiver) UnpackERC1155BatchReceivedLog(log types.Log) (*ERC1155BatchReceivedLog, error) {
+	event := new(ERC1155BatchReceivedLog)
+	if err := _Receiver.bound().UnpackLog(event, "ERC1155BatchReceived", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC1155BatchReceivedLogIterator is returned from FilterERC1155BatchReceived and is used to iterate over
+// the raw logs and unpacked data for ERC1155BatchReceived events raised by the Receiver contract.
+type ERC1155BatchReceivedLogIterator struct {
+	Event *ERC1155BatchReceivedLog // Event containing the contract specifics and raw log
+
+	contract *Receiver             // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC1155BatchReceivedLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackERC1155BatchReceivedLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackERC1155BatchReceivedLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC1155BatchReceivedLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC1155BatchReceivedLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterERC1155BatchReceived is a free log retrieval operation binding the contract event 0x2360e6b28d144bcde27ca99baa660f822a59e919f243c01dfaec5a55986df5f5.
+// Solidity: event ERC1155BatchReceived(address operator, address from, uint256[] ids, uint256[] values, bytes data)
+func (_Receiver *Receiver) FilterERC1155BatchReceived(opts *bind.FilterOpts) (*ERC1155BatchReceivedLogIterator, error) {
+
+	logs, sub, err := _Receiver.bound().FilterLogs(opts, "ERC1155BatchReceived")
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155BatchReceivedLogIterator{contract: _Receiver, logs: logs, sub: sub}, nil
+}
+
+// WatchERC1155BatchReceived is a free log subscription operation binding the contract event 0x2360e6b28d144bcde27ca99baa660f822a59e919f243c01dfaec5a55986df5f5.
+// Solidity: event ERC1155BatchReceived(address operator, address from, uint256[] ids, uint256[] values, bytes data)
+func (_Receiver *Receiver) WatchERC1155BatchReceived(opts *bind.WatchOpts, sink chan<- *ERC1155BatchReceivedLog) (event.Subscription, error) {
+
+	logs, sub, err := _Receiver.bound().WatchLogs(opts, "ERC1155BatchReceived")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _Receiver.UnpackERC1155BatchReceivedLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// ERC1155Received ////////
+
+// ERC1155ReceivedID is the hex of the Topic Hash
+const ReceiverERC1155ReceivedID = "0x01109c91bca177ae1324bc2fc89be4b2573d2e59ad0cc75b1f3d3aa807814d36"
+
+// ERC1155ReceivedLog represents a ERC1155Received event raised by the Receiver contract.
+type ERC1155ReceivedLog struct {
+	Operator common.Address
+	From     common.Address
+	Id       *big.Int
+	Value    *big.Int
+	Data     []byte
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e ERC1155ReceivedLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Operator common.Address `json:"operator"`
+		From     common.Address `json:"from"`
+		Id       string         `json:"id"`
+		Value    string         `json:"value"`
+		Data     hexutil.Bytes  `json:"data"`
+	}
+	fields.Operator = e.Operator
+	fields.From = e.From
+	if e.Id != nil {
+		fields.Id = e.Id.String()
+	}
+	if e.Value != nil {
+		fields.Value = e.Value.String()
+	}
+	fields.Data = e.Data
+	return marshalLogReceiver("ERC1155Received", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *ERC1155ReceivedLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Operator common.Address `json:"operator"`
+		From     common.Address `json:"from"`
+		Id       string         `json:"id"`
+		Value    string         `json:"value"`
+		Data     hexutil.Bytes  `json:"data"`
+	}
+	raw, err := unmarshalLogReceiver(input, "ERC1155Received", &fields)
+	if err != nil {
+		return err
+	}
+	e.Operator = fields.Operator
+	e.From = fields.From
+	if fields.Id != "" {
+		value, ok := new(big.Int).SetString(fields.Id, 10)
+		if !ok {
+			return fmt.Errorf("invalid id %q", fields.Id)
+		}
+		e.Id = value
+	}
+	if fields.Value != "" {
+		value, ok := new(big.Int).SetString(fields.Value, 10)
+		if !ok {
+			return fmt.Errorf("invalid value %q", fields.Value)
+		}
+		e.Value = value
+	}
+	e.Data = fields.Data
+	e.Raw = raw
+	return nil
+}
+
+// UnpackERC1155ReceivedLog is a log parse operation binding the contract event 0x01109c91bca177ae1324bc2fc89be4b2573d2e59ad0cc75b1f3d3aa807814d36
+// Solidity: event ERC1155Received(address operator, address from, uint256 id, uint256 value, bytes data)
+func (_Receiver *Receiver) UnpackERC1155ReceivedLog(log types.Log) (*ERC1155ReceivedLog, error) {
+	event := new(ERC1155ReceivedLog)
+	if err := _Receiver.bound().UnpackLog(event, "ERC1155Received", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC1155ReceivedLogIterator is returned from FilterERC1155Received and is used to iterate over
+// the raw logs and unpacked data for ERC1155Received events raised by the Receiver contract.
+type ERC1155ReceivedLogIterator struct {
+	Event *ERC1155ReceivedLog // Event containing the contract specifics and raw log
+
+	contract *Receiver             // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC1155ReceivedLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackERC1155ReceivedLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackERC1155ReceivedLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC1155ReceivedLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC1155ReceivedLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterERC1155Received is a free log retrieval operation binding the contract event 0x01109c91bca177ae1324bc2fc89be4b2573d2e59ad0cc75b1f3d3aa807814d36.
+// Solidity: event ERC1155Received(address operator, address from, uint256 id, uint256 value, bytes data)
+func (_Receiver *Receiver) FilterERC1155Received(opts *bind.FilterOpts) (*ERC1155ReceivedLogIterator, error) {
+
+	logs, sub, err := _Receiver.bound().FilterLogs(opts, "ERC1155Received")
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155ReceivedLogIterator{contract: _Receiver, logs: logs, sub: sub}, nil
+}
+
+// WatchERC1155Received is a free log subscription operation binding the contract event 0x01109c91bca177ae1324bc2fc89be4b2573d2e59ad0cc75b1f3d3aa807814d36.
+// Solidity: event ERC1155Received(address operator, address from, uint256 id, uint256 value, bytes data)
+func (_Receiver *Receiver) WatchERC1155Received(opts *bind.WatchOpts, sink chan<- *ERC1155ReceivedLog) (event.Subscription, error) {
+
+	logs, sub, err := _Receiver.bound().WatchLogs(opts, "ERC1155Received")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _Receiver.UnpackERC1155ReceivedLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// ERC721Received ////////
+
+// ERC721ReceivedID is the hex of the Topic Hash
+const ReceiverERC721ReceivedID = "0xa05d90f300156ad1b545bc5d8197024456f21d22a708f5af04dd293e3d605251"
+
+// ERC721ReceivedLog represents a ERC721Received event raised by the Receiver contract.
+type ERC721ReceivedLog struct {
+	Operator common.Address
+	From     common.Address
+	TokenId  *big.Int
+	Data     []byte
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e ERC721ReceivedLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Operator common.Address `json:"operator"`
+		From     common.Address `json:"from"`
+		TokenId  string         `json:"tokenId"`
+		Data     hexutil.Bytes  `json:"data"`
+	}
+	fields.Operator = e.Operator
+	fields.From = e.From
+	if e.TokenId != nil {
+		fields.TokenId = e.TokenId.String()
+	}
+	fields.Data = e.Data
+	return marshalLogReceiver("ERC721Received", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *ERC721ReceivedLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Operator common.Address `json:"operator"`
+		From     common.Address `json:"from"`
+		TokenId  string         `json:"tokenId"`
+		Data     hexutil.Bytes  `json:"data"`
+	}
+	raw, err := unmarshalLogReceiver(input, "ERC721Received", &fields)
+	if err != nil {
+		return err
+	}
+	e.Operator = fields.Operator
+	e.From = fields.From
+	if fields.TokenId != "" {
+		value, ok := new(big.Int).SetString(fields.TokenId, 10)
+		if !ok {
+			return fmt.Errorf("invalid tokenId %q", fields.TokenId)
+		}
+		e.TokenId = value
+	}
+	e.Data = fields.Data
+	e.Raw = raw
+	return nil
+}
+
+// UnpackERC721ReceivedLog is a log parse operation binding the contract event 0xa05d90f300156ad1b545bc5d8197024456f21d22a708f5af04dd293e3d605251
+// Solidity: event ERC721Received(address operator, address from, uint256 tokenId, bytes data)
+func (_Receiver *Receiver) UnpackERC721ReceivedLog(log types.Log) (*ERC721ReceivedLog, error) {
+	event := new(ERC721ReceivedLog)
+	if err := _Receiver.bound().UnpackLog(event, "ERC721Received", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721ReceivedLogIterator is returned from FilterERC721Received and is used to iterate over
+// the raw logs and unpacked data for ERC721Received events raised by the Receiver contract.
+type ERC721ReceivedLogIterator struct {
+	Event *ERC721ReceivedLog // Event containing the contract specifics and raw log
+
+	contract *Receiver             // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721ReceivedLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackERC721ReceivedLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackERC721ReceivedLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721ReceivedLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721ReceivedLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterERC721Received is a free log retrieval operation binding the contract event 0xa05d90f300156ad1b545bc5d8197024456f21d22a708f5af04dd293e3d605251.
+// Solidity: event ERC721Received(address operator, address from, uint256 tokenId, bytes data)
+func (_Receiver *Receiver) FilterERC721Received(opts *bind.FilterOpts) (*ERC721ReceivedLogIterator, error) {
+
+	logs, sub, err := _Receiver.bound().FilterLogs(opts, "ERC721Received")
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721ReceivedLogIterator{contract: _Receiver, logs: logs, sub: sub}, nil
+}
+
+// WatchERC721Received is a free log subscription operation binding the contract event 0xa05d90f300156ad1b545bc5d8197024456f21d22a708f5af04dd293e3d605251.
+// Solidity: event ERC721Received(address operator, address from, uint256 tokenId, bytes data)
+func (_Receiver *Receiver) WatchERC721Received(opts *bind.WatchOpts, sink chan<- *ERC721ReceivedLog) (event.Subscription, error) {
+
+	logs, sub, err := _Receiver.bound().WatchLogs(opts, "ERC721Received")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _Receiver.UnpackERC721ReceivedLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////////////////////////////////////////////////////
+//		Event Dispatch
+////////////////////////////////////////////////////
+
+// ReceiverEventHandler handles each of the events raised by the Receiver contract.
+type ReceiverEventHandler interface {
+	HandleERC1155BatchReceived(event *ERC1155BatchReceivedLog) error
+	HandleERC1155Received(event *ERC1155ReceivedLog) error
+	HandleERC721Received(event *ERC721ReceivedLog) error
+}
+
+// ErrReceiverUnknownEvent is returned by Dispatch for logs that weren't raised by a Receiver event.
+var ErrReceiverUnknownEvent = errors.New("unknown Receiver event")
+
+// Dispatch unpacks the log according to its first topic and passes it on to the
+// matching method of the handler.
+func (_Receiver *Receiver) Dispatch(log types.Log, h ReceiverEventHandler) error {
+	if len(log.Topics) == 0 {
+		return fmt.Errorf("%w: log has no topics", ErrReceiverUnknownEvent)
+	}
+	switch log.Topics[0].Hex() {
+	case ReceiverERC1155BatchReceivedID:
+		event, err := _Receiver.UnpackERC1155BatchReceivedLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleERC1155BatchReceived(event)
+
+	case ReceiverERC1155ReceivedID:
+		event, err := _Receiver.UnpackERC1155ReceivedLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleERC1155Received(event)
+
+	case ReceiverERC721ReceivedID:
+		event, err := _Receiver.UnpackERC721ReceivedLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleERC721Received(event)
+
+	}
+	return fmt.Errorf("%w: topic %s", ErrReceiverUnknownEvent, log.Topics[0].Hex())
+}
+
+//////////////////////////////////////////////////////
+//		Bin and ABI
+////////////////////////////////////////////////////
+
+// ReceiverBin is used to deploy the generated contract
+const ReceiverBin = "0x608060405234801561001057600080fd5b506105ff806100206000396000f3fe608060405234801561001057600080fd5b506004361061004c5760003560e01c806301ffc9a714610051578063150b7a0214610079578063bc197c81146100a5578063f23a6e61146100b8575b600080fd5b61006461005f366004610207565b6100cb565b60405190151581526020015b60405180910390f35b61008c61008736600461029d565b610102565b6040516001600160e01b03199091168152602001610070565b61008c6100b3366004610351565b610155565b61008c6100c636600461040c565b6101b1565b60006001600160e01b031982166301ffc9a760e01b14806100fc57506001600160e01b03198216630271189760e51b145b92915050565b60007fa05d90f300156ad1b545bc5d8197024456f21d22a708f5af04dd293e3d605251868686868660405161013b9594939291906104ad565b60405180910390a150630a85bd0160e11b95945050505050565b60007f2360e6b28d144bcde27ca99baa660f822a59e919f243c01dfaec5a55986df5f5898989898989898960405161019498979695949392919061051e565b60405180910390a15063bc197c8160e01b98975050505050505050565b60007f01109c91bca177ae1324bc2fc89be4b2573d2e59ad0cc75b1f3d3aa807814d368787878787876040516101ec96959493929190610582565b60405180910390a15063f23a6e6160e01b9695505050505050565b60006020828403121561021957600080fd5b81356001600160e01b03198116811461023157600080fd5b9392505050565b80356001600160a01b038116811461024f57600080fd5b919050565b60008083601f84011261026657600080fd5b50813567ffffffffffffffff81111561027e57600080fd5b60208301915083602082850101111561029657600080fd5b9250929050565b6000806000806000608086880312156102b557600080fd5b6102be86610238565b94506102cc60208701610238565b935060408601359250606086013567ffffffffffffffff8111156102ef57600080fd5b6102fb88828901610254565b969995985093965092949392505050565b60008083601f84011261031e57600080fd5b50813567ffffffffffffffff81111561033657600080fd5b6020830191508360208260051b850101111561029657600080fd5b60008060008060008060008060a0898b03121561036d57600080fd5b61037689610238565b975061038460208a01610238565b9650604089013567ffffffffffffffff808211156103a157600080fd5b6103ad8c838d0161030c565b909850965060608b01359150808211156103c657600080fd5b6103d28c838d0161030c565b909650945060808b01359150808211156103eb57600080fd5b506103f88b828c01610254565b999c989b5096995094979396929594505050565b60008060008060008060a0878903121561042557600080fd5b61042e87610238565b955061043c60208801610238565b94506040870135935060608701359250608087013567ffffffffffffffff81111561046657600080fd5b61047289828a01610254565b979a9699509497509295939492505050565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b6001600160a01b03868116825285166020820152604081018490526080606082018190526000906104e19083018486610484565b979650505050505050565b81835260006001600160fb1b0383111561050557600080fd5b8260051b80836020870137939093016020019392505050565b6001600160a01b0389811682528816602082015260a06040820181905260009061054b908301888a6104ec565b828103606084015261055e8187896104ec565b90508281036080840152610573818587610484565b9b9a5050505050505050505050565b6001600160a01b03878116825286166020820152604081018590526060810184905260a0608082018190526000906105bd9083018486610484565b9897505050505050505056fea2646970667358221220a67edf8cc6c4bd15b6806822ca614bb80759aac114fe8f19528efb52b132d9f164736f6c63430008150033"
+
+// ReceiverABI is used to communicate with the compiled solidity code of the generated contract
+const ReceiverABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"ERC1155BatchReceived\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"ERC1155Received\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"ERC721Received\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"onERC1155BatchReceived\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"onERC1155Received\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"onERC721Received\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}]"
+
+// ReceiverRuntimeBin is the code deployed by ReceiverBin, with libraries left unlinked
+const ReceiverRuntimeBin = "0x608060405234801561001057600080fd5b506004361061004c5760003560e01c806301ffc9a714610051578063150b7a0214610079578063bc197c81146100a5578063f23a6e61146100b8575b600080fd5b61006461005f366004610207565b6100cb565b60405190151581526020015b60405180910390f35b61008c61008736600461029d565b610102565b6040516001600160e01b03199091168152602001610070565b61008c6100b3366004610351565b610155565b61008c6100c636600461040c565b6101b1565b60006001600160e01b031982166301ffc9a760e01b14806100fc57506001600160e01b03198216630271189760e51b145b92915050565b60007fa05d90f300156ad1b545bc5d8197024456f21d22a708f5af04dd293e3d605251868686868660405161013b9594939291906104ad565b60405180910390a150630a85bd0160e11b95945050505050565b60007f2360e6b28d144bcde27ca99baa660f822a59e919f243c01dfaec5a55986df5f5898989898989898960405161019498979695949392919061051e565b60405180910390a15063bc197c8160e01b98975050505050505050565b60007f01109c91bca177ae1324bc2fc89be4b2573d2e59ad0cc75b1f3d3aa807814d368787878787876040516101ec96959493929190610582565b60405180910390a15063f23a6e6160e01b9695505050505050565b60006020828403121561021957600080fd5b81356001600160e01b03198116811461023157600080fd5b9392505050565b80356001600160a01b038116811461024f57600080fd5b919050565b60008083601f84011261026657600080fd5b50813567ffffffffffffffff81111561027e57600080fd5b60208301915083602082850101111561029657600080fd5b9250929050565b6000806000806000608086880312156102b557600080fd5b6102be86610238565b94506102cc60208701610238565b935060408601359250606086013567ffffffffffffffff8111156102ef57600080fd5b6102fb88828901610254565b969995985093965092949392505050565b60008083601f84011261031e57600080fd5b50813567ffffffffffffffff81111561033657600080fd5b6020830191508360208260051b850101111561029657600080fd5b60008060008060008060008060a0898b03121561036d57600080fd5b61037689610238565b975061038460208a01610238565b9650604089013567ffffffffffffffff808211156103a157600080fd5b6103ad8c838d0161030c565b909850965060608b01359150808211156103c657600080fd5b6103d28c838d0161030c565b909650945060808b01359150808211156103eb57600080fd5b506103f88b828c01610254565b999c989b5096995094979396929594505050565b60008060008060008060a0878903121561042557600080fd5b61042e87610238565b955061043c60208801610238565b94506040870135935060608701359250608087013567ffffffffffffffff81111561046657600080fd5b61047289828a01610254565b979a9699509497509295939492505050565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b6001600160a01b03868116825285166020820152604081018490526080606082018190526000906104e19083018486610484565b979650505050505050565b81835260006001600160fb1b0383111561050557600080fd5b8260051b80836020870137939093016020019392505050565b6001600160a01b0389811682528816602082015260a06040820181905260009061054b908301888a6104ec565b828103606084015261055e8187896104ec565b90508281036080840152610573818587610484565b9b9a5050505050505050505050565b6001600160a01b03878116825286166020820152604081018590526060810184905260a0608082018190526000906105bd9083018486610484565b9897505050505050505056fea2646970667358221220a67edf8cc6c4bd15b6806822ca614bb80759aac114fe8f19528efb52b132d9f164736f6c63430008150033"
+
+// ReceiverSourceMap maps the instructions of ReceiverBin to the Solidity sources
+const ReceiverSourceMap = "300:1384:3:-:0;;;;;;;;;;;;;;;;;;;"
+
+// ReceiverRuntimeSourceMap maps the instructions of ReceiverRuntimeBin to the Solidity sources
+const ReceiverRuntimeSourceMap = "300:1384:3:-:0;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;656:202;;;;;;:::i;:::-;;:::i;:::-;;;470:14:4;;463:22;445:41;;433:2;418:18;656:202:3;;;;;;;;864:245;;;;;;:::i;:::-;;:::i;:::-;;;-1:-1:-1;;;;;;1820:33:4;;;1802:52;;1790:2;1775:18;864:245:3;1658:202:4;1381:301:3;;;;;;:::i;:::-;;:::i;1115:260::-;;;;;;:::i;:::-;;:::i;656:202::-;735:4;-1:-1:-1;;;;;;758:40:3;;-1:-1:-1;;;758:40:3;;:93;;-1:-1:-1;;;;;;;802:49:3;;-1:-1:-1;;;802:49:3;758:93;751:100;656:202;-1:-1:-1;;656:202:3:o;864:245::-;987:6;1010:45;1025:8;1035:4;1041:7;1050:4;;1010:45;;;;;;;;;;:::i;:::-;;;;;;;;-1:-1:-1;;;;864:245:3;;;;;;;:::o;1381:301::-;1544:6;1567:55;1588:8;1598:4;1604:3;;1609:6;;1617:4;;1567:55;;;;;;;;;;;;;:::i;:::-;;;;;;;;-1:-1:-1;;;;1381:301:3;;;;;;;;;;:::o;1115:260::-;1249:6;1272:48;1288:8;1298:4;1304:2;1308:5;1315:4;;1272:48;;;;;;;;;;;:::i;:::-;;;;;;;;-1:-1:-1;;;;1115:260:3;;;;;;;;:::o;14:286:4:-;72:6;125:2;113:9;104:7;100:23;96:32;93:52;;;141:1;138;131:12;93:52;167:23;;-1:-1:-1;;;;;;219:32:4;;209:43;;199:71;;266:1;263;256:12;199:71;289:5;14:286;-1:-1:-1;;;14:286:4:o;497:173::-;565:20;;-1:-1:-1;;;;;614:31:4;;604:42;;594:70;;660:1;657;650:12;594:70;497:173;;;:::o;675:347::-;726:8;736:6;790:3;783:4;775:6;771:17;767:27;757:55;;808:1;805;798:12;757:55;-1:-1:-1;831:20:4;;874:18;863:30;;860:50;;;906:1;903;896:12;860:50;943:4;935:6;931:17;919:29;;995:3;988:4;979:6;971;967:19;963:30;960:39;957:59;;;1012:1;1009;1002:12;957:59;675:347;;;;;:::o;1027:626::-;1124:6;1132;1140;1148;1156;1209:3;1197:9;1188:7;1184:23;1180:33;1177:53;;;1226:1;1223;1216:12;1177:53;1249:29;1268:9;1249:29;:::i;:::-;1239:39;;1297:38;1331:2;1320:9;1316:18;1297:38;:::i;:::-;1287:48;;1382:2;1371:9;1367:18;1354:32;1344:42;;1437:2;1426:9;1422:18;1409:32;1464:18;1456:6;1453:30;1450:50;;;1496:1;1493;1486:12;1450:50;1535:58;1585:7;1576:6;1565:9;1561:22;1535:58;:::i;:::-;1027:626;;;;-1:-1:-1;1027:626:4;;-1:-1:-1;1612:8:4;;1509:84;1027:626;-1:-1:-1;;;1027:626:4:o;1865:367::-;1928:8;1938:6;1992:3;1985:4;1977:6;1973:17;1969:27;1959:55;;2010:1;2007;2000:12;1959:55;-1:-1:-1;2033:20:4;;2076:18;2065:30;;2062:50;;;2108:1;2105;2098:12;2062:50;2145:4;2137:6;2133:17;2121:29;;2205:3;2198:4;2188:6;2185:1;2181:14;2173:6;2169:27;2165:38;2162:47;2159:67;;;2222:1;2219;2212:12;2237:1210;2397:6;2405;2413;2421;2429;2437;2445;2453;2506:3;2494:9;2485:7;2481:23;2477:33;2474:53;;;2523:1;2520;2513:12;2474:53;2546:29;2565:9;2546:29;:::i;:::-;2536:39;;2594:38;2628:2;2617:9;2613:18;2594:38;:::i;:::-;2584:48;;2683:2;2672:9;2668:18;2655:32;2706:18;2747:2;2739:6;2736:14;2733:34;;;2763:1;2760;2753:12;2733:34;2802:70;2864:7;2855:6;2844:9;2840:22;2802:70;:::i;:::-;2891:8;;-1:-1:-1;2776:96:4;-1:-1:-1;2979:2:4;2964:18;;2951:32;;-1:-1:-1;2995:16:4;;;2992:36;;;3024:1;3021;3014:12;2992:36;3063:72;3127:7;3116:8;3105:9;3101:24;3063:72;:::i;:::-;3154:8;;-1:-1:-1;3037:98:4;-1:-1:-1;3242:3:4;3227:19;;3214:33;;-1:-1:-1;3259:16:4;;;3256:36;;;3288:1;3285;3278:12;3256:36;;3327:60;3379:7;3368:8;3357:9;3353:24;3327:60;:::i;:::-;2237:1210;;;;-1:-1:-1;2237:1210:4;;-1:-1:-1;2237:1210:4;;;;;;3406:8;-1:-1:-1;;;2237:1210:4:o;3452:695::-;3558:6;3566;3574;3582;3590;3598;3651:3;3639:9;3630:7;3626:23;3622:33;3619:53;;;3668:1;3665;3658:12;3619:53;3691:29;3710:9;3691:29;:::i;:::-;3681:39;;3739:38;3773:2;3762:9;3758:18;3739:38;:::i;:::-;3729:48;;3824:2;3813:9;3809:18;3796:32;3786:42;;3875:2;3864:9;3860:18;3847:32;3837:42;;3930:3;3919:9;3915:19;3902:33;3958:18;3950:6;3947:30;3944:50;;;3990:1;3987;3980:12;3944:50;4029:58;4079:7;4070:6;4059:9;4055:22;4029:58;:::i;:::-;3452:695;;;;-1:-1:-1;3452:695:4;;-1:-1:-1;3452:695:4;;4106:8;;3452:695;-1:-1:-1;;;3452:695:4:o;4152:266::-;4240:6;4235:3;4228:19;4292:6;4285:5;4278:4;4273:3;4269:14;4256:43;-1:-1:-1;4344:1:4;4319:16;;;4337:4;4315:27;;;4308:38;;;;4400:2;4379:15;;;-1:-1:-1;;4375:29:4;4366:39;;;4362:50;;4152:266::o;4423:515::-;-1:-1:-1;;;;;4702:15:4;;;4684:34;;4754:15;;4749:2;4734:18;;4727:43;4801:2;4786:18;;4779:34;;;4849:3;4844:2;4829:18;;4822:31;;;4627:4;;4870:62;;4912:19;;4904:6;4896;4870:62;:::i;:::-;4862:70;4423:515;-1:-1:-1;;;;;;;4423:515:4:o;4943:311::-;5031:19;;;5013:3;-1:-1:-1;;;;;5062:31:4;;5059:51;;;5106:1;5103;5096:12;5059:51;5142:6;5139:1;5135:14;5194:8;5187:5;5180:4;5175:3;5171:14;5158:45;5223:18;;;;5243:4;5219:29;;4943:311;-1:-1:-1;;;4943:311:4:o;5259:907::-;-1:-1:-1;;;;;5686:15:4;;;5668:34;;5738:15;;5733:2;5718:18;;5711:43;5648:3;5785:2;5770:18;;5763:31;;;5611:4;;5817:74;;5871:19;;5863:6;5855;5817:74;:::i;:::-;5939:9;5931:6;5927:22;5922:2;5911:9;5907:18;5900:50;5973:61;6027:6;6019;6011;5973:61;:::i;:::-;5959:75;;6083:9;6075:6;6071:22;6065:3;6054:9;6050:19;6043:51;6111:49;6153:6;6145;6137;6111:49;:::i;:::-;6103:57;5259:907;-1:-1:-1;;;;;;;;;;;5259:907:4:o;6171:587::-;-1:-1:-1;;;;;6478:15:4;;;6460:34;;6530:15;;6525:2;6510:18;;6503:43;6577:2;6562:18;;6555:34;;;6620:2;6605:18;;6598:34;;;6440:3;6663;6648:19;;6641:32;;;6403:4;;6690:62;;6732:19;;6724:6;6716;6690:62;:::i;:::-;6682:70;6171:587;-1:-1:-1;;;;;;;;6171:587:4:o"
+
+// ReceiverFuncSigs maps the 4-byte function signature to its string representation.
+var ReceiverFuncSigs = map[string]string{
+	"bc197c81": "onERC1155BatchReceived(address,address,uint256[],uint256[],bytes)",
+	"f23a6e61": "onERC1155Received(address,address,uint256,uint256,bytes)",
+	"150b7a02": "onERC721Received(address,address,uint256,bytes)",
+	"01ffc9a7": "supportsInterface(bytes4)",
+}
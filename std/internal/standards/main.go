@@ -0,0 +1,21 @@
+// Command standards regenerates standards_gen.go, the Go interfaces of the
+// token standards in package std, from bind.BindStandards. It is run by go
+// generate in std.
+package main
+
+import (
+	"io/ioutil"
+	"log"
+
+	"github.com/evan-forbes/buddy/bind"
+)
+
+func main() {
+	code, err := bind.BindStandards("std")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("standards_gen.go", []byte(code), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
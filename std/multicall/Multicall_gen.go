@@ -0,0 +1,1323 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package multicall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = context.Background
+	_ = errors.New
+	_ = json.Marshal
+	_ = fmt.Errorf
+	_ = big.NewInt
+	_ = reflect.ValueOf
+	_ = strings.NewReader
+	_ = sync.NewCond
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = hexutil.Encode
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// Multicall is a wrapper around BoundContract, enforcing type checking and including
+// QoL helper methods
+//
+// Title: Multicall2 - Aggregate results from multiple read-only function calls
+//
+// Author: Michael Elliot <mike@makerdao.com>Joshua Levine <joshua@makerdao.com>Nick Johnson <arachnid@notdot.net>
+type Multicall struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+	abi      abi.ABI             // Parsed ABI, used to replay failed transactions
+	address  common.Address      // Address the contract is deployed at
+	backend  bind.ContractBackend
+}
+
+// NewMulticall creates a new instance of Multicall, bound to a specific deployed contract.
+func NewMulticall(address common.Address, backend bind.ContractBackend) (*Multicall, error) {
+	a, err := parseMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(address, a, backend, backend, backend)
+	return &Multicall{contract: contract, abi: a, address: address, backend: backend}, nil
+}
+
+// parseMulticallABI parses MulticallABI, keying overloaded methods by their full
+// signature so that every binding calls the exact overload it was generated for.
+func parseMulticallABI() (abi.ABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(MulticallABI))
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	overloads := make(map[string]int)
+	for _, method := range parsed.Methods {
+		overloads[method.RawName]++
+	}
+	methods := make(map[string]abi.Method, len(parsed.Methods))
+	for name, method := range parsed.Methods {
+		if overloads[method.RawName] > 1 {
+			name = method.Sig()
+		}
+		methods[name] = method
+	}
+	parsed.Methods = methods
+	return parsed, nil
+}
+
+// bound exposes the underlying BoundContract used for the low level calls
+func (_Multicall *Multicall) bound() *bind.BoundContract {
+	return _Multicall.contract
+}
+
+// transact invokes method. Should that fail while estimating gas, the invocation
+// is replayed as a call to recover the revert data behind the failure.
+func (_Multicall *Multicall) transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	tx, err := _Multicall.contract.Transact(opts, method, params...)
+	if err == nil || opts.GasLimit != 0 {
+		return tx, err
+	}
+	msg, perr := _Multicall.message(opts, method, params...)
+	if perr != nil {
+		return nil, err
+	}
+	if _, cerr := _Multicall.backend.CallContract(contextOfMulticall(opts), msg, nil); cerr != nil {
+		if decoded := unpackMulticallError(cerr); decoded != cerr {
+			return nil, decoded
+		}
+	}
+	return nil, err
+}
+
+// estimate estimates the gas needed to invoke method with the given options.
+func (_Multicall *Multicall) estimate(opts *bind.TransactOpts, method string, params ...interface{}) (uint64, error) {
+	msg, err := _Multicall.message(opts, method, params...)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := _Multicall.backend.EstimateGas(contextOfMulticall(opts), msg)
+	return gas, unpackMulticallError(err)
+}
+
+// simulate runs method as an eth_call from opts.From against the pending state,
+// unpacking its outputs into out, if any.
+func (_Multicall *Multicall) simulate(opts *bind.TransactOpts, out interface{}, method string, params ...interface{}) error {
+	msg, err := _Multicall.message(opts, method, params...)
+	if err != nil {
+		return err
+	}
+	var output []byte
+	if pending, ok := _Multicall.backend.(bind.PendingContractCaller); ok {
+		output, err = pending.PendingCallContract(contextOfMulticall(opts), msg)
+	} else {
+		output, err = _Multicall.backend.CallContract(contextOfMulticall(opts), msg, nil)
+	}
+	if err != nil {
+		return unpackMulticallError(err)
+	}
+	if out == nil {
+		return nil
+	}
+	return _Multicall.abi.Unpack(out, method, output)
+}
+
+// message packs an invocation of method into a call message sent with opts.
+func (_Multicall *Multicall) message(opts *bind.TransactOpts, method string, params ...interface{}) (ethereum.CallMsg, error) {
+	if opts == nil {
+		return ethereum.CallMsg{}, errors.New("no transact options to invoke Multicall." + method + " with")
+	}
+	input, err := _Multicall.abi.Pack(method, params...)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	return ethereum.CallMsg{
+		From:     opts.From,
+		To:       &_Multicall.address,
+		Gas:      opts.GasLimit,
+		GasPrice: opts.GasPrice,
+		Value:    opts.Value,
+		Data:     input,
+	}, nil
+}
+
+// contextOfMulticall returns the context of opts, defaulting to the background one.
+func contextOfMulticall(opts *bind.TransactOpts) context.Context {
+	if opts.Context == nil {
+		return context.Background()
+	}
+	return opts.Context
+}
+
+//////////////////////////////////////////////////////
+//		Interface
+////////////////////////////////////////////////////
+
+// MulticallInterface lists every call, transaction and log unpacker of Multicall.
+// It is regenerated along with the binding, so it can't drift from the ABI.
+type MulticallInterface interface {
+	GetBlockHash(opts *bind.CallOpts, blockNumber *big.Int) ([32]byte, error)
+	GetBlockNumber(opts *bind.CallOpts) (*big.Int, error)
+	GetCurrentBlockCoinbase(opts *bind.CallOpts) (common.Address, error)
+	GetCurrentBlockDifficulty(opts *bind.CallOpts) (*big.Int, error)
+	GetCurrentBlockGasLimit(opts *bind.CallOpts) (*big.Int, error)
+	GetCurrentBlockTimestamp(opts *bind.CallOpts) (*big.Int, error)
+	GetEthBalance(opts *bind.CallOpts, addr common.Address) (*big.Int, error)
+	GetLastBlockHash(opts *bind.CallOpts) ([32]byte, error)
+
+	Aggregate(opts *bind.TransactOpts, calls []Multicall2Call) (*types.Transaction, error)
+	BlockAndAggregate(opts *bind.TransactOpts, calls []Multicall2Call) (*types.Transaction, error)
+	TryAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error)
+	TryBlockAndAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error)
+}
+
+// This nil assignment ensures at compile time that Multicall implements MulticallInterface.
+var _ MulticallInterface = (*Multicall)(nil)
+
+//////////////////////////////////////////////////////
+//		Deployment
+////////////////////////////////////////////////////
+
+// DeployMulticall deploys a new Ethereum contract, binding an instance of Multicall to it.
+func DeployMulticall(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Multicall, error) {
+	parsed, err := parseMulticallABI()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(MulticallBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Multicall{contract: contract, abi: parsed, address: address, backend: backend}, nil
+}
+
+// ErrMulticallCodeMismatch is returned by NewMulticallVerified when the code deployed
+// at an address isn't the one of Multicall.
+var ErrMulticallCodeMismatch = errors.New("code does not match MulticallBin")
+
+// NewMulticallVerified binds an instance of Multicall, after checking that the code
+// deployed at address is the runtime code of MulticallBin.
+func NewMulticallVerified(ctx context.Context, address common.Address, backend bind.ContractBackend) (*Multicall, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w: %s", bind.ErrNoCode, address.Hex())
+	}
+	if !matchMulticallCode(code) {
+		return nil, fmt.Errorf("%w: %s", ErrMulticallCodeMismatch, address.Hex())
+	}
+	return NewMulticall(address, backend)
+}
+
+// matchMulticallCode reports whether code is the runtime code embedded in MulticallBin.
+// The metadata hash is ignored, as are the zeroed regions the bytecode reserves
+// for immutables and linked library addresses.
+func matchMulticallCode(code []byte) bool {
+	template := MulticallBin
+
+	bin := common.FromHex(template)
+
+	// Strip the CBOR encoded metadata, its length being in the last two bytes
+	if n := len(code); n > 2 {
+		if meta := int(code[n-2])<<8 | int(code[n-1]); meta+2 < n && code[n-2-meta]&0xe0 == 0xa0 {
+			code = code[:n-2-meta]
+		}
+	}
+	// Mask the runs of zeros long enough to hold an address or an immutable
+	masked := make([]bool, len(bin))
+	for start := 0; start < len(bin); {
+		end := start
+		for end < len(bin) && bin[end] == 0 {
+			end++
+		}
+		if end-start >= common.AddressLength {
+			for i := start; i < end; i++ {
+				masked[i] = true
+			}
+		}
+		start = end + 1
+	}
+	// Look for the runtime code within the creation code
+	for offset := 0; len(code) > 0 && offset+len(code) <= len(bin); offset++ {
+		matched := true
+		for i := range code {
+			if code[i] != bin[offset+i] && !masked[offset+i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// GetBlockHash is a free data retrieval call binding the contract method 0xee82ac5e.
+// - Solidity: function getBlockHash(uint256 blockNumber) constant returns(bytes32 blockHash)
+func (_Multicall *Multicall) GetBlockHash(opts *bind.CallOpts, blockNumber *big.Int) ([32]byte, error) {
+	var (
+		ret0 = new([32]byte)
+	)
+	out := ret0
+	err := _Multicall.bound().Call(opts, out, "getBlockHash", blockNumber)
+	return *ret0, unpackMulticallError(err)
+}
+
+// GetBlockNumber is a free data retrieval call binding the contract method 0x42cbb15c.
+// - Solidity: function getBlockNumber() constant returns(uint256 blockNumber)
+func (_Multicall *Multicall) GetBlockNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Multicall.bound().Call(opts, out, "getBlockNumber")
+	return *ret0, unpackMulticallError(err)
+}
+
+// GetCurrentBlockCoinbase is a free data retrieval call binding the contract method 0xa8b0574e.
+// - Solidity: function getCurrentBlockCoinbase() constant returns(address coinbase)
+func (_Multicall *Multicall) GetCurrentBlockCoinbase(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _Multicall.bound().Call(opts, out, "getCurrentBlockCoinbase")
+	return *ret0, unpackMulticallError(err)
+}
+
+// GetCurrentBlockDifficulty is a free data retrieval call binding the contract method 0x72425d9d.
+// - Solidity: function getCurrentBlockDifficulty() constant returns(uint256 difficulty)
+func (_Multicall *Multicall) GetCurrentBlockDifficulty(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Multicall.bound().Call(opts, out, "getCurrentBlockDifficulty")
+	return *ret0, unpackMulticallError(err)
+}
+
+// GetCurrentBlockGasLimit is a free data retrieval call binding the contract method 0x86d516e8.
+// - Solidity: function getCurrentBlockGasLimit() constant returns(uint256 gaslimit)
+func (_Multicall *Multicall) GetCurrentBlockGasLimit(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Multicall.bound().Call(opts, out, "getCurrentBlockGasLimit")
+	return *ret0, unpackMulticallError(err)
+}
+
+// GetCurrentBlockTimestamp is a free data retrieval call binding the contract method 0x0f28c97d.
+// - Solidity: function getCurrentBlockTimestamp() constant returns(uint256 timestamp)
+func (_Multicall *Multicall) GetCurrentBlockTimestamp(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Multicall.bound().Call(opts, out, "getCurrentBlockTimestamp")
+	return *ret0, unpackMulticallError(err)
+}
+
+// GetEthBalance is a free data retrieval call binding the contract method 0x4d2301cc.
+// - Solidity: function getEthBalance(address addr) constant returns(uint256 balance)
+func (_Multicall *Multicall) GetEthBalance(opts *bind.CallOpts, addr common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Multicall.bound().Call(opts, out, "getEthBalance", addr)
+	return *ret0, unpackMulticallError(err)
+}
+
+// GetLastBlockHash is a free data retrieval call binding the contract method 0x27e86d6e.
+// - Solidity: function getLastBlockHash() constant returns(bytes32 blockHash)
+func (_Multicall *Multicall) GetLastBlockHash(opts *bind.CallOpts) ([32]byte, error) {
+	var (
+		ret0 = new([32]byte)
+	)
+	out := ret0
+	err := _Multicall.bound().Call(opts, out, "getLastBlockHash")
+	return *ret0, unpackMulticallError(err)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// Aggregate is a paid mutator transaction binding the contract method 0x252dba42.
+// - Solidity: function aggregate([]Multicall2Call calls) returns(uint256 blockNumber, bytes[] returnData)
+func (_Multicall *Multicall) Aggregate(opts *bind.TransactOpts, calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.transact(opts, "aggregate", calls)
+}
+
+// EstimateAggregate estimates the gas needed to invoke the contract method 0x252dba42.
+func (_Multicall *Multicall) EstimateAggregate(opts *bind.TransactOpts, calls []Multicall2Call) (uint64, error) {
+	return _Multicall.estimate(opts, "aggregate", calls)
+}
+
+// SimulateAggregate runs the contract method 0x252dba42 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_Multicall *Multicall) SimulateAggregate(opts *bind.TransactOpts, calls []Multicall2Call) (struct {
+	BlockNumber *big.Int
+	ReturnData  [][]byte
+}, error) {
+	ret := new(struct {
+		BlockNumber *big.Int
+		ReturnData  [][]byte
+	})
+	out := ret
+	err := _Multicall.simulate(opts, out, "aggregate", calls)
+	return *ret, err
+}
+
+// BlockAndAggregate is a paid mutator transaction binding the contract method 0xc3077fa9.
+// - Solidity: function blockAndAggregate([]Multicall2Call calls) returns(uint256 blockNumber, bytes32 blockHash, []Multicall2Result returnData)
+func (_Multicall *Multicall) BlockAndAggregate(opts *bind.TransactOpts, calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.transact(opts, "blockAndAggregate", calls)
+}
+
+// EstimateBlockAndAggregate estimates the gas needed to invoke the contract method 0xc3077fa9.
+func (_Multicall *Multicall) EstimateBlockAndAggregate(opts *bind.TransactOpts, calls []Multicall2Call) (uint64, error) {
+	return _Multicall.estimate(opts, "blockAndAggregate", calls)
+}
+
+// SimulateBlockAndAggregate runs the contract method 0xc3077fa9 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_Multicall *Multicall) SimulateBlockAndAggregate(opts *bind.TransactOpts, calls []Multicall2Call) (struct {
+	BlockNumber *big.Int
+	BlockHash   [32]byte
+	ReturnData  []Multicall2Result
+}, error) {
+	ret := new(struct {
+		BlockNumber *big.Int
+		BlockHash   [32]byte
+		ReturnData  []Multicall2Result
+	})
+	out := ret
+	err := _Multicall.simulate(opts, out, "blockAndAggregate", calls)
+	return *ret, err
+}
+
+// TryAggregate is a paid mutator transaction binding the contract method 0xbce38bd7.
+// - Solidity: function tryAggregate(bool requireSuccess, []Multicall2Call calls) returns([]Multicall2Result returnData)
+func (_Multicall *Multicall) TryAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.transact(opts, "tryAggregate", requireSuccess, calls)
+}
+
+// EstimateTryAggregate estimates the gas needed to invoke the contract method 0xbce38bd7.
+func (_Multicall *Multicall) EstimateTryAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) (uint64, error) {
+	return _Multicall.estimate(opts, "tryAggregate", requireSuccess, calls)
+}
+
+// SimulateTryAggregate runs the contract method 0xbce38bd7 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_Multicall *Multicall) SimulateTryAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) ([]Multicall2Result, error) {
+	var (
+		ret0 = new([]Multicall2Result)
+	)
+	out := ret0
+	err := _Multicall.simulate(opts, out, "tryAggregate", requireSuccess, calls)
+	return *ret0, err
+}
+
+// TryBlockAndAggregate is a paid mutator transaction binding the contract method 0x399542e9.
+// - Solidity: function tryBlockAndAggregate(bool requireSuccess, []Multicall2Call calls) returns(uint256 blockNumber, bytes32 blockHash, []Multicall2Result returnData)
+func (_Multicall *Multicall) TryBlockAndAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.transact(opts, "tryBlockAndAggregate", requireSuccess, calls)
+}
+
+// EstimateTryBlockAndAggregate estimates the gas needed to invoke the contract method 0x399542e9.
+func (_Multicall *Multicall) EstimateTryBlockAndAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) (uint64, error) {
+	return _Multicall.estimate(opts, "tryBlockAndAggregate", requireSuccess, calls)
+}
+
+// SimulateTryBlockAndAggregate runs the contract method 0x399542e9 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_Multicall *Multicall) SimulateTryBlockAndAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) (struct {
+	BlockNumber *big.Int
+	BlockHash   [32]byte
+	ReturnData  []Multicall2Result
+}, error) {
+	ret := new(struct {
+		BlockNumber *big.Int
+		BlockHash   [32]byte
+		ReturnData  []Multicall2Result
+	})
+	out := ret
+	err := _Multicall.simulate(opts, out, "tryBlockAndAggregate", requireSuccess, calls)
+	return *ret, err
+}
+
+//////////////////////////////////////////////////////
+//		Sessions
+////////////////////////////////////////////////////
+
+// MulticallSession binds Multicall to default call and transact options, exposing
+// every method without the opts argument.
+type MulticallSession struct {
+	Contract     *Multicall        // Contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MulticallCallerSession binds Multicall to default call options, exposing every
+// call without the opts argument.
+type MulticallCallerSession struct {
+	Contract *Multicall    // Contract binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// MulticallTransactorSession binds Multicall to default transact options, exposing
+// every transaction without the opts argument.
+type MulticallTransactorSession struct {
+	Contract     *Multicall        // Contract binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NewMulticallSession opens a session on contract, sending transactions with auth
+// and making calls from the same account.
+func NewMulticallSession(contract *Multicall, auth *bind.TransactOpts) *MulticallSession {
+	return &MulticallSession{
+		Contract:     contract,
+		CallOpts:     bind.CallOpts{From: auth.From, Context: auth.Context},
+		TransactOpts: *auth,
+	}
+}
+
+// MulticallSessionInterface is the opts-free counterpart of MulticallInterface.
+type MulticallSessionInterface interface {
+	GetBlockHash(blockNumber *big.Int) ([32]byte, error)
+	GetBlockNumber() (*big.Int, error)
+	GetCurrentBlockCoinbase() (common.Address, error)
+	GetCurrentBlockDifficulty() (*big.Int, error)
+	GetCurrentBlockGasLimit() (*big.Int, error)
+	GetCurrentBlockTimestamp() (*big.Int, error)
+	GetEthBalance(addr common.Address) (*big.Int, error)
+	GetLastBlockHash() ([32]byte, error)
+
+	Aggregate(calls []Multicall2Call) (*types.Transaction, error)
+	BlockAndAggregate(calls []Multicall2Call) (*types.Transaction, error)
+	TryAggregate(requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error)
+	TryBlockAndAggregate(requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error)
+}
+
+// This nil assignment ensures at compile time that MulticallSession implements MulticallSessionInterface.
+var _ MulticallSessionInterface = (*MulticallSession)(nil)
+
+// GetBlockHash is a free data retrieval call binding the contract method 0xee82ac5e.
+func (_Multicall *MulticallSession) GetBlockHash(blockNumber *big.Int) ([32]byte, error) {
+	return _Multicall.Contract.GetBlockHash(&_Multicall.CallOpts, blockNumber)
+}
+
+// GetBlockHash is a free data retrieval call binding the contract method 0xee82ac5e.
+func (_Multicall *MulticallCallerSession) GetBlockHash(blockNumber *big.Int) ([32]byte, error) {
+	return _Multicall.Contract.GetBlockHash(&_Multicall.CallOpts, blockNumber)
+}
+
+// GetBlockNumber is a free data retrieval call binding the contract method 0x42cbb15c.
+func (_Multicall *MulticallSession) GetBlockNumber() (*big.Int, error) {
+	return _Multicall.Contract.GetBlockNumber(&_Multicall.CallOpts)
+}
+
+// GetBlockNumber is a free data retrieval call binding the contract method 0x42cbb15c.
+func (_Multicall *MulticallCallerSession) GetBlockNumber() (*big.Int, error) {
+	return _Multicall.Contract.GetBlockNumber(&_Multicall.CallOpts)
+}
+
+// GetCurrentBlockCoinbase is a free data retrieval call binding the contract method 0xa8b0574e.
+func (_Multicall *MulticallSession) GetCurrentBlockCoinbase() (common.Address, error) {
+	return _Multicall.Contract.GetCurrentBlockCoinbase(&_Multicall.CallOpts)
+}
+
+// GetCurrentBlockCoinbase is a free data retrieval call binding the contract method 0xa8b0574e.
+func (_Multicall *MulticallCallerSession) GetCurrentBlockCoinbase() (common.Address, error) {
+	return _Multicall.Contract.GetCurrentBlockCoinbase(&_Multicall.CallOpts)
+}
+
+// GetCurrentBlockDifficulty is a free data retrieval call binding the contract method 0x72425d9d.
+func (_Multicall *MulticallSession) GetCurrentBlockDifficulty() (*big.Int, error) {
+	return _Multicall.Contract.GetCurrentBlockDifficulty(&_Multicall.CallOpts)
+}
+
+// GetCurrentBlockDifficulty is a free data retrieval call binding the contract method 0x72425d9d.
+func (_Multicall *MulticallCallerSession) GetCurrentBlockDifficulty() (*big.Int, error) {
+	return _Multicall.Contract.GetCurrentBlockDifficulty(&_Multicall.CallOpts)
+}
+
+// GetCurrentBlockGasLimit is a free data retrieval call binding the contract method 0x86d516e8.
+func (_Multicall *MulticallSession) GetCurrentBlockGasLimit() (*big.Int, error) {
+	return _Multicall.Contract.GetCurrentBlockGasLimit(&_Multicall.CallOpts)
+}
+
+// GetCurrentBlockGasLimit is a free data retrieval call binding the contract method 0x86d516e8.
+func (_Multicall *MulticallCallerSession) GetCurrentBlockGasLimit() (*big.Int, error) {
+	return _Multicall.Contract.GetCurrentBlockGasLimit(&_Multicall.CallOpts)
+}
+
+// GetCurrentBlockTimestamp is a free data retrieval call binding the contract method 0x0f28c97d.
+func (_Multicall *MulticallSession) GetCurrentBlockTimestamp() (*big.Int, error) {
+	return _Multicall.Contract.GetCurrentBlockTimestamp(&_Multicall.CallOpts)
+}
+
+// GetCurrentBlockTimestamp is a free data retrieval call binding the contract method 0x0f28c97d.
+func (_Multicall *MulticallCallerSession) GetCurrentBlockTimestamp() (*big.Int, error) {
+	return _Multicall.Contract.GetCurrentBlockTimestamp(&_Multicall.CallOpts)
+}
+
+// GetEthBalance is a free data retrieval call binding the contract method 0x4d2301cc.
+func (_Multicall *MulticallSession) GetEthBalance(addr common.Address) (*big.Int, error) {
+	return _Multicall.Contract.GetEthBalance(&_Multicall.CallOpts, addr)
+}
+
+// GetEthBalance is a free data retrieval call binding the contract method 0x4d2301cc.
+func (_Multicall *MulticallCallerSession) GetEthBalance(addr common.Address) (*big.Int, error) {
+	return _Multicall.Contract.GetEthBalance(&_Multicall.CallOpts, addr)
+}
+
+// GetLastBlockHash is a free data retrieval call binding the contract method 0x27e86d6e.
+func (_Multicall *MulticallSession) GetLastBlockHash() ([32]byte, error) {
+	return _Multicall.Contract.GetLastBlockHash(&_Multicall.CallOpts)
+}
+
+// GetLastBlockHash is a free data retrieval call binding the contract method 0x27e86d6e.
+func (_Multicall *MulticallCallerSession) GetLastBlockHash() ([32]byte, error) {
+	return _Multicall.Contract.GetLastBlockHash(&_Multicall.CallOpts)
+}
+
+// Aggregate is a paid mutator transaction binding the contract method 0x252dba42.
+func (_Multicall *MulticallSession) Aggregate(calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.Contract.Aggregate(&_Multicall.TransactOpts, calls)
+}
+
+// Aggregate is a paid mutator transaction binding the contract method 0x252dba42.
+func (_Multicall *MulticallTransactorSession) Aggregate(calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.Contract.Aggregate(&_Multicall.TransactOpts, calls)
+}
+
+// BlockAndAggregate is a paid mutator transaction binding the contract method 0xc3077fa9.
+func (_Multicall *MulticallSession) BlockAndAggregate(calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.Contract.BlockAndAggregate(&_Multicall.TransactOpts, calls)
+}
+
+// BlockAndAggregate is a paid mutator transaction binding the contract method 0xc3077fa9.
+func (_Multicall *MulticallTransactorSession) BlockAndAggregate(calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.Contract.BlockAndAggregate(&_Multicall.TransactOpts, calls)
+}
+
+// TryAggregate is a paid mutator transaction binding the contract method 0xbce38bd7.
+func (_Multicall *MulticallSession) TryAggregate(requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.Contract.TryAggregate(&_Multicall.TransactOpts, requireSuccess, calls)
+}
+
+// TryAggregate is a paid mutator transaction binding the contract method 0xbce38bd7.
+func (_Multicall *MulticallTransactorSession) TryAggregate(requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.Contract.TryAggregate(&_Multicall.TransactOpts, requireSuccess, calls)
+}
+
+// TryBlockAndAggregate is a paid mutator transaction binding the contract method 0x399542e9.
+func (_Multicall *MulticallSession) TryBlockAndAggregate(requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.Contract.TryBlockAndAggregate(&_Multicall.TransactOpts, requireSuccess, calls)
+}
+
+// TryBlockAndAggregate is a paid mutator transaction binding the contract method 0x399542e9.
+func (_Multicall *MulticallTransactorSession) TryBlockAndAggregate(requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error) {
+	return _Multicall.Contract.TryBlockAndAggregate(&_Multicall.TransactOpts, requireSuccess, calls)
+}
+
+//////////////////////////////////////////////////////
+//		Calldata
+////////////////////////////////////////////////////
+
+var (
+	parsedMulticallABIOnce sync.Once
+	parsedMulticallABI     abi.ABI
+	parsedMulticallABIErr  error
+)
+
+// loadMulticallABI parses MulticallABI once, sharing it between the calldata helpers.
+func loadMulticallABI() (abi.ABI, error) {
+	parsedMulticallABIOnce.Do(func() {
+		parsedMulticallABI, parsedMulticallABIErr = parseMulticallABI()
+	})
+	return parsedMulticallABI, parsedMulticallABIErr
+}
+
+// unnamedMulticallArgs names unnamed arguments the way the input structs do, so
+// they can be unpacked into them.
+func unnamedMulticallArgs(args abi.Arguments) abi.Arguments {
+	named := make(abi.Arguments, len(args))
+	for i, arg := range args {
+		if arg.Name == "" {
+			arg.Name = fmt.Sprintf("arg%d", i)
+		}
+		named[i] = arg
+	}
+	return named
+}
+
+// MulticallGetBlockHashInput holds the arguments of a Multicall.GetBlockHash invocation.
+type MulticallGetBlockHashInput struct {
+	BlockNumber *big.Int
+}
+
+// PackMulticallGetBlockHash packs the calldata invoking the contract method 0xee82ac5e.
+func PackMulticallGetBlockHash(blockNumber *big.Int) ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("getBlockHash", blockNumber)
+}
+
+// UnpackMulticallGetBlockHashInput unpacks the calldata of a transaction invoking the
+// contract method 0xee82ac5e, selector included.
+func UnpackMulticallGetBlockHashInput(data []byte) (*MulticallGetBlockHashInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "ee82ac5e" {
+		return nil, errors.New("calldata does not invoke getBlockHash(uint256)")
+	}
+	input := new(MulticallGetBlockHashInput)
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedMulticallArgs(parsed.Methods["getBlockHash"].Inputs)
+	if err := args.Unpack(&input.BlockNumber, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackMulticallGetBlockHashOutput unpacks the data returned by the contract method 0xee82ac5e,
+// as Multicall.GetBlockHash returns it.
+func UnpackMulticallGetBlockHashOutput(data []byte) ([32]byte, error) {
+	var (
+		ret0 = new([32]byte)
+	)
+	out := ret0
+	parsed, err := loadMulticallABI()
+	if err == nil {
+		err = parsed.Unpack(out, "getBlockHash", data)
+	}
+	return *ret0, err
+}
+
+// MulticallGetBlockNumberInput holds the arguments of a Multicall.GetBlockNumber invocation.
+type MulticallGetBlockNumberInput struct{}
+
+// PackMulticallGetBlockNumber packs the calldata invoking the contract method 0x42cbb15c.
+func PackMulticallGetBlockNumber() ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("getBlockNumber")
+}
+
+// UnpackMulticallGetBlockNumberInput unpacks the calldata of a transaction invoking the
+// contract method 0x42cbb15c, selector included.
+func UnpackMulticallGetBlockNumberInput(data []byte) (*MulticallGetBlockNumberInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "42cbb15c" {
+		return nil, errors.New("calldata does not invoke getBlockNumber()")
+	}
+	input := new(MulticallGetBlockNumberInput)
+
+	return input, nil
+}
+
+// UnpackMulticallGetBlockNumberOutput unpacks the data returned by the contract method 0x42cbb15c,
+// as Multicall.GetBlockNumber returns it.
+func UnpackMulticallGetBlockNumberOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadMulticallABI()
+	if err == nil {
+		err = parsed.Unpack(out, "getBlockNumber", data)
+	}
+	return *ret0, err
+}
+
+// MulticallGetCurrentBlockCoinbaseInput holds the arguments of a Multicall.GetCurrentBlockCoinbase invocation.
+type MulticallGetCurrentBlockCoinbaseInput struct{}
+
+// PackMulticallGetCurrentBlockCoinbase packs the calldata invoking the contract method 0xa8b0574e.
+func PackMulticallGetCurrentBlockCoinbase() ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("getCurrentBlockCoinbase")
+}
+
+// UnpackMulticallGetCurrentBlockCoinbaseInput unpacks the calldata of a transaction invoking the
+// contract method 0xa8b0574e, selector included.
+func UnpackMulticallGetCurrentBlockCoinbaseInput(data []byte) (*MulticallGetCurrentBlockCoinbaseInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "a8b0574e" {
+		return nil, errors.New("calldata does not invoke getCurrentBlockCoinbase()")
+	}
+	input := new(MulticallGetCurrentBlockCoinbaseInput)
+
+	return input, nil
+}
+
+// UnpackMulticallGetCurrentBlockCoinbaseOutput unpacks the data returned by the contract method 0xa8b0574e,
+// as Multicall.GetCurrentBlockCoinbase returns it.
+func UnpackMulticallGetCurrentBlockCoinbaseOutput(data []byte) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	parsed, err := loadMulticallABI()
+	if err == nil {
+		err = parsed.Unpack(out, "getCurrentBlockCoinbase", data)
+	}
+	return *ret0, err
+}
+
+// MulticallGetCurrentBlockDifficultyInput holds the arguments of a Multicall.GetCurrentBlockDifficulty invocation.
+type MulticallGetCurrentBlockDifficultyInput struct{}
+
+// PackMulticallGetCurrentBlockDifficulty packs the calldata invoking the contract method 0x72425d9d.
+func PackMulticallGetCurrentBlockDifficulty() ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("getCurrentBlockDifficulty")
+}
+
+// UnpackMulticallGetCurrentBlockDifficultyInput unpacks the calldata of a transaction invoking the
+// contract method 0x72425d9d, selector included.
+func UnpackMulticallGetCurrentBlockDifficultyInput(data []byte) (*MulticallGetCurrentBlockDifficultyInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "72425d9d" {
+		return nil, errors.New("calldata does not invoke getCurrentBlockDifficulty()")
+	}
+	input := new(MulticallGetCurrentBlockDifficultyInput)
+
+	return input, nil
+}
+
+// UnpackMulticallGetCurrentBlockDifficultyOutput unpacks the data returned by the contract method 0x72425d9d,
+// as Multicall.GetCurrentBlockDifficulty returns it.
+func UnpackMulticallGetCurrentBlockDifficultyOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadMulticallABI()
+	if err == nil {
+		err = parsed.Unpack(out, "getCurrentBlockDifficulty", data)
+	}
+	return *ret0, err
+}
+
+// MulticallGetCurrentBlockGasLimitInput holds the arguments of a Multicall.GetCurrentBlockGasLimit invocation.
+type MulticallGetCurrentBlockGasLimitInput struct{}
+
+// PackMulticallGetCurrentBlockGasLimit packs the calldata invoking the contract method 0x86d516e8.
+func PackMulticallGetCurrentBlockGasLimit() ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("getCurrentBlockGasLimit")
+}
+
+// UnpackMulticallGetCurrentBlockGasLimitInput unpacks the calldata of a transaction invoking the
+// contract method 0x86d516e8, selector included.
+func UnpackMulticallGetCurrentBlockGasLimitInput(data []byte) (*MulticallGetCurrentBlockGasLimitInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "86d516e8" {
+		return nil, errors.New("calldata does not invoke getCurrentBlockGasLimit()")
+	}
+	input := new(MulticallGetCurrentBlockGasLimitInput)
+
+	return input, nil
+}
+
+// UnpackMulticallGetCurrentBlockGasLimitOutput unpacks the data returned by the contract method 0x86d516e8,
+// as Multicall.GetCurrentBlockGasLimit returns it.
+func UnpackMulticallGetCurrentBlockGasLimitOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadMulticallABI()
+	if err == nil {
+		err = parsed.Unpack(out, "getCurrentBlockGasLimit", data)
+	}
+	return *ret0, err
+}
+
+// MulticallGetCurrentBlockTimestampInput holds the arguments of a Multicall.GetCurrentBlockTimestamp invocation.
+type MulticallGetCurrentBlockTimestampInput struct{}
+
+// PackMulticallGetCurrentBlockTimestamp packs the calldata invoking the contract method 0x0f28c97d.
+func PackMulticallGetCurrentBlockTimestamp() ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("getCurrentBlockTimestamp")
+}
+
+// UnpackMulticallGetCurrentBlockTimestampInput unpacks the calldata of a transaction invoking the
+// contract method 0x0f28c97d, selector included.
+func UnpackMulticallGetCurrentBlockTimestampInput(data []byte) (*MulticallGetCurrentBlockTimestampInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "0f28c97d" {
+		return nil, errors.New("calldata does not invoke getCurrentBlockTimestamp()")
+	}
+	input := new(MulticallGetCurrentBlockTimestampInput)
+
+	return input, nil
+}
+
+// UnpackMulticallGetCurrentBlockTimestampOutput unpacks the data returned by the contract method 0x0f28c97d,
+// as Multicall.GetCurrentBlockTimestamp returns it.
+func UnpackMulticallGetCurrentBlockTimestampOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadMulticallABI()
+	if err == nil {
+		err = parsed.Unpack(out, "getCurrentBlockTimestamp", data)
+	}
+	return *ret0, err
+}
+
+// MulticallGetEthBalanceInput holds the arguments of a Multicall.GetEthBalance invocation.
+type MulticallGetEthBalanceInput struct {
+	Addr common.Address
+}
+
+// PackMulticallGetEthBalance packs the calldata invoking the contract method 0x4d2301cc.
+func PackMulticallGetEthBalance(addr common.Address) ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("getEthBalance", addr)
+}
+
+// UnpackMulticallGetEthBalanceInput unpacks the calldata of a transaction invoking the
+// contract method 0x4d2301cc, selector included.
+func UnpackMulticallGetEthBalanceInput(data []byte) (*MulticallGetEthBalanceInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "4d2301cc" {
+		return nil, errors.New("calldata does not invoke getEthBalance(address)")
+	}
+	input := new(MulticallGetEthBalanceInput)
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedMulticallArgs(parsed.Methods["getEthBalance"].Inputs)
+	if err := args.Unpack(&input.Addr, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackMulticallGetEthBalanceOutput unpacks the data returned by the contract method 0x4d2301cc,
+// as Multicall.GetEthBalance returns it.
+func UnpackMulticallGetEthBalanceOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadMulticallABI()
+	if err == nil {
+		err = parsed.Unpack(out, "getEthBalance", data)
+	}
+	return *ret0, err
+}
+
+// MulticallGetLastBlockHashInput holds the arguments of a Multicall.GetLastBlockHash invocation.
+type MulticallGetLastBlockHashInput struct{}
+
+// PackMulticallGetLastBlockHash packs the calldata invoking the contract method 0x27e86d6e.
+func PackMulticallGetLastBlockHash() ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("getLastBlockHash")
+}
+
+// UnpackMulticallGetLastBlockHashInput unpacks the calldata of a transaction invoking the
+// contract method 0x27e86d6e, selector included.
+func UnpackMulticallGetLastBlockHashInput(data []byte) (*MulticallGetLastBlockHashInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "27e86d6e" {
+		return nil, errors.New("calldata does not invoke getLastBlockHash()")
+	}
+	input := new(MulticallGetLastBlockHashInput)
+
+	return input, nil
+}
+
+// UnpackMulticallGetLastBlockHashOutput unpacks the data returned by the contract method 0x27e86d6e,
+// as Multicall.GetLastBlockHash returns it.
+func UnpackMulticallGetLastBlockHashOutput(data []byte) ([32]byte, error) {
+	var (
+		ret0 = new([32]byte)
+	)
+	out := ret0
+	parsed, err := loadMulticallABI()
+	if err == nil {
+		err = parsed.Unpack(out, "getLastBlockHash", data)
+	}
+	return *ret0, err
+}
+
+// MulticallAggregateInput holds the arguments of a Multicall.Aggregate invocation.
+type MulticallAggregateInput struct {
+	Calls []Multicall2Call
+}
+
+// PackMulticallAggregate packs the calldata invoking the contract method 0x252dba42.
+func PackMulticallAggregate(calls []Multicall2Call) ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("aggregate", calls)
+}
+
+// UnpackMulticallAggregateInput unpacks the calldata of a transaction invoking the
+// contract method 0x252dba42, selector included.
+func UnpackMulticallAggregateInput(data []byte) (*MulticallAggregateInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "252dba42" {
+		return nil, errors.New("calldata does not invoke aggregate((address,bytes)[])")
+	}
+	input := new(MulticallAggregateInput)
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedMulticallArgs(parsed.Methods["aggregate"].Inputs)
+	if err := args.Unpack(&input.Calls, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// MulticallBlockAndAggregateInput holds the arguments of a Multicall.BlockAndAggregate invocation.
+type MulticallBlockAndAggregateInput struct {
+	Calls []Multicall2Call
+}
+
+// PackMulticallBlockAndAggregate packs the calldata invoking the contract method 0xc3077fa9.
+func PackMulticallBlockAndAggregate(calls []Multicall2Call) ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("blockAndAggregate", calls)
+}
+
+// UnpackMulticallBlockAndAggregateInput unpacks the calldata of a transaction invoking the
+// contract method 0xc3077fa9, selector included.
+func UnpackMulticallBlockAndAggregateInput(data []byte) (*MulticallBlockAndAggregateInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "c3077fa9" {
+		return nil, errors.New("calldata does not invoke blockAndAggregate((address,bytes)[])")
+	}
+	input := new(MulticallBlockAndAggregateInput)
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedMulticallArgs(parsed.Methods["blockAndAggregate"].Inputs)
+	if err := args.Unpack(&input.Calls, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// MulticallTryAggregateInput holds the arguments of a Multicall.TryAggregate invocation.
+type MulticallTryAggregateInput struct {
+	RequireSuccess bool
+	Calls          []Multicall2Call
+}
+
+// PackMulticallTryAggregate packs the calldata invoking the contract method 0xbce38bd7.
+func PackMulticallTryAggregate(requireSuccess bool, calls []Multicall2Call) ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("tryAggregate", requireSuccess, calls)
+}
+
+// UnpackMulticallTryAggregateInput unpacks the calldata of a transaction invoking the
+// contract method 0xbce38bd7, selector included.
+func UnpackMulticallTryAggregateInput(data []byte) (*MulticallTryAggregateInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "bce38bd7" {
+		return nil, errors.New("calldata does not invoke tryAggregate(bool,(address,bytes)[])")
+	}
+	input := new(MulticallTryAggregateInput)
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedMulticallArgs(parsed.Methods["tryAggregate"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// MulticallTryBlockAndAggregateInput holds the arguments of a Multicall.TryBlockAndAggregate invocation.
+type MulticallTryBlockAndAggregateInput struct {
+	RequireSuccess bool
+	Calls          []Multicall2Call
+}
+
+// PackMulticallTryBlockAndAggregate packs the calldata invoking the contract method 0x399542e9.
+func PackMulticallTryBlockAndAggregate(requireSuccess bool, calls []Multicall2Call) ([]byte, error) {
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("tryBlockAndAggregate", requireSuccess, calls)
+}
+
+// UnpackMulticallTryBlockAndAggregateInput unpacks the calldata of a transaction invoking the
+// contract method 0x399542e9, selector included.
+func UnpackMulticallTryBlockAndAggregateInput(data []byte) (*MulticallTryBlockAndAggregateInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "399542e9" {
+		return nil, errors.New("calldata does not invoke tryBlockAndAggregate(bool,(address,bytes)[])")
+	}
+	input := new(MulticallTryBlockAndAggregateInput)
+	parsed, err := loadMulticallABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedMulticallArgs(parsed.Methods["tryBlockAndAggregate"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ErrMulticallUnknownMethod is returned by DecodeMulticallCall for calldata that doesn't invoke a Multicall method.
+var ErrMulticallUnknownMethod = errors.New("unknown Multicall method")
+
+// DecodeMulticallCall identifies the method invoked by calldata through its 4-byte
+// selector, returning the matching *MethodInput holding its arguments.
+func DecodeMulticallCall(data []byte) (interface{}, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("%w: calldata too short", ErrMulticallUnknownMethod)
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "ee82ac5e":
+		input, err := UnpackMulticallGetBlockHashInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "42cbb15c":
+		input, err := UnpackMulticallGetBlockNumberInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "a8b0574e":
+		input, err := UnpackMulticallGetCurrentBlockCoinbaseInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "72425d9d":
+		input, err := UnpackMulticallGetCurrentBlockDifficultyInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "86d516e8":
+		input, err := UnpackMulticallGetCurrentBlockGasLimitInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "0f28c97d":
+		input, err := UnpackMulticallGetCurrentBlockTimestampInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "4d2301cc":
+		input, err := UnpackMulticallGetEthBalanceInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "27e86d6e":
+		input, err := UnpackMulticallGetLastBlockHashInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "252dba42":
+		input, err := UnpackMulticallAggregateInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "c3077fa9":
+		input, err := UnpackMulticallBlockAndAggregateInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "bce38bd7":
+		input, err := UnpackMulticallTryAggregateInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "399542e9":
+		input, err := UnpackMulticallTryBlockAndAggregateInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	}
+	return nil, fmt.Errorf("%w: selector %x", ErrMulticallUnknownMethod, data[:4])
+}
+
+//////////////////////////////////////////////////////
+//		Errors
+////////////////////////////////////////////////////
+
+// MulticallRevert is a revert with a reason string, as raised by require and revert.
+type MulticallRevert struct {
+	Reason string
+}
+
+func (e MulticallRevert) Error() string {
+	return "Multicall: execution reverted: " + e.Reason
+}
+
+// MulticallPanic is raised by failed assertions, arithmetic overflows and the like.
+type MulticallPanic struct {
+	Code *big.Int
+}
+
+func (e MulticallPanic) Error() string {
+	return fmt.Sprintf("Multicall: panic code %#x", e.Code)
+}
+
+// MulticallErrorsABI describes the errors Multicall can revert with, each as a function
+// returning the error arguments.
+const MulticallErrorsABI = "[{\"type\":\"function\",\"name\":\"Error\",\"outputs\":[{\"name\":\"reason\",\"type\":\"string\"}]},{\"type\":\"function\",\"name\":\"Panic\",\"outputs\":[{\"name\":\"code\",\"type\":\"uint256\"}]}]"
+
+// DecodeMulticallRevert decodes revert data into a MulticallRevert, a MulticallPanic
+// or one of the custom errors of Multicall. It returns nil for unknown data.
+func DecodeMulticallRevert(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(MulticallErrorsABI))
+	if err != nil {
+		return nil
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "08c379a0":
+		var e MulticallRevert
+		if parsed.Unpack(&e, "Error", data[4:]) == nil {
+			return e
+		}
+	case "4e487b71":
+		var e MulticallPanic
+		if parsed.Unpack(&e, "Panic", data[4:]) == nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// unpackMulticallError replaces an error carrying revert data with the typed error
+// decoded from it, leaving any other error untouched. Only errors exposing the
+// data through ErrorData, like sim.RevertError, carry it; RPC errors don't.
+func unpackMulticallError(err error) error {
+	var reverted interface{ ErrorData() interface{} }
+	if !errors.As(err, &reverted) {
+		return err
+	}
+	var data []byte
+	switch d := reverted.ErrorData().(type) {
+	case string:
+		data = common.FromHex(d)
+	case []byte:
+		data = d
+	}
+	if decoded := DecodeMulticallRevert(data); decoded != nil {
+		return decoded
+	}
+	return err
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+//////////////////////////////////////////////////////
+//		Bin and ABI
+////////////////////////////////////////////////////
+
+// MulticallBin is used to deploy the generated contract
+const MulticallBin = "0x608060405234801561001057600080fd5b506109c4806100206000396000f3fe608060405234801561001057600080fd5b50600436106100b45760003560e01c806372425d9d1161007157806372425d9d1461013a57806386d516e814610140578063a8b0574e14610146578063bce38bd714610154578063c3077fa914610174578063ee82ac5e1461018757600080fd5b80630f28c97d146100b9578063252dba42146100ce57806327e86d6e146100ef578063399542e9146100f757806342cbb15c146101195780634d2301cc1461011f575b600080fd5b425b6040519081526020015b60405180910390f35b6100e16100dc3660046106ea565b610199565b6040516100c5929190610777565b6100bb610321565b61010a6101053660046107e1565b610334565b6040516100c59392919061089e565b436100bb565b6100bb61012d3660046108c6565b6001600160a01b03163190565b446100bb565b456100bb565b6040514181526020016100c5565b6101676101623660046107e1565b61034c565b6040516100c591906108e8565b61010a6101823660046106ea565b610506565b6100bb6101953660046108fb565b4090565b8051439060609067ffffffffffffffff8111156101b8576101b8610523565b6040519080825280602002602001820160405280156101eb57816020015b60608152602001906001900390816101d65790505b50905060005b835181101561031b5760008085838151811061020f5761020f610914565b6020026020010151600001516001600160a01b031686848151811061023657610236610914565b60200260200101516020015160405161024f919061092a565b6000604051808303816000865af19150503d806000811461028c576040519150601f19603f3d011682016040523d82523d6000602084013e610291565b606091505b5091509150816102e85760405162461bcd60e51b815260206004820181905260248201527f4d756c746963616c6c206167677265676174653a2063616c6c206661696c656460448201526064015b60405180910390fd5b808484815181106102fb576102fb610914565b6020026020010181905250505080806103139061095c565b9150506101f1565b50915091565b600061032e600143610975565b40905090565b4380406060610343858561034c565b90509250925092565b6060815167ffffffffffffffff81111561036857610368610523565b6040519080825280602002602001820160405280156103ae57816020015b6040805180820190915260008152606060208201528152602001906001900390816103865790505b50905060005b82518110156104ff576000808483815181106103d2576103d2610914565b6020026020010151600001516001600160a01b03168584815181106103f9576103f9610914565b602002602001015160200151604051610412919061092a565b6000604051808303816000865af19150503d806000811461044f576040519150601f19603f3d011682016040523d82523d6000602084013e610454565b606091505b509150915085156104b657816104b65760405162461bcd60e51b815260206004820152602160248201527f4d756c746963616c6c32206167677265676174653a2063616c6c206661696c656044820152601960fa1b60648201526084016102df565b60405180604001604052808315158152602001828152508484815181106104df576104df610914565b6020026020010181905250505080806104f79061095c565b9150506103b4565b5092915050565b6000806060610516600185610334565b9196909550909350915050565b634e487b7160e01b600052604160045260246000fd5b6040805190810167ffffffffffffffff8111828210171561055c5761055c610523565b60405290565b604051601f8201601f1916810167ffffffffffffffff8111828210171561058b5761058b610523565b604052919050565b80356001600160a01b03811681146105aa57600080fd5b919050565b6000601f83818401126105c157600080fd5b8235602067ffffffffffffffff808311156105de576105de610523565b8260051b6105ed838201610562565b938452868101830193838101908986111561060757600080fd5b84890192505b858310156106dd578235848111156106255760008081fd5b89016040601f19828d03810182131561063e5760008081fd5b610646610539565b610651898501610593565b815282840135888111156106655760008081fd5b8085019450508d603f85011261067b5760008081fd5b888401358881111561068f5761068f610523565b61069e8a848e84011601610562565b92508083528e848287010111156106b55760008081fd5b808486018b85013760009083018a01528089019190915284525050918401919084019061060d565b9998505050505050505050565b6000602082840312156106fc57600080fd5b813567ffffffffffffffff81111561071357600080fd5b61071f848285016105af565b949350505050565b60005b8381101561074257818101518382015260200161072a565b50506000910152565b60008151808452610763816020860160208601610727565b601f01601f19169290920160200192915050565b600060408201848352602060408185015281855180845260608601915060608160051b870101935082870160005b828110156107d357605f198887030184526107c186835161074b565b955092840192908401906001016107a5565b509398975050505050505050565b600080604083850312156107f457600080fd5b8235801515811461080457600080fd5b9150602083013567ffffffffffffffff81111561082057600080fd5b61082c858286016105af565b9150509250929050565b6000815180845260208085019450848260051b860182860160005b858110156108915783830389528151805115158452850151604086850181905261087d8186018361074b565b9a87019a9450505090840190600101610851565b5090979650505050505050565b8381528260208201526060604082015260006108bd6060830184610836565b95945050505050565b6000602082840312156108d857600080fd5b6108e182610593565b9392505050565b6020815260006108e16020830184610836565b60006020828403121561090d57600080fd5b5035919050565b634e487b7160e01b600052603260045260246000fd5b6000825161093c818460208701610727565b9190910192915050565b634e487b7160e01b600052601160045260246000fd5b60006001820161096e5761096e610946565b5060010190565b8181038181111561098857610988610946565b9291505056fea264697066735822122063843a5ae0bb11e495061e80ea8ccbabf98a9a174f13d0c06b7990b973f67b4e64736f6c63430008150033"
+
+// MulticallABI is used to communicate with the compiled solidity code of the generated contract
+const MulticallABI = "[{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"callData\",\"type\":\"bytes\"}],\"internalType\":\"structMulticall2.Call[]\",\"name\":\"calls\",\"type\":\"tuple[]\"}],\"name\":\"aggregate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"},{\"internalType\":\"bytes[]\",\"name\":\"returnData\",\"type\":\"bytes[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"callData\",\"type\":\"bytes\"}],\"internalType\":\"structMulticall2.Call[]\",\"name\":\"calls\",\"type\":\"tuple[]\"}],\"name\":\"blockAndAggregate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"internalType\":\"structMulticall2.Result[]\",\"name\":\"returnData\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"getBlockHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentBlockCoinbase\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"coinbase\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentBlockDifficulty\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"difficulty\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentBlockGasLimit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"gaslimit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentBlockTimestamp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"getEthBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLastBlockHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"requireSuccess\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"callData\",\"type\":\"bytes\"}],\"internalType\":\"structMulticall2.Call[]\",\"name\":\"calls\",\"type\":\"tuple[]\"}],\"name\":\"tryAggregate\",\"outputs\":[{\"components\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"internalType\":\"structMulticall2.Result[]\",\"name\":\"returnData\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"requireSuccess\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"callData\",\"type\":\"bytes\"}],\"internalType\":\"structMulticall2.Call[]\",\"name\":\"calls\",\"type\":\"tuple[]\"}],\"name\":\"tryBlockAndAggregate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"internalType\":\"structMulticall2.Result[]\",\"name\":\"returnData\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// MulticallRuntimeBin is the code deployed by MulticallBin, with libraries left unlinked
+const MulticallRuntimeBin = "0x608060405234801561001057600080fd5b50600436106100b45760003560e01c806372425d9d1161007157806372425d9d1461013a57806386d516e814610140578063a8b0574e14610146578063bce38bd714610154578063c3077fa914610174578063ee82ac5e1461018757600080fd5b80630f28c97d146100b9578063252dba42146100ce57806327e86d6e146100ef578063399542e9146100f757806342cbb15c146101195780634d2301cc1461011f575b600080fd5b425b6040519081526020015b60405180910390f35b6100e16100dc3660046106ea565b610199565b6040516100c5929190610777565b6100bb610321565b61010a6101053660046107e1565b610334565b6040516100c59392919061089e565b436100bb565b6100bb61012d3660046108c6565b6001600160a01b03163190565b446100bb565b456100bb565b6040514181526020016100c5565b6101676101623660046107e1565b61034c565b6040516100c591906108e8565b61010a6101823660046106ea565b610506565b6100bb6101953660046108fb565b4090565b8051439060609067ffffffffffffffff8111156101b8576101b8610523565b6040519080825280602002602001820160405280156101eb57816020015b60608152602001906001900390816101d65790505b50905060005b835181101561031b5760008085838151811061020f5761020f610914565b6020026020010151600001516001600160a01b031686848151811061023657610236610914565b60200260200101516020015160405161024f919061092a565b6000604051808303816000865af19150503d806000811461028c576040519150601f19603f3d011682016040523d82523d6000602084013e610291565b606091505b5091509150816102e85760405162461bcd60e51b815260206004820181905260248201527f4d756c746963616c6c206167677265676174653a2063616c6c206661696c656460448201526064015b60405180910390fd5b808484815181106102fb576102fb610914565b6020026020010181905250505080806103139061095c565b9150506101f1565b50915091565b600061032e600143610975565b40905090565b4380406060610343858561034c565b90509250925092565b6060815167ffffffffffffffff81111561036857610368610523565b6040519080825280602002602001820160405280156103ae57816020015b6040805180820190915260008152606060208201528152602001906001900390816103865790505b50905060005b82518110156104ff576000808483815181106103d2576103d2610914565b6020026020010151600001516001600160a01b03168584815181106103f9576103f9610914565b602002602001015160200151604051610412919061092a565b6000604051808303816000865af19150503d806000811461044f576040519150601f19603f3d011682016040523d82523d6000602084013e610454565b606091505b509150915085156104b657816104b65760405162461bcd60e51b815260206004820152602160248201527f4d756c746963616c6c32206167677265676174653a2063616c6c206661696c656044820152601960fa1b60648201526084016102df565b60405180604001604052808315158152602001828152508484815181106104df576104df610914565b6020026020010181905250505080806104f79061095c565b9150506103b4565b5092915050565b6000806060610516600185610334565b9196909550909350915050565b634e487b7160e01b600052604160045260246000fd5b6040805190810167ffffffffffffffff8111828210171561055c5761055c610523565b60405290565b604051601f8201601f1916810167ffffffffffffffff8111828210171561058b5761058b610523565b604052919050565b80356001600160a01b03811681146105aa57600080fd5b919050565b6000601f83818401126105c157600080fd5b8235602067ffffffffffffffff808311156105de576105de610523565b8260051b6105ed838201610562565b938452868101830193838101908986111561060757600080fd5b84890192505b858310156106dd578235848111156106255760008081fd5b89016040601f19828d03810182131561063e5760008081fd5b610646610539565b610651898501610593565b815282840135888111156106655760008081fd5b8085019450508d603f85011261067b5760008081fd5b888401358881111561068f5761068f610523565b61069e8a848e84011601610562565b92508083528e848287010111156106b55760008081fd5b808486018b85013760009083018a01528089019190915284525050918401919084019061060d565b9998505050505050505050565b6000602082840312156106fc57600080fd5b813567ffffffffffffffff81111561071357600080fd5b61071f848285016105af565b949350505050565b60005b8381101561074257818101518382015260200161072a565b50506000910152565b60008151808452610763816020860160208601610727565b601f01601f19169290920160200192915050565b600060408201848352602060408185015281855180845260608601915060608160051b870101935082870160005b828110156107d357605f198887030184526107c186835161074b565b955092840192908401906001016107a5565b509398975050505050505050565b600080604083850312156107f457600080fd5b8235801515811461080457600080fd5b9150602083013567ffffffffffffffff81111561082057600080fd5b61082c858286016105af565b9150509250929050565b6000815180845260208085019450848260051b860182860160005b858110156108915783830389528151805115158452850151604086850181905261087d8186018361074b565b9a87019a9450505090840190600101610851565b5090979650505050505050565b8381528260208201526060604082015260006108bd6060830184610836565b95945050505050565b6000602082840312156108d857600080fd5b6108e182610593565b9392505050565b6020815260006108e16020830184610836565b60006020828403121561090d57600080fd5b5035919050565b634e487b7160e01b600052603260045260246000fd5b6000825161093c818460208701610727565b9190910192915050565b634e487b7160e01b600052601160045260246000fd5b60006001820161096e5761096e610946565b5060010190565b8181038181111561098857610988610946565b9291505056fea264697066735822122063843a5ae0bb11e495061e80ea8ccbabf98a9a174f13d0c06b7990b973f67b4e64736f6c63430008150033"
+
+// MulticallSourceMap maps the instructions of MulticallBin to the Solidity sources
+const MulticallSourceMap = "486:2641:0:-:0;;;;;;;;;;;;;;;;;;;"
+
+// MulticallRuntimeSourceMap maps the instructions of MulticallRuntimeBin to the Solidity sources
+const MulticallRuntimeSourceMap = "486:2641:0:-:0;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;1960:120;2058:15;1960:120;;;160:25:1;;;148:2;133:18;1960:120:0;;;;;;;;659:444;;;;;;:::i;:::-;;:::i;:::-;;;;;;;;:::i;2204:124::-;;;:::i;2823:302::-;;;;;;:::i;:::-;;:::i;:::-;;;;;;;;;:::i;1473:111::-;1565:12;1473:111;;2085:114;;;;;;:::i;:::-;-1:-1:-1;;;;;2180:12:0;;;2085:114;1710:124;1811:16;1710:124;;1839:116;1934:14;1839:116;;1589;;;1684:14;7257:51:1;;7245:2;7230:18;1589:116:0;7111:203:1;2333:485:0;;;;;;:::i;:::-;;:::i;:::-;;;;;;;:::i;1108:221::-;;;;;;:::i;:::-;;:::i;1334:134::-;;;;;;:::i;:::-;1439:22;;1334:134;659:444;834:12;;787;;736:25;;822;;;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;809:38;;861:9;857:240;880:5;:12;876:1;:16;857:240;;;914:12;928:16;948:5;954:1;948:8;;;;;;;;:::i;:::-;;;;;;;:15;;;-1:-1:-1;;;;;948:20:0;969:5;975:1;969:8;;;;;;;;:::i;:::-;;;;;;;:17;;;948:39;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;913:74;;;;1009:7;1001:52;;;;-1:-1:-1;;;1001:52:0;;8446:2:1;1001:52:0;;;8428:21:1;;;8465:18;;;8458:30;8524:34;8504:18;;;8497:62;8576:18;;1001:52:0;;;;;;;;;1083:3;1067:10;1078:1;1067:13;;;;;;;;:::i;:::-;;;;;;:19;;;;899:198;;894:3;;;;;:::i;:::-;;;;857:240;;;;659:444;;;:::o;2204:124::-;2253:17;2304:16;2319:1;2304:12;:16;:::i;:::-;2294:27;2282:39;;2204:124;:::o;2823:302::-;3003:12;3037:23;;2951:26;3083:35;3096:14;3112:5;3083:12;:35::i;:::-;3070:48;;2823:302;;;;;:::o;2333:485::-;2413:26;2477:5;:12;2464:26;;;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;-1:-1:-1;;;;;;;;;;;;;;;;;2464:26:0;;;;;;;;;;;;;;;;2451:39;;2504:9;2500:312;2523:5;:12;2519:1;:16;2500:312;;;2557:12;2571:16;2591:5;2597:1;2591:8;;;;;;;;:::i;:::-;;;;;;;:15;;;-1:-1:-1;;;;;2591:20:0;2612:5;2618:1;2612:8;;;;;;;;:::i;:::-;;;;;;;:17;;;2591:39;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;2556:74;;;;2649:14;2645:106;;;2691:7;2683:53;;;;-1:-1:-1;;;2683:53:0;;9212:2:1;2683:53:0;;;9194:21:1;9251:2;9231:18;;;9224:30;9290:34;9270:18;;;9263:62;-1:-1:-1;;;9341:18:1;;;9334:31;9382:19;;2683:53:0;9010:397:1;2683:53:0;2781:20;;;;;;;;2788:7;2781:20;;;;;;2797:3;2781:20;;;2765:10;2776:1;2765:13;;;;;;;;:::i;:::-;;;;;;:36;;;;2542:270;;2537:3;;;;;:::i;:::-;;;;2500:312;;;;2333:485;;;;:::o;1108:221::-;1172:19;1193:17;1212:26;1289:33;1310:4;1316:5;1289:20;:33::i;:::-;1250:72;;;;-1:-1:-1;1250:72:0;;-1:-1:-1;1108:221:0;-1:-1:-1;;1108:221:0:o;196:127:1:-;257:10;252:3;248:20;245:1;238:31;288:4;285:1;278:15;312:4;309:1;302:15;328:257;400:4;394:11;;;432:17;;479:18;464:34;;500:22;;;461:62;458:88;;;526:18;;:::i;:::-;562:4;555:24;328:257;:::o;590:275::-;661:2;655:9;726:2;707:13;;-1:-1:-1;;703:27:1;691:40;;761:18;746:34;;782:22;;;743:62;740:88;;;808:18;;:::i;:::-;844:2;837:22;590:275;;-1:-1:-1;590:275:1:o;870:173::-;938:20;;-1:-1:-1;;;;;987:31:1;;977:42;;967:70;;1033:1;1030;1023:12;967:70;870:173;;;:::o;1048:2118::-;1106:5;1136:4;1180:3;1175:2;1167:6;1163:15;1159:25;1149:53;;1198:1;1195;1188:12;1149:53;1234:6;1221:20;1260:4;1283:18;1320:2;1316;1313:10;1310:36;;;1326:18;;:::i;:::-;1372:2;1369:1;1365:10;1395:28;1419:2;1415;1411:11;1395:28;:::i;:::-;1457:15;;;1527;;;1523:24;;;1488:12;;;;1559:15;;;1556:35;;;1587:1;1584;1577:12;1556:35;1623:2;1615:6;1611:15;1600:26;;1635:1502;1651:6;1646:3;1643:15;1635:1502;;;1737:3;1724:17;1773:2;1760:11;1757:19;1754:109;;;1817:1;1846:2;1842;1835:14;1754:109;1886:24;;1933:4;-1:-1:-1;;1991:12:1;;;1987:21;;1983:30;-1:-1:-1;1980:123:1;;;2055:1;2085:3;2080;2073:16;1980:123;2129:22;;:::i;:::-;2178:31;2205:2;2201;2197:11;2178:31;:::i;:::-;2171:5;2164:46;2260:2;2256;2252:11;2239:25;2293:2;2283:8;2280:16;2277:109;;;2338:1;2368:3;2363;2356:16;2277:109;2418:8;2414:2;2410:17;2399:28;;;2468:3;2463:2;2458:3;2454:12;2450:22;2440:123;;2515:1;2545:3;2540;2533:16;2440:123;2609:2;2604:3;2600:12;2587:26;2637:2;2632:3;2629:11;2626:37;;;2643:18;;:::i;:::-;2691:47;2734:2;2729;2724;2719:3;2715:12;2711:21;2707:30;2691:47;:::i;:::-;2676:62;;2767:3;2758:7;2751:20;2814:3;2809:2;2803:3;2798;2794:13;2790:22;2787:31;2784:124;;;2860:1;2890:3;2885;2878:16;2784:124;2966:3;2961:2;2956:3;2952:12;2947:2;2938:7;2934:16;2921:49;3018:1;2994:17;;;2990:26;;2983:37;3040:14;;;3033:31;;;;3077:18;;-1:-1:-1;;1668:12:1;;;;3115;;;;1635:1502;;;3155:5;1048:2118;-1:-1:-1;;;;;;;;;1048:2118:1:o;3171:371::-;3274:6;3327:2;3315:9;3306:7;3302:23;3298:32;3295:52;;;3343:1;3340;3333:12;3295:52;3383:9;3370:23;3416:18;3408:6;3405:30;3402:50;;;3448:1;3445;3438:12;3402:50;3471:65;3528:7;3519:6;3508:9;3504:22;3471:65;:::i;:::-;3461:75;3171:371;-1:-1:-1;;;;3171:371:1:o;3547:250::-;3632:1;3642:113;3656:6;3653:1;3650:13;3642:113;;;3732:11;;;3726:18;3713:11;;;3706:39;3678:2;3671:10;3642:113;;;-1:-1:-1;;3789:1:1;3771:16;;3764:27;3547:250::o;3802:270::-;3843:3;3881:5;3875:12;3908:6;3903:3;3896:19;3924:76;3993:6;3986:4;3981:3;3977:14;3970:4;3963:5;3959:16;3924:76;:::i;:::-;4054:2;4033:15;-1:-1:-1;;4029:29:1;4020:39;;;;4061:4;4016:50;;3802:270;-1:-1:-1;;3802:270:1:o;4077:871::-;4265:4;4313:2;4302:9;4298:18;4343:6;4332:9;4325:25;4369:2;4407;4402;4391:9;4387:18;4380:30;4430:6;4465;4459:13;4496:6;4488;4481:22;4534:2;4523:9;4519:18;4512:25;;4596:2;4586:6;4583:1;4579:14;4568:9;4564:30;4560:39;4546:53;;4634:2;4626:6;4622:15;4655:1;4665:254;4679:6;4676:1;4673:13;4665:254;;;4772:2;4768:7;4756:9;4748:6;4744:22;4740:36;4735:3;4728:49;4800:39;4832:6;4823;4817:13;4800:39;:::i;:::-;4790:49;-1:-1:-1;4897:12:1;;;;4862:15;;;;4701:1;4694:9;4665:254;;;-1:-1:-1;4936:6:1;;4077:871;-1:-1:-1;;;;;;;;4077:871:1:o;5135:532::-;5244:6;5252;5305:2;5293:9;5284:7;5280:23;5276:32;5273:52;;;5321:1;5318;5311:12;5273:52;5360:9;5347:23;5413:5;5406:13;5399:21;5392:5;5389:32;5379:60;;5435:1;5432;5425:12;5379:60;5458:5;-1:-1:-1;5514:2:1;5499:18;;5486:32;5541:18;5530:30;;5527:50;;;5573:1;5570;5563:12;5527:50;5596:65;5653:7;5644:6;5633:9;5629:22;5596:65;:::i;:::-;5586:75;;;5135:532;;;;;:::o;5672:785::-;5731:3;5769:5;5763:12;5796:6;5791:3;5784:19;5822:4;5851:2;5846:3;5842:12;5835:19;;5876:3;5916:6;5913:1;5909:14;5904:3;5900:24;5958:2;5951:5;5947:14;5979:1;5989:442;6003:6;6000:1;5997:13;5989:442;;;6064:16;;;6052:29;;6104:13;;6184:9;;6177:17;6170:25;6157:39;;6235:11;;6229:18;6140:4;6267:13;;;6260:25;;;6306:45;6337:13;;;6229:18;6306:45;:::i;:::-;6409:12;;;;6298:53;-1:-1:-1;;;6374:15:1;;;;6025:1;6018:9;5989:442;;;-1:-1:-1;6447:4:1;;5672:785;-1:-1:-1;;;;;;;5672:785:1:o;6462:453::-;6741:6;6730:9;6723:25;6784:6;6779:2;6768:9;6764:18;6757:34;6827:2;6822;6811:9;6807:18;6800:30;6704:4;6847:62;6905:2;6894:9;6890:18;6882:6;6847:62;:::i;:::-;6839:70;6462:453;-1:-1:-1;;;;;6462:453:1:o;6920:186::-;6979:6;7032:2;7020:9;7011:7;7007:23;7003:32;7000:52;;;7048:1;7045;7038:12;7000:52;7071:29;7090:9;7071:29;:::i;:::-;7061:39;6920:186;-1:-1:-1;;;6920:186:1:o;7319:311::-;7542:2;7531:9;7524:21;7505:4;7562:62;7620:2;7609:9;7605:18;7597:6;7562:62;:::i;7635:180::-;7694:6;7747:2;7735:9;7726:7;7722:23;7718:32;7715:52;;;7763:1;7760;7753:12;7715:52;-1:-1:-1;7786:23:1;;7635:180;-1:-1:-1;7635:180:1:o;7820:127::-;7881:10;7876:3;7872:20;7869:1;7862:31;7912:4;7909:1;7902:15;7936:4;7933:1;7926:15;7952:287;8081:3;8119:6;8113:13;8135:66;8194:6;8189:3;8182:4;8174:6;8170:17;8135:66;:::i;:::-;8217:16;;;;;7952:287;-1:-1:-1;;7952:287:1:o;8605:127::-;8666:10;8661:3;8657:20;8654:1;8647:31;8697:4;8694:1;8687:15;8721:4;8718:1;8711:15;8737:135;8776:3;8797:17;;;8794:43;;8817:18;;:::i;:::-;-1:-1:-1;8864:1:1;8853:13;;8737:135::o;8877:128::-;8944:9;;;8965:11;;;8962:37;;;8979:18;;:::i;:::-;8877:128;;;;:::o"
+
+// MulticallFuncSigs maps the 4-byte function signature to its string representation.
+var MulticallFuncSigs = map[string]string{
+	"252dba42": "aggregate((address,bytes)[])",
+	"c3077fa9": "blockAndAggregate((address,bytes)[])",
+	"ee82ac5e": "getBlockHash(uint256)",
+	"42cbb15c": "getBlockNumber()",
+	"a8b0574e": "getCurrentBlockCoinbase()",
+	"72425d9d": "getCurrentBlockDifficulty()",
+	"86d516e8": "getCurrentBlockGasLimit()",
+	"0f28c97d": "getCurrentBlockTimestamp()",
+	"4d2301cc": "getEthBalance(address)",
+	"27e86d6e": "getLastBlockHash()",
+	"bce38bd7": "tryAggregate(bool,(address,bytes)[])",
+	"399542e9": "tryBlockAndAggregate(bool,(address,bytes)[])",
+}
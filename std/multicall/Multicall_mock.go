@@ -0,0 +1,577 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package multicall
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+)
+
+// MulticallMock is a scriptable implementation of MulticallInterface for unit tests.
+// Results are scripted per method and arguments, for example
+//
+//	mock.OnMethod(args...).Return(results..., err)
+//
+// Calls that were not scripted return zero values and an error.
+type MulticallMock struct {
+	mu    sync.Mutex
+	calls []MulticallMockCall
+
+	onGetBlockHash              []*MulticallGetBlockHashMock
+	onGetBlockNumber            []*MulticallGetBlockNumberMock
+	onGetCurrentBlockCoinbase   []*MulticallGetCurrentBlockCoinbaseMock
+	onGetCurrentBlockDifficulty []*MulticallGetCurrentBlockDifficultyMock
+	onGetCurrentBlockGasLimit   []*MulticallGetCurrentBlockGasLimitMock
+	onGetCurrentBlockTimestamp  []*MulticallGetCurrentBlockTimestampMock
+	onGetEthBalance             []*MulticallGetEthBalanceMock
+	onGetLastBlockHash          []*MulticallGetLastBlockHashMock
+
+	onAggregate            []*MulticallAggregateMock
+	onBlockAndAggregate    []*MulticallBlockAndAggregateMock
+	onTryAggregate         []*MulticallTryAggregateMock
+	onTryBlockAndAggregate []*MulticallTryBlockAndAggregateMock
+}
+
+// This nil assignment ensures at compile time that MulticallMock implements MulticallInterface.
+var _ MulticallInterface = (*MulticallMock)(nil)
+
+// MulticallMockCall records a single call made to a MulticallMock.
+type MulticallMockCall struct {
+	Method string        // Name of the called method
+	Args   []interface{} // Arguments passed to the method, excluding opts
+}
+
+// MockCalls returns every call made to the mock so far, in order.
+func (_m *MulticallMock) MockCalls() []MulticallMockCall {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	return append([]MulticallMockCall(nil), _m.calls...)
+}
+
+// matchMulticallMockArgs compares scripted and received arguments, treating big
+// integers as equal whenever they hold the same value.
+func matchMulticallMockArgs(want, got []interface{}) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		w, wok := want[i].(*big.Int)
+		g, gok := got[i].(*big.Int)
+		if wok && gok && w != nil && g != nil {
+			if w.Cmp(g) != 0 {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(want[i], got[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// MulticallGetBlockHashMock scripts the results of MulticallMock.GetBlockHash.
+type MulticallGetBlockHashMock struct {
+	mock *MulticallMock
+	args []interface{}
+	ret0 [32]byte
+	err  error
+}
+
+// OnGetBlockHash scripts the results of GetBlockHash calls made with the given arguments.
+func (_m *MulticallMock) OnGetBlockHash(blockNumber *big.Int) *MulticallGetBlockHashMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallGetBlockHashMock{mock: _m, args: []interface{}{blockNumber}}
+	_m.onGetBlockHash = append(_m.onGetBlockHash, _e)
+	return _e
+}
+
+// Return sets the results of the GetBlockHash calls matching the scripted arguments.
+func (_e *MulticallGetBlockHashMock) Return(ret0 [32]byte, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// GetBlockHash records the call and returns the results scripted for its arguments.
+func (_m *MulticallMock) GetBlockHash(opts *bind.CallOpts, blockNumber *big.Int) ([32]byte, error) {
+	_args := []interface{}{blockNumber}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "GetBlockHash", Args: _args})
+	for _i := len(_m.onGetBlockHash) - 1; _i >= 0; _i-- {
+		if _e := _m.onGetBlockHash[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e MulticallGetBlockHashMock
+	return _e.ret0, fmt.Errorf("MulticallMock: no results scripted for GetBlockHash%v", _args)
+}
+
+// MulticallGetBlockNumberMock scripts the results of MulticallMock.GetBlockNumber.
+type MulticallGetBlockNumberMock struct {
+	mock *MulticallMock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnGetBlockNumber scripts the results of GetBlockNumber calls made with the given arguments.
+func (_m *MulticallMock) OnGetBlockNumber() *MulticallGetBlockNumberMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallGetBlockNumberMock{mock: _m, args: []interface{}{}}
+	_m.onGetBlockNumber = append(_m.onGetBlockNumber, _e)
+	return _e
+}
+
+// Return sets the results of the GetBlockNumber calls matching the scripted arguments.
+func (_e *MulticallGetBlockNumberMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// GetBlockNumber records the call and returns the results scripted for its arguments.
+func (_m *MulticallMock) GetBlockNumber(opts *bind.CallOpts) (*big.Int, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "GetBlockNumber", Args: _args})
+	for _i := len(_m.onGetBlockNumber) - 1; _i >= 0; _i-- {
+		if _e := _m.onGetBlockNumber[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e MulticallGetBlockNumberMock
+	return _e.ret0, fmt.Errorf("MulticallMock: no results scripted for GetBlockNumber%v", _args)
+}
+
+// MulticallGetCurrentBlockCoinbaseMock scripts the results of MulticallMock.GetCurrentBlockCoinbase.
+type MulticallGetCurrentBlockCoinbaseMock struct {
+	mock *MulticallMock
+	args []interface{}
+	ret0 common.Address
+	err  error
+}
+
+// OnGetCurrentBlockCoinbase scripts the results of GetCurrentBlockCoinbase calls made with the given arguments.
+func (_m *MulticallMock) OnGetCurrentBlockCoinbase() *MulticallGetCurrentBlockCoinbaseMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallGetCurrentBlockCoinbaseMock{mock: _m, args: []interface{}{}}
+	_m.onGetCurrentBlockCoinbase = append(_m.onGetCurrentBlockCoinbase, _e)
+	return _e
+}
+
+// Return sets the results of the GetCurrentBlockCoinbase calls matching the scripted arguments.
+func (_e *MulticallGetCurrentBlockCoinbaseMock) Return(ret0 common.Address, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// GetCurrentBlockCoinbase records the call and returns the results scripted for its arguments.
+func (_m *MulticallMock) GetCurrentBlockCoinbase(opts *bind.CallOpts) (common.Address, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "GetCurrentBlockCoinbase", Args: _args})
+	for _i := len(_m.onGetCurrentBlockCoinbase) - 1; _i >= 0; _i-- {
+		if _e := _m.onGetCurrentBlockCoinbase[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e MulticallGetCurrentBlockCoinbaseMock
+	return _e.ret0, fmt.Errorf("MulticallMock: no results scripted for GetCurrentBlockCoinbase%v", _args)
+}
+
+// MulticallGetCurrentBlockDifficultyMock scripts the results of MulticallMock.GetCurrentBlockDifficulty.
+type MulticallGetCurrentBlockDifficultyMock struct {
+	mock *MulticallMock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnGetCurrentBlockDifficulty scripts the results of GetCurrentBlockDifficulty calls made with the given arguments.
+func (_m *MulticallMock) OnGetCurrentBlockDifficulty() *MulticallGetCurrentBlockDifficultyMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallGetCurrentBlockDifficultyMock{mock: _m, args: []interface{}{}}
+	_m.onGetCurrentBlockDifficulty = append(_m.onGetCurrentBlockDifficulty, _e)
+	return _e
+}
+
+// Return sets the results of the GetCurrentBlockDifficulty calls matching the scripted arguments.
+func (_e *MulticallGetCurrentBlockDifficultyMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// GetCurrentBlockDifficulty records the call and returns the results scripted for its arguments.
+func (_m *MulticallMock) GetCurrentBlockDifficulty(opts *bind.CallOpts) (*big.Int, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "GetCurrentBlockDifficulty", Args: _args})
+	for _i := len(_m.onGetCurrentBlockDifficulty) - 1; _i >= 0; _i-- {
+		if _e := _m.onGetCurrentBlockDifficulty[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e MulticallGetCurrentBlockDifficultyMock
+	return _e.ret0, fmt.Errorf("MulticallMock: no results scripted for GetCurrentBlockDifficulty%v", _args)
+}
+
+// MulticallGetCurrentBlockGasLimitMock scripts the results of MulticallMock.GetCurrentBlockGasLimit.
+type MulticallGetCurrentBlockGasLimitMock struct {
+	mock *MulticallMock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnGetCurrentBlockGasLimit scripts the results of GetCurrentBlockGasLimit calls made with the given arguments.
+func (_m *MulticallMock) OnGetCurrentBlockGasLimit() *MulticallGetCurrentBlockGasLimitMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallGetCurrentBlockGasLimitMock{mock: _m, args: []interface{}{}}
+	_m.onGetCurrentBlockGasLimit = append(_m.onGetCurrentBlockGasLimit, _e)
+	return _e
+}
+
+// Return sets the results of the GetCurrentBlockGasLimit calls matching the scripted arguments.
+func (_e *MulticallGetCurrentBlockGasLimitMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// GetCurrentBlockGasLimit records the call and returns the results scripted for its arguments.
+func (_m *MulticallMock) GetCurrentBlockGasLimit(opts *bind.CallOpts) (*big.Int, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "GetCurrentBlockGasLimit", Args: _args})
+	for _i := len(_m.onGetCurrentBlockGasLimit) - 1; _i >= 0; _i-- {
+		if _e := _m.onGetCurrentBlockGasLimit[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e MulticallGetCurrentBlockGasLimitMock
+	return _e.ret0, fmt.Errorf("MulticallMock: no results scripted for GetCurrentBlockGasLimit%v", _args)
+}
+
+// MulticallGetCurrentBlockTimestampMock scripts the results of MulticallMock.GetCurrentBlockTimestamp.
+type MulticallGetCurrentBlockTimestampMock struct {
+	mock *MulticallMock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnGetCurrentBlockTimestamp scripts the results of GetCurrentBlockTimestamp calls made with the given arguments.
+func (_m *MulticallMock) OnGetCurrentBlockTimestamp() *MulticallGetCurrentBlockTimestampMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallGetCurrentBlockTimestampMock{mock: _m, args: []interface{}{}}
+	_m.onGetCurrentBlockTimestamp = append(_m.onGetCurrentBlockTimestamp, _e)
+	return _e
+}
+
+// Return sets the results of the GetCurrentBlockTimestamp calls matching the scripted arguments.
+func (_e *MulticallGetCurrentBlockTimestampMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// GetCurrentBlockTimestamp records the call and returns the results scripted for its arguments.
+func (_m *MulticallMock) GetCurrentBlockTimestamp(opts *bind.CallOpts) (*big.Int, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "GetCurrentBlockTimestamp", Args: _args})
+	for _i := len(_m.onGetCurrentBlockTimestamp) - 1; _i >= 0; _i-- {
+		if _e := _m.onGetCurrentBlockTimestamp[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e MulticallGetCurrentBlockTimestampMock
+	return _e.ret0, fmt.Errorf("MulticallMock: no results scripted for GetCurrentBlockTimestamp%v", _args)
+}
+
+// MulticallGetEthBalanceMock scripts the results of MulticallMock.GetEthBalance.
+type MulticallGetEthBalanceMock struct {
+	mock *MulticallMock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnGetEthBalance scripts the results of GetEthBalance calls made with the given arguments.
+func (_m *MulticallMock) OnGetEthBalance(addr common.Address) *MulticallGetEthBalanceMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallGetEthBalanceMock{mock: _m, args: []interface{}{addr}}
+	_m.onGetEthBalance = append(_m.onGetEthBalance, _e)
+	return _e
+}
+
+// Return sets the results of the GetEthBalance calls matching the scripted arguments.
+func (_e *MulticallGetEthBalanceMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// GetEthBalance records the call and returns the results scripted for its arguments.
+func (_m *MulticallMock) GetEthBalance(opts *bind.CallOpts, addr common.Address) (*big.Int, error) {
+	_args := []interface{}{addr}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "GetEthBalance", Args: _args})
+	for _i := len(_m.onGetEthBalance) - 1; _i >= 0; _i-- {
+		if _e := _m.onGetEthBalance[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e MulticallGetEthBalanceMock
+	return _e.ret0, fmt.Errorf("MulticallMock: no results scripted for GetEthBalance%v", _args)
+}
+
+// MulticallGetLastBlockHashMock scripts the results of MulticallMock.GetLastBlockHash.
+type MulticallGetLastBlockHashMock struct {
+	mock *MulticallMock
+	args []interface{}
+	ret0 [32]byte
+	err  error
+}
+
+// OnGetLastBlockHash scripts the results of GetLastBlockHash calls made with the given arguments.
+func (_m *MulticallMock) OnGetLastBlockHash() *MulticallGetLastBlockHashMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallGetLastBlockHashMock{mock: _m, args: []interface{}{}}
+	_m.onGetLastBlockHash = append(_m.onGetLastBlockHash, _e)
+	return _e
+}
+
+// Return sets the results of the GetLastBlockHash calls matching the scripted arguments.
+func (_e *MulticallGetLastBlockHashMock) Return(ret0 [32]byte, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// GetLastBlockHash records the call and returns the results scripted for its arguments.
+func (_m *MulticallMock) GetLastBlockHash(opts *bind.CallOpts) ([32]byte, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "GetLastBlockHash", Args: _args})
+	for _i := len(_m.onGetLastBlockHash) - 1; _i >= 0; _i-- {
+		if _e := _m.onGetLastBlockHash[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e MulticallGetLastBlockHashMock
+	return _e.ret0, fmt.Errorf("MulticallMock: no results scripted for GetLastBlockHash%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// MulticallAggregateMock scripts the results of MulticallMock.Aggregate.
+type MulticallAggregateMock struct {
+	mock *MulticallMock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnAggregate scripts the results of Aggregate transactions made with the given arguments.
+func (_m *MulticallMock) OnAggregate(calls []Multicall2Call) *MulticallAggregateMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallAggregateMock{mock: _m, args: []interface{}{calls}}
+	_m.onAggregate = append(_m.onAggregate, _e)
+	return _e
+}
+
+// Return sets the results of the Aggregate transactions matching the scripted arguments.
+func (_e *MulticallAggregateMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Aggregate records the transaction and returns the results scripted for its arguments.
+func (_m *MulticallMock) Aggregate(opts *bind.TransactOpts, calls []Multicall2Call) (*types.Transaction, error) {
+	_args := []interface{}{calls}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "Aggregate", Args: _args})
+	for _i := len(_m.onAggregate) - 1; _i >= 0; _i-- {
+		if _e := _m.onAggregate[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("MulticallMock: no results scripted for Aggregate%v", _args)
+}
+
+// MulticallBlockAndAggregateMock scripts the results of MulticallMock.BlockAndAggregate.
+type MulticallBlockAndAggregateMock struct {
+	mock *MulticallMock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnBlockAndAggregate scripts the results of BlockAndAggregate transactions made with the given arguments.
+func (_m *MulticallMock) OnBlockAndAggregate(calls []Multicall2Call) *MulticallBlockAndAggregateMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallBlockAndAggregateMock{mock: _m, args: []interface{}{calls}}
+	_m.onBlockAndAggregate = append(_m.onBlockAndAggregate, _e)
+	return _e
+}
+
+// Return sets the results of the BlockAndAggregate transactions matching the scripted arguments.
+func (_e *MulticallBlockAndAggregateMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// BlockAndAggregate records the transaction and returns the results scripted for its arguments.
+func (_m *MulticallMock) BlockAndAggregate(opts *bind.TransactOpts, calls []Multicall2Call) (*types.Transaction, error) {
+	_args := []interface{}{calls}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "BlockAndAggregate", Args: _args})
+	for _i := len(_m.onBlockAndAggregate) - 1; _i >= 0; _i-- {
+		if _e := _m.onBlockAndAggregate[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("MulticallMock: no results scripted for BlockAndAggregate%v", _args)
+}
+
+// MulticallTryAggregateMock scripts the results of MulticallMock.TryAggregate.
+type MulticallTryAggregateMock struct {
+	mock *MulticallMock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnTryAggregate scripts the results of TryAggregate transactions made with the given arguments.
+func (_m *MulticallMock) OnTryAggregate(requireSuccess bool, calls []Multicall2Call) *MulticallTryAggregateMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallTryAggregateMock{mock: _m, args: []interface{}{requireSuccess, calls}}
+	_m.onTryAggregate = append(_m.onTryAggregate, _e)
+	return _e
+}
+
+// Return sets the results of the TryAggregate transactions matching the scripted arguments.
+func (_e *MulticallTryAggregateMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// TryAggregate records the transaction and returns the results scripted for its arguments.
+func (_m *MulticallMock) TryAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error) {
+	_args := []interface{}{requireSuccess, calls}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "TryAggregate", Args: _args})
+	for _i := len(_m.onTryAggregate) - 1; _i >= 0; _i-- {
+		if _e := _m.onTryAggregate[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("MulticallMock: no results scripted for TryAggregate%v", _args)
+}
+
+// MulticallTryBlockAndAggregateMock scripts the results of MulticallMock.TryBlockAndAggregate.
+type MulticallTryBlockAndAggregateMock struct {
+	mock *MulticallMock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnTryBlockAndAggregate scripts the results of TryBlockAndAggregate transactions made with the given arguments.
+func (_m *MulticallMock) OnTryBlockAndAggregate(requireSuccess bool, calls []Multicall2Call) *MulticallTryBlockAndAggregateMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &MulticallTryBlockAndAggregateMock{mock: _m, args: []interface{}{requireSuccess, calls}}
+	_m.onTryBlockAndAggregate = append(_m.onTryBlockAndAggregate, _e)
+	return _e
+}
+
+// Return sets the results of the TryBlockAndAggregate transactions matching the scripted arguments.
+func (_e *MulticallTryBlockAndAggregateMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// TryBlockAndAggregate records the transaction and returns the results scripted for its arguments.
+func (_m *MulticallMock) TryBlockAndAggregate(opts *bind.TransactOpts, requireSuccess bool, calls []Multicall2Call) (*types.Transaction, error) {
+	_args := []interface{}{requireSuccess, calls}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, MulticallMockCall{Method: "TryBlockAndAggregate", Args: _args})
+	for _i := len(_m.onTryBlockAndAggregate) - 1; _i >= 0; _i-- {
+		if _e := _m.onTryBlockAndAggregate[_i]; matchMulticallMockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("MulticallMock: no results scripted for TryBlockAndAggregate%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
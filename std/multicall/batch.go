@@ -0,0 +1,77 @@
+package multicall
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// Address is where Multicall2 is deployed on mainnet, and where
+// sim.WithMulticall of github.com/evan-forbes/buddy/sim predeploys it.
+var Address = common.HexToAddress("0x5BA1e12693Dc8F9c48aAD8770482f4739bEeD696")
+
+// Batch queues read calls to make them in a single aggregate call, instead of a
+// round trip each.
+//
+//	batch := multicall.NewBatch(mc)
+//	var balance *big.Int
+//	batch.Add(token, data, func(ret []byte) (err error) {
+//		balance, err = erc20.UnpackERC20BalanceOfOutput(ret)
+//		return err
+//	})
+//	_, err := batch.Call(nil)
+type Batch struct {
+	multicall *Multicall
+	calls     []Multicall2Call
+	decoders  []func([]byte) error
+}
+
+// NewBatch creates an empty batch aggregating calls through multicall.
+func NewBatch(multicall *Multicall) *Batch {
+	return &Batch{multicall: multicall}
+}
+
+// Add queues a call of target with data, typically built by a generated Pack
+// helper. decode, which may be nil, is given the data the call returns, to pass
+// it to the matching generated Unpack output helper.
+func (b *Batch) Add(target common.Address, data []byte, decode func([]byte) error) {
+	b.calls = append(b.calls, Multicall2Call{Target: target, CallData: data})
+	b.decoders = append(b.decoders, decode)
+}
+
+// Len returns the number of queued calls.
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Reset empties the batch so it can be reused.
+func (b *Batch) Reset() {
+	b.calls, b.decoders = nil, nil
+}
+
+// Call makes every queued call in one aggregate call and decodes their results
+// in order, returning the number of the block they were made at. It fails
+// without decoding anything if any of the calls reverts.
+func (b *Batch) Call(opts *bind.CallOpts) (*big.Int, error) {
+	ret := new(struct {
+		BlockNumber *big.Int
+		ReturnData  [][]byte
+	})
+	if err := b.multicall.bound().Call(opts, ret, "aggregate", b.calls); err != nil {
+		return nil, unpackMulticallError(err)
+	}
+	if len(ret.ReturnData) != len(b.calls) {
+		return nil, errors.Errorf("multicall returned %d results for %d calls", len(ret.ReturnData), len(b.calls))
+	}
+	for i, decode := range b.decoders {
+		if decode == nil {
+			continue
+		}
+		if err := decode(ret.ReturnData[i]); err != nil {
+			return nil, errors.Wrapf(err, "call %d to %x", i, b.calls[i].Target)
+		}
+	}
+	return ret.BlockNumber, nil
+}
@@ -0,0 +1,106 @@
+package multicall_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evan-forbes/buddy/sim"
+	"github.com/evan-forbes/buddy/std/erc20"
+	"github.com/evan-forbes/buddy/std/multicall"
+)
+
+func TestBatch(t *testing.T) {
+	accounts := sim.NewAccounts("alice", "bob", "carol")
+	backend := sim.NewSimulatedBackend(sim.WithMulticall(accounts.Genesis()), 10000000)
+	defer backend.Close()
+	alice, bob, carol := accounts["alice"], accounts["bob"], accounts["carol"]
+
+	token, _, erc, err := erc20.DeployERC20(alice.TxOpts, backend, "Buddy", "BUD", 18, big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if _, err := erc.Transfer(alice.TxOpts, bob.Address, big.NewInt(300)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	// sim predeploys the multicall bound here
+	if sim.MulticallAddress != multicall.Address {
+		t.Fatalf("sim predeploys the multicall at %x, want %x", sim.MulticallAddress, multicall.Address)
+	}
+	if code, err := backend.CodeAt(context.Background(), multicall.Address, nil); err != nil || !bytes.Equal(code, common.FromHex(multicall.MulticallRuntimeBin)) {
+		t.Fatalf("sim predeploys a different multicall (%v), regenerate its bytecode", err)
+	}
+	mc, err := multicall.NewMulticall(multicall.Address, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := multicall.NewBatch(mc)
+	balances := make([]*big.Int, 3)
+	for i, account := range []*sim.Account{alice, bob, carol} {
+		i := i
+		data, err := erc20.PackERC20BalanceOf(account.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch.Add(token, data, func(ret []byte) (err error) {
+			balances[i], err = erc20.UnpackERC20BalanceOfOutput(ret)
+			return err
+		})
+	}
+	var symbol string
+	data, err := erc20.PackERC20Symbol()
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch.Add(token, data, func(ret []byte) (err error) {
+		symbol, err = erc20.UnpackERC20SymbolOutput(ret)
+		return err
+	})
+	var ether *big.Int
+	if data, err = multicall.PackMulticallGetEthBalance(carol.Address); err != nil {
+		t.Fatal(err)
+	}
+	batch.Add(multicall.Address, data, func(ret []byte) (err error) {
+		ether, err = multicall.UnpackMulticallGetEthBalanceOutput(ret)
+		return err
+	})
+
+	number, err := batch.Call(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number.Int64() != 2 {
+		t.Errorf("calls were made at block %v, want 2", number)
+	}
+	for i, want := range []int64{700, 300, 0} {
+		if balances[i] == nil || balances[i].Int64() != want {
+			t.Errorf("balance %d = %v, want %d", i, balances[i], want)
+		}
+	}
+	if symbol != "BUD" {
+		t.Errorf("symbol = %q", symbol)
+	}
+	if want, err := backend.BalanceAt(context.Background(), carol.Address, nil); err != nil || ether == nil || ether.Cmp(want) != 0 {
+		t.Errorf("ether balance = %v, want %v (%v)", ether, want, err)
+	}
+
+	// a reverting call fails the whole batch
+	if data, err = erc20.PackERC20Transfer(bob.Address, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	batch.Add(token, data, nil)
+	var revert multicall.MulticallRevert
+	if _, err := batch.Call(nil); !errors.As(err, &revert) || revert.Reason != "Multicall aggregate: call failed" {
+		t.Errorf("calling a reverting batch failed with %v", err)
+	}
+	batch.Reset()
+	if batch.Len() != 0 {
+		t.Errorf("reset batch holds %d calls", batch.Len())
+	}
+}
@@ -0,0 +1,78 @@
+package multicall_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evan-forbes/buddy/sim"
+	"github.com/evan-forbes/buddy/std/erc20"
+	"github.com/evan-forbes/buddy/std/multicall"
+)
+
+func TestMulticall(t *testing.T) {
+	accounts := sim.NewAccounts("alice", "bob")
+	backend := sim.NewSimulatedBackend(accounts.Genesis(), 10000000)
+	defer backend.Close()
+	alice, bob := accounts["alice"], accounts["bob"]
+
+	address, _, mc, err := multicall.DeployMulticall(alice.TxOpts, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, _, _, err := erc20.DeployERC20(alice.TxOpts, backend, "Buddy", "BUD", 18, big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	balanceOf, err := erc20.PackERC20BalanceOf(alice.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ethBalance, err := multicall.PackMulticallGetEthBalance(bob.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggregated, err := mc.SimulateAggregate(alice.TxOpts, []multicall.Multicall2Call{{Target: token, CallData: balanceOf}, {Target: address, CallData: ethBalance}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// simulations run on the pending block
+	if aggregated.BlockNumber.Int64() != 2 || len(aggregated.ReturnData) != 2 {
+		t.Fatalf("unexpected aggregate %+v", aggregated)
+	}
+	if balance := new(big.Int).SetBytes(aggregated.ReturnData[0]); balance.Int64() != 1000 {
+		t.Errorf("token balance = %v, want 1000", balance)
+	}
+	if balance, err := backend.BalanceAt(context.Background(), bob.Address, nil); err != nil || new(big.Int).SetBytes(aggregated.ReturnData[1]).Cmp(balance) != 0 {
+		t.Errorf("ether balance = %x, want %v (%v)", aggregated.ReturnData[1], balance, err)
+	}
+
+	// calls are made by the multicall, which holds no tokens
+	transfer, err := erc20.PackERC20Transfer(bob.Address, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := []multicall.Multicall2Call{{Target: token, CallData: balanceOf}, {Target: token, CallData: transfer}, {Target: common.Address{}, CallData: nil}}
+	if _, err := mc.SimulateAggregate(alice.TxOpts, calls); err == nil {
+		t.Errorf("aggregating a failing call succeeded")
+	}
+	if _, err := mc.SimulateTryAggregate(alice.TxOpts, true, calls); err == nil {
+		t.Errorf("requiring a failing call to succeed succeeded")
+	}
+	results, err := mc.SimulateTryAggregate(alice.TxOpts, false, calls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 || !results[0].Success || results[1].Success || !results[2].Success {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if len(results[1].ReturnData) < 4 || common.Bytes2Hex(results[1].ReturnData[:4]) != "08c379a0" {
+		t.Errorf("failed call returned %x, want a revert reason", results[1].ReturnData)
+	}
+	if len(results[2].ReturnData) != 0 {
+		t.Errorf("calling an account without code returned %x", results[2].ReturnData)
+	}
+}
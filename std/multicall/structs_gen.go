@@ -0,0 +1,31 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package multicall
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+)
+
+//////////////////////////////////////////////////////
+//		Structs
+////////////////////////////////////////////////////
+
+// Multicall2Call is an auto generated low-level Go binding around an user-defined struct.
+type Multicall2Call struct {
+	Target   common.Address `json:"target"`
+	CallData []byte         `json:"callData"`
+}
+
+// Multicall2Result is an auto generated low-level Go binding around an user-defined struct.
+type Multicall2Result struct {
+	Success    bool   `json:"success"`
+	ReturnData []byte `json:"returnData"`
+}
@@ -0,0 +1,72 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package std
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+)
+
+// ERC1155 lists the calls and transactions of the ERC1155 standard (EIP-1155).
+//
+// Events:
+//   - event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+//   - event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+//   - event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+//   - event URI(string value, uint256 indexed id)
+type ERC1155 interface {
+	BalanceOf(opts *bind.CallOpts, account common.Address, id *big.Int) (*big.Int, error)
+	BalanceOfBatch(opts *bind.CallOpts, accounts []common.Address, ids []*big.Int) ([]*big.Int, error)
+	IsApprovedForAll(opts *bind.CallOpts, account common.Address, operator common.Address) (bool, error)
+	SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error)
+
+	SafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error)
+	SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error)
+	SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error)
+}
+
+// ERC20 lists the calls and transactions of the ERC20 standard (EIP-20).
+//
+// Events:
+//   - event Approval(address indexed owner, address indexed spender, uint256 value)
+//   - event Transfer(address indexed from, address indexed to, uint256 value)
+type ERC20 interface {
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+
+	Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error)
+}
+
+// ERC721 lists the calls and transactions of the ERC721 standard (EIP-721).
+//
+// Events:
+//   - event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+//   - event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+//   - event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+type ERC721 interface {
+	BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error)
+	GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error)
+	IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error)
+	OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error)
+	SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error)
+
+	Approve(opts *bind.TransactOpts, approved common.Address, tokenId *big.Int) (*types.Transaction, error)
+	SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error)
+	SafeTransferFromWithData(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error)
+	SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error)
+}
@@ -0,0 +1,13 @@
+// Package std declares the Go interfaces of the token standards ABIs are checked
+// against with buddy abigen --conforms. Bindings of conforming ABIs assert at
+// compile time that they implement them. standards_gen.go is generated by
+// bind.BindStandards; run go generate to refresh it along with the packages below.
+//
+// The packages under std bind contracts implementing the standards, along with
+// WETH9 and Multicall2, and carry their deploy bytecode. Their Solidity sources
+// are under contracts/ and are compiled with solc and bound as declared in
+// buddy.toml.
+package std
+
+//go:generate go run ./internal/standards
+//go:generate go run github.com/evan-forbes/buddy generate --manifest buddy.toml
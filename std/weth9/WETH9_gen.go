@@ -0,0 +1,2044 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package weth9
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/evan-forbes/buddy/std"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = context.Background
+	_ = errors.New
+	_ = json.Marshal
+	_ = fmt.Errorf
+	_ = big.NewInt
+	_ = reflect.ValueOf
+	_ = strings.NewReader
+	_ = sync.NewCond
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = hexutil.Encode
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// WETH9 is a wrapper around BoundContract, enforcing type checking and including
+// QoL helper methods
+type WETH9 struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+	abi      abi.ABI             // Parsed ABI, used to replay failed transactions
+	address  common.Address      // Address the contract is deployed at
+	backend  bind.ContractBackend
+}
+
+// NewWETH9 creates a new instance of WETH9, bound to a specific deployed contract.
+func NewWETH9(address common.Address, backend bind.ContractBackend) (*WETH9, error) {
+	a, err := parseWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(address, a, backend, backend, backend)
+	return &WETH9{contract: contract, abi: a, address: address, backend: backend}, nil
+}
+
+// parseWETH9ABI parses WETH9ABI, keying overloaded methods by their full
+// signature so that every binding calls the exact overload it was generated for.
+func parseWETH9ABI() (abi.ABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(WETH9ABI))
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	overloads := make(map[string]int)
+	for _, method := range parsed.Methods {
+		overloads[method.RawName]++
+	}
+	methods := make(map[string]abi.Method, len(parsed.Methods))
+	for name, method := range parsed.Methods {
+		if overloads[method.RawName] > 1 {
+			name = method.Sig()
+		}
+		methods[name] = method
+	}
+	parsed.Methods = methods
+	return parsed, nil
+}
+
+// bound exposes the underlying BoundContract used for the low level calls
+func (_WETH9 *WETH9) bound() *bind.BoundContract {
+	return _WETH9.contract
+}
+
+// transact invokes method. Should that fail while estimating gas, the invocation
+// is replayed as a call to recover the revert data behind the failure.
+func (_WETH9 *WETH9) transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	tx, err := _WETH9.contract.Transact(opts, method, params...)
+	if err == nil || opts.GasLimit != 0 {
+		return tx, err
+	}
+	msg, perr := _WETH9.message(opts, method, params...)
+	if perr != nil {
+		return nil, err
+	}
+	if _, cerr := _WETH9.backend.CallContract(contextOfWETH9(opts), msg, nil); cerr != nil {
+		if decoded := unpackWETH9Error(cerr); decoded != cerr {
+			return nil, decoded
+		}
+	}
+	return nil, err
+}
+
+// estimate estimates the gas needed to invoke method with the given options.
+func (_WETH9 *WETH9) estimate(opts *bind.TransactOpts, method string, params ...interface{}) (uint64, error) {
+	msg, err := _WETH9.message(opts, method, params...)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := _WETH9.backend.EstimateGas(contextOfWETH9(opts), msg)
+	return gas, unpackWETH9Error(err)
+}
+
+// simulate runs method as an eth_call from opts.From against the pending state,
+// unpacking its outputs into out, if any.
+func (_WETH9 *WETH9) simulate(opts *bind.TransactOpts, out interface{}, method string, params ...interface{}) error {
+	msg, err := _WETH9.message(opts, method, params...)
+	if err != nil {
+		return err
+	}
+	var output []byte
+	if pending, ok := _WETH9.backend.(bind.PendingContractCaller); ok {
+		output, err = pending.PendingCallContract(contextOfWETH9(opts), msg)
+	} else {
+		output, err = _WETH9.backend.CallContract(contextOfWETH9(opts), msg, nil)
+	}
+	if err != nil {
+		return unpackWETH9Error(err)
+	}
+	if out == nil {
+		return nil
+	}
+	return _WETH9.abi.Unpack(out, method, output)
+}
+
+// message packs an invocation of method into a call message sent with opts.
+func (_WETH9 *WETH9) message(opts *bind.TransactOpts, method string, params ...interface{}) (ethereum.CallMsg, error) {
+	if opts == nil {
+		return ethereum.CallMsg{}, errors.New("no transact options to invoke WETH9." + method + " with")
+	}
+	input, err := _WETH9.abi.Pack(method, params...)
+	if err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	return ethereum.CallMsg{
+		From:     opts.From,
+		To:       &_WETH9.address,
+		Gas:      opts.GasLimit,
+		GasPrice: opts.GasPrice,
+		Value:    opts.Value,
+		Data:     input,
+	}, nil
+}
+
+// contextOfWETH9 returns the context of opts, defaulting to the background one.
+func contextOfWETH9(opts *bind.TransactOpts) context.Context {
+	if opts.Context == nil {
+		return context.Background()
+	}
+	return opts.Context
+}
+
+//////////////////////////////////////////////////////
+//		Interface
+////////////////////////////////////////////////////
+
+// WETH9Interface lists every call, transaction and log unpacker of WETH9.
+// It is regenerated along with the binding, so it can't drift from the ABI.
+type WETH9Interface interface {
+	Allowance(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error)
+	BalanceOf(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	Decimals(opts *bind.CallOpts) (uint8, error)
+	Name(opts *bind.CallOpts) (string, error)
+	Symbol(opts *bind.CallOpts) (string, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+
+	Approve(opts *bind.TransactOpts, guy common.Address, wad *big.Int) (*types.Transaction, error)
+	Deposit(opts *bind.TransactOpts) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, dst common.Address, wad *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, src common.Address, dst common.Address, wad *big.Int) (*types.Transaction, error)
+	Withdraw(opts *bind.TransactOpts, wad *big.Int) (*types.Transaction, error)
+
+	UnpackApprovalLog(log types.Log) (*ApprovalLog, error)
+	UnpackDepositLog(log types.Log) (*DepositLog, error)
+	UnpackTransferLog(log types.Log) (*TransferLog, error)
+	UnpackWithdrawalLog(log types.Log) (*WithdrawalLog, error)
+}
+
+// This nil assignment ensures at compile time that WETH9 implements WETH9Interface.
+var _ WETH9Interface = (*WETH9)(nil)
+
+// This nil assignment ensures at compile time that WETH9 implements std.ERC20, the
+// standard its ABI conforms to.
+var _ std.ERC20 = (*WETH9)(nil)
+
+//////////////////////////////////////////////////////
+//		Deployment
+////////////////////////////////////////////////////
+
+// DeployWETH9 deploys a new Ethereum contract, binding an instance of WETH9 to it.
+func DeployWETH9(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *WETH9, error) {
+	parsed, err := parseWETH9ABI()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(WETH9Bin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &WETH9{contract: contract, abi: parsed, address: address, backend: backend}, nil
+}
+
+// ErrWETH9CodeMismatch is returned by NewWETH9Verified when the code deployed
+// at an address isn't the one of WETH9.
+var ErrWETH9CodeMismatch = errors.New("code does not match WETH9Bin")
+
+// NewWETH9Verified binds an instance of WETH9, after checking that the code
+// deployed at address is the runtime code of WETH9Bin.
+func NewWETH9Verified(ctx context.Context, address common.Address, backend bind.ContractBackend) (*WETH9, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w: %s", bind.ErrNoCode, address.Hex())
+	}
+	if !matchWETH9Code(code) {
+		return nil, fmt.Errorf("%w: %s", ErrWETH9CodeMismatch, address.Hex())
+	}
+	return NewWETH9(address, backend)
+}
+
+// matchWETH9Code reports whether code is the runtime code embedded in WETH9Bin.
+// The metadata hash is ignored, as are the zeroed regions the bytecode reserves
+// for immutables and linked library addresses.
+func matchWETH9Code(code []byte) bool {
+	template := WETH9Bin
+
+	bin := common.FromHex(template)
+
+	// Strip the CBOR encoded metadata, its length being in the last two bytes
+	if n := len(code); n > 2 {
+		if meta := int(code[n-2])<<8 | int(code[n-1]); meta+2 < n && code[n-2-meta]&0xe0 == 0xa0 {
+			code = code[:n-2-meta]
+		}
+	}
+	// Mask the runs of zeros long enough to hold an address or an immutable
+	masked := make([]bool, len(bin))
+	for start := 0; start < len(bin); {
+		end := start
+		for end < len(bin) && bin[end] == 0 {
+			end++
+		}
+		if end-start >= common.AddressLength {
+			for i := start; i < end; i++ {
+				masked[i] = true
+			}
+		}
+		start = end + 1
+	}
+	// Look for the runtime code within the creation code
+	for offset := 0; len(code) > 0 && offset+len(code) <= len(bin); offset++ {
+		matched := true
+		for i := range code {
+			if code[i] != bin[offset+i] && !masked[offset+i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+// - Solidity: function allowance(address , address ) constant returns(uint256)
+func (_WETH9 *WETH9) Allowance(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _WETH9.bound().Call(opts, out, "allowance", arg0, arg1)
+	return *ret0, unpackWETH9Error(err)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+// - Solidity: function balanceOf(address ) constant returns(uint256)
+func (_WETH9 *WETH9) BalanceOf(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _WETH9.bound().Call(opts, out, "balanceOf", arg0)
+	return *ret0, unpackWETH9Error(err)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+// - Solidity: function decimals() constant returns(uint8)
+func (_WETH9 *WETH9) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var (
+		ret0 = new(uint8)
+	)
+	out := ret0
+	err := _WETH9.bound().Call(opts, out, "decimals")
+	return *ret0, unpackWETH9Error(err)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+// - Solidity: function name() constant returns(string)
+func (_WETH9 *WETH9) Name(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _WETH9.bound().Call(opts, out, "name")
+	return *ret0, unpackWETH9Error(err)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// - Solidity: function symbol() constant returns(string)
+func (_WETH9 *WETH9) Symbol(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _WETH9.bound().Call(opts, out, "symbol")
+	return *ret0, unpackWETH9Error(err)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+// - Solidity: function totalSupply() constant returns(uint256)
+func (_WETH9 *WETH9) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _WETH9.bound().Call(opts, out, "totalSupply")
+	return *ret0, unpackWETH9Error(err)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+// - Solidity: function approve(address guy, uint256 wad) returns(bool)
+func (_WETH9 *WETH9) Approve(opts *bind.TransactOpts, guy common.Address, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.transact(opts, "approve", guy, wad)
+}
+
+// EstimateApprove estimates the gas needed to invoke the contract method 0x095ea7b3.
+func (_WETH9 *WETH9) EstimateApprove(opts *bind.TransactOpts, guy common.Address, wad *big.Int) (uint64, error) {
+	return _WETH9.estimate(opts, "approve", guy, wad)
+}
+
+// SimulateApprove runs the contract method 0x095ea7b3 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_WETH9 *WETH9) SimulateApprove(opts *bind.TransactOpts, guy common.Address, wad *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _WETH9.simulate(opts, out, "approve", guy, wad)
+	return *ret0, err
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+// - Solidity: function deposit() returns()
+func (_WETH9 *WETH9) Deposit(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WETH9.transact(opts, "deposit")
+}
+
+// EstimateDeposit estimates the gas needed to invoke the contract method 0xd0e30db0.
+func (_WETH9 *WETH9) EstimateDeposit(opts *bind.TransactOpts) (uint64, error) {
+	return _WETH9.estimate(opts, "deposit")
+}
+
+// SimulateDeposit runs the contract method 0xd0e30db0 as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_WETH9 *WETH9) SimulateDeposit(opts *bind.TransactOpts) error {
+	return _WETH9.simulate(opts, nil, "deposit")
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+// - Solidity: function transfer(address dst, uint256 wad) returns(bool)
+func (_WETH9 *WETH9) Transfer(opts *bind.TransactOpts, dst common.Address, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.transact(opts, "transfer", dst, wad)
+}
+
+// EstimateTransfer estimates the gas needed to invoke the contract method 0xa9059cbb.
+func (_WETH9 *WETH9) EstimateTransfer(opts *bind.TransactOpts, dst common.Address, wad *big.Int) (uint64, error) {
+	return _WETH9.estimate(opts, "transfer", dst, wad)
+}
+
+// SimulateTransfer runs the contract method 0xa9059cbb as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_WETH9 *WETH9) SimulateTransfer(opts *bind.TransactOpts, dst common.Address, wad *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _WETH9.simulate(opts, out, "transfer", dst, wad)
+	return *ret0, err
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+// - Solidity: function transferFrom(address src, address dst, uint256 wad) returns(bool)
+func (_WETH9 *WETH9) TransferFrom(opts *bind.TransactOpts, src common.Address, dst common.Address, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.transact(opts, "transferFrom", src, dst, wad)
+}
+
+// EstimateTransferFrom estimates the gas needed to invoke the contract method 0x23b872dd.
+func (_WETH9 *WETH9) EstimateTransferFrom(opts *bind.TransactOpts, src common.Address, dst common.Address, wad *big.Int) (uint64, error) {
+	return _WETH9.estimate(opts, "transferFrom", src, dst, wad)
+}
+
+// SimulateTransferFrom runs the contract method 0x23b872dd as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_WETH9 *WETH9) SimulateTransferFrom(opts *bind.TransactOpts, src common.Address, dst common.Address, wad *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _WETH9.simulate(opts, out, "transferFrom", src, dst, wad)
+	return *ret0, err
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+// - Solidity: function withdraw(uint256 wad) returns()
+func (_WETH9 *WETH9) Withdraw(opts *bind.TransactOpts, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.transact(opts, "withdraw", wad)
+}
+
+// EstimateWithdraw estimates the gas needed to invoke the contract method 0x2e1a7d4d.
+func (_WETH9 *WETH9) EstimateWithdraw(opts *bind.TransactOpts, wad *big.Int) (uint64, error) {
+	return _WETH9.estimate(opts, "withdraw", wad)
+}
+
+// SimulateWithdraw runs the contract method 0x2e1a7d4d as a call from opts.From,
+// returning its outputs or the error it reverts with, without sending a transaction.
+func (_WETH9 *WETH9) SimulateWithdraw(opts *bind.TransactOpts, wad *big.Int) error {
+	return _WETH9.simulate(opts, nil, "withdraw", wad)
+}
+
+//////////////////////////////////////////////////////
+//		Sessions
+////////////////////////////////////////////////////
+
+// WETH9Session binds WETH9 to default call and transact options, exposing
+// every method without the opts argument.
+type WETH9Session struct {
+	Contract     *WETH9            // Contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// WETH9CallerSession binds WETH9 to default call options, exposing every
+// call without the opts argument.
+type WETH9CallerSession struct {
+	Contract *WETH9        // Contract binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// WETH9TransactorSession binds WETH9 to default transact options, exposing
+// every transaction without the opts argument.
+type WETH9TransactorSession struct {
+	Contract     *WETH9            // Contract binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NewWETH9Session opens a session on contract, sending transactions with auth
+// and making calls from the same account.
+func NewWETH9Session(contract *WETH9, auth *bind.TransactOpts) *WETH9Session {
+	return &WETH9Session{
+		Contract:     contract,
+		CallOpts:     bind.CallOpts{From: auth.From, Context: auth.Context},
+		TransactOpts: *auth,
+	}
+}
+
+// WETH9SessionInterface is the opts-free counterpart of WETH9Interface.
+type WETH9SessionInterface interface {
+	Allowance(arg0 common.Address, arg1 common.Address) (*big.Int, error)
+	BalanceOf(arg0 common.Address) (*big.Int, error)
+	Decimals() (uint8, error)
+	Name() (string, error)
+	Symbol() (string, error)
+	TotalSupply() (*big.Int, error)
+
+	Approve(guy common.Address, wad *big.Int) (*types.Transaction, error)
+	Deposit() (*types.Transaction, error)
+	Transfer(dst common.Address, wad *big.Int) (*types.Transaction, error)
+	TransferFrom(src common.Address, dst common.Address, wad *big.Int) (*types.Transaction, error)
+	Withdraw(wad *big.Int) (*types.Transaction, error)
+}
+
+// This nil assignment ensures at compile time that WETH9Session implements WETH9SessionInterface.
+var _ WETH9SessionInterface = (*WETH9Session)(nil)
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+func (_WETH9 *WETH9Session) Allowance(arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	return _WETH9.Contract.Allowance(&_WETH9.CallOpts, arg0, arg1)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+func (_WETH9 *WETH9CallerSession) Allowance(arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	return _WETH9.Contract.Allowance(&_WETH9.CallOpts, arg0, arg1)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+func (_WETH9 *WETH9Session) BalanceOf(arg0 common.Address) (*big.Int, error) {
+	return _WETH9.Contract.BalanceOf(&_WETH9.CallOpts, arg0)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+func (_WETH9 *WETH9CallerSession) BalanceOf(arg0 common.Address) (*big.Int, error) {
+	return _WETH9.Contract.BalanceOf(&_WETH9.CallOpts, arg0)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+func (_WETH9 *WETH9Session) Decimals() (uint8, error) {
+	return _WETH9.Contract.Decimals(&_WETH9.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+func (_WETH9 *WETH9CallerSession) Decimals() (uint8, error) {
+	return _WETH9.Contract.Decimals(&_WETH9.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+func (_WETH9 *WETH9Session) Name() (string, error) {
+	return _WETH9.Contract.Name(&_WETH9.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+func (_WETH9 *WETH9CallerSession) Name() (string, error) {
+	return _WETH9.Contract.Name(&_WETH9.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+func (_WETH9 *WETH9Session) Symbol() (string, error) {
+	return _WETH9.Contract.Symbol(&_WETH9.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+func (_WETH9 *WETH9CallerSession) Symbol() (string, error) {
+	return _WETH9.Contract.Symbol(&_WETH9.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+func (_WETH9 *WETH9Session) TotalSupply() (*big.Int, error) {
+	return _WETH9.Contract.TotalSupply(&_WETH9.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+func (_WETH9 *WETH9CallerSession) TotalSupply() (*big.Int, error) {
+	return _WETH9.Contract.TotalSupply(&_WETH9.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+func (_WETH9 *WETH9Session) Approve(guy common.Address, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.Contract.Approve(&_WETH9.TransactOpts, guy, wad)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+func (_WETH9 *WETH9TransactorSession) Approve(guy common.Address, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.Contract.Approve(&_WETH9.TransactOpts, guy, wad)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+func (_WETH9 *WETH9Session) Deposit() (*types.Transaction, error) {
+	return _WETH9.Contract.Deposit(&_WETH9.TransactOpts)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xd0e30db0.
+func (_WETH9 *WETH9TransactorSession) Deposit() (*types.Transaction, error) {
+	return _WETH9.Contract.Deposit(&_WETH9.TransactOpts)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+func (_WETH9 *WETH9Session) Transfer(dst common.Address, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.Contract.Transfer(&_WETH9.TransactOpts, dst, wad)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+func (_WETH9 *WETH9TransactorSession) Transfer(dst common.Address, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.Contract.Transfer(&_WETH9.TransactOpts, dst, wad)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+func (_WETH9 *WETH9Session) TransferFrom(src common.Address, dst common.Address, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.Contract.TransferFrom(&_WETH9.TransactOpts, src, dst, wad)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+func (_WETH9 *WETH9TransactorSession) TransferFrom(src common.Address, dst common.Address, wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.Contract.TransferFrom(&_WETH9.TransactOpts, src, dst, wad)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+func (_WETH9 *WETH9Session) Withdraw(wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.Contract.Withdraw(&_WETH9.TransactOpts, wad)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+func (_WETH9 *WETH9TransactorSession) Withdraw(wad *big.Int) (*types.Transaction, error) {
+	return _WETH9.Contract.Withdraw(&_WETH9.TransactOpts, wad)
+}
+
+//////////////////////////////////////////////////////
+//		Calldata
+////////////////////////////////////////////////////
+
+var (
+	parsedWETH9ABIOnce sync.Once
+	parsedWETH9ABI     abi.ABI
+	parsedWETH9ABIErr  error
+)
+
+// loadWETH9ABI parses WETH9ABI once, sharing it between the calldata helpers.
+func loadWETH9ABI() (abi.ABI, error) {
+	parsedWETH9ABIOnce.Do(func() {
+		parsedWETH9ABI, parsedWETH9ABIErr = parseWETH9ABI()
+	})
+	return parsedWETH9ABI, parsedWETH9ABIErr
+}
+
+// unnamedWETH9Args names unnamed arguments the way the input structs do, so
+// they can be unpacked into them.
+func unnamedWETH9Args(args abi.Arguments) abi.Arguments {
+	named := make(abi.Arguments, len(args))
+	for i, arg := range args {
+		if arg.Name == "" {
+			arg.Name = fmt.Sprintf("arg%d", i)
+		}
+		named[i] = arg
+	}
+	return named
+}
+
+// WETH9AllowanceInput holds the arguments of a WETH9.Allowance invocation.
+type WETH9AllowanceInput struct {
+	Arg0 common.Address
+	Arg1 common.Address
+}
+
+// PackWETH9Allowance packs the calldata invoking the contract method 0xdd62ed3e.
+func PackWETH9Allowance(arg0 common.Address, arg1 common.Address) ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("allowance", arg0, arg1)
+}
+
+// UnpackWETH9AllowanceInput unpacks the calldata of a transaction invoking the
+// contract method 0xdd62ed3e, selector included.
+func UnpackWETH9AllowanceInput(data []byte) (*WETH9AllowanceInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "dd62ed3e" {
+		return nil, errors.New("calldata does not invoke allowance(address,address)")
+	}
+	input := new(WETH9AllowanceInput)
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedWETH9Args(parsed.Methods["allowance"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackWETH9AllowanceOutput unpacks the data returned by the contract method 0xdd62ed3e,
+// as WETH9.Allowance returns it.
+func UnpackWETH9AllowanceOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadWETH9ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "allowance", data)
+	}
+	return *ret0, err
+}
+
+// WETH9BalanceOfInput holds the arguments of a WETH9.BalanceOf invocation.
+type WETH9BalanceOfInput struct {
+	Arg0 common.Address
+}
+
+// PackWETH9BalanceOf packs the calldata invoking the contract method 0x70a08231.
+func PackWETH9BalanceOf(arg0 common.Address) ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("balanceOf", arg0)
+}
+
+// UnpackWETH9BalanceOfInput unpacks the calldata of a transaction invoking the
+// contract method 0x70a08231, selector included.
+func UnpackWETH9BalanceOfInput(data []byte) (*WETH9BalanceOfInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "70a08231" {
+		return nil, errors.New("calldata does not invoke balanceOf(address)")
+	}
+	input := new(WETH9BalanceOfInput)
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedWETH9Args(parsed.Methods["balanceOf"].Inputs)
+	if err := args.Unpack(&input.Arg0, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UnpackWETH9BalanceOfOutput unpacks the data returned by the contract method 0x70a08231,
+// as WETH9.BalanceOf returns it.
+func UnpackWETH9BalanceOfOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadWETH9ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "balanceOf", data)
+	}
+	return *ret0, err
+}
+
+// WETH9DecimalsInput holds the arguments of a WETH9.Decimals invocation.
+type WETH9DecimalsInput struct{}
+
+// PackWETH9Decimals packs the calldata invoking the contract method 0x313ce567.
+func PackWETH9Decimals() ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("decimals")
+}
+
+// UnpackWETH9DecimalsInput unpacks the calldata of a transaction invoking the
+// contract method 0x313ce567, selector included.
+func UnpackWETH9DecimalsInput(data []byte) (*WETH9DecimalsInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "313ce567" {
+		return nil, errors.New("calldata does not invoke decimals()")
+	}
+	input := new(WETH9DecimalsInput)
+
+	return input, nil
+}
+
+// UnpackWETH9DecimalsOutput unpacks the data returned by the contract method 0x313ce567,
+// as WETH9.Decimals returns it.
+func UnpackWETH9DecimalsOutput(data []byte) (uint8, error) {
+	var (
+		ret0 = new(uint8)
+	)
+	out := ret0
+	parsed, err := loadWETH9ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "decimals", data)
+	}
+	return *ret0, err
+}
+
+// WETH9NameInput holds the arguments of a WETH9.Name invocation.
+type WETH9NameInput struct{}
+
+// PackWETH9Name packs the calldata invoking the contract method 0x06fdde03.
+func PackWETH9Name() ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("name")
+}
+
+// UnpackWETH9NameInput unpacks the calldata of a transaction invoking the
+// contract method 0x06fdde03, selector included.
+func UnpackWETH9NameInput(data []byte) (*WETH9NameInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "06fdde03" {
+		return nil, errors.New("calldata does not invoke name()")
+	}
+	input := new(WETH9NameInput)
+
+	return input, nil
+}
+
+// UnpackWETH9NameOutput unpacks the data returned by the contract method 0x06fdde03,
+// as WETH9.Name returns it.
+func UnpackWETH9NameOutput(data []byte) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	parsed, err := loadWETH9ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "name", data)
+	}
+	return *ret0, err
+}
+
+// WETH9SymbolInput holds the arguments of a WETH9.Symbol invocation.
+type WETH9SymbolInput struct{}
+
+// PackWETH9Symbol packs the calldata invoking the contract method 0x95d89b41.
+func PackWETH9Symbol() ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("symbol")
+}
+
+// UnpackWETH9SymbolInput unpacks the calldata of a transaction invoking the
+// contract method 0x95d89b41, selector included.
+func UnpackWETH9SymbolInput(data []byte) (*WETH9SymbolInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "95d89b41" {
+		return nil, errors.New("calldata does not invoke symbol()")
+	}
+	input := new(WETH9SymbolInput)
+
+	return input, nil
+}
+
+// UnpackWETH9SymbolOutput unpacks the data returned by the contract method 0x95d89b41,
+// as WETH9.Symbol returns it.
+func UnpackWETH9SymbolOutput(data []byte) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	parsed, err := loadWETH9ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "symbol", data)
+	}
+	return *ret0, err
+}
+
+// WETH9TotalSupplyInput holds the arguments of a WETH9.TotalSupply invocation.
+type WETH9TotalSupplyInput struct{}
+
+// PackWETH9TotalSupply packs the calldata invoking the contract method 0x18160ddd.
+func PackWETH9TotalSupply() ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("totalSupply")
+}
+
+// UnpackWETH9TotalSupplyInput unpacks the calldata of a transaction invoking the
+// contract method 0x18160ddd, selector included.
+func UnpackWETH9TotalSupplyInput(data []byte) (*WETH9TotalSupplyInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "18160ddd" {
+		return nil, errors.New("calldata does not invoke totalSupply()")
+	}
+	input := new(WETH9TotalSupplyInput)
+
+	return input, nil
+}
+
+// UnpackWETH9TotalSupplyOutput unpacks the data returned by the contract method 0x18160ddd,
+// as WETH9.TotalSupply returns it.
+func UnpackWETH9TotalSupplyOutput(data []byte) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	parsed, err := loadWETH9ABI()
+	if err == nil {
+		err = parsed.Unpack(out, "totalSupply", data)
+	}
+	return *ret0, err
+}
+
+// WETH9ApproveInput holds the arguments of a WETH9.Approve invocation.
+type WETH9ApproveInput struct {
+	Guy common.Address
+	Wad *big.Int
+}
+
+// PackWETH9Approve packs the calldata invoking the contract method 0x095ea7b3.
+func PackWETH9Approve(guy common.Address, wad *big.Int) ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("approve", guy, wad)
+}
+
+// UnpackWETH9ApproveInput unpacks the calldata of a transaction invoking the
+// contract method 0x095ea7b3, selector included.
+func UnpackWETH9ApproveInput(data []byte) (*WETH9ApproveInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "095ea7b3" {
+		return nil, errors.New("calldata does not invoke approve(address,uint256)")
+	}
+	input := new(WETH9ApproveInput)
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedWETH9Args(parsed.Methods["approve"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// WETH9DepositInput holds the arguments of a WETH9.Deposit invocation.
+type WETH9DepositInput struct{}
+
+// PackWETH9Deposit packs the calldata invoking the contract method 0xd0e30db0.
+func PackWETH9Deposit() ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("deposit")
+}
+
+// UnpackWETH9DepositInput unpacks the calldata of a transaction invoking the
+// contract method 0xd0e30db0, selector included.
+func UnpackWETH9DepositInput(data []byte) (*WETH9DepositInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "d0e30db0" {
+		return nil, errors.New("calldata does not invoke deposit()")
+	}
+	input := new(WETH9DepositInput)
+
+	return input, nil
+}
+
+// WETH9TransferInput holds the arguments of a WETH9.Transfer invocation.
+type WETH9TransferInput struct {
+	Dst common.Address
+	Wad *big.Int
+}
+
+// PackWETH9Transfer packs the calldata invoking the contract method 0xa9059cbb.
+func PackWETH9Transfer(dst common.Address, wad *big.Int) ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("transfer", dst, wad)
+}
+
+// UnpackWETH9TransferInput unpacks the calldata of a transaction invoking the
+// contract method 0xa9059cbb, selector included.
+func UnpackWETH9TransferInput(data []byte) (*WETH9TransferInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "a9059cbb" {
+		return nil, errors.New("calldata does not invoke transfer(address,uint256)")
+	}
+	input := new(WETH9TransferInput)
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedWETH9Args(parsed.Methods["transfer"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// WETH9TransferFromInput holds the arguments of a WETH9.TransferFrom invocation.
+type WETH9TransferFromInput struct {
+	Src common.Address
+	Dst common.Address
+	Wad *big.Int
+}
+
+// PackWETH9TransferFrom packs the calldata invoking the contract method 0x23b872dd.
+func PackWETH9TransferFrom(src common.Address, dst common.Address, wad *big.Int) ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("transferFrom", src, dst, wad)
+}
+
+// UnpackWETH9TransferFromInput unpacks the calldata of a transaction invoking the
+// contract method 0x23b872dd, selector included.
+func UnpackWETH9TransferFromInput(data []byte) (*WETH9TransferFromInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "23b872dd" {
+		return nil, errors.New("calldata does not invoke transferFrom(address,address,uint256)")
+	}
+	input := new(WETH9TransferFromInput)
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedWETH9Args(parsed.Methods["transferFrom"].Inputs)
+	if err := args.Unpack(input, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// WETH9WithdrawInput holds the arguments of a WETH9.Withdraw invocation.
+type WETH9WithdrawInput struct {
+	Wad *big.Int
+}
+
+// PackWETH9Withdraw packs the calldata invoking the contract method 0x2e1a7d4d.
+func PackWETH9Withdraw(wad *big.Int) ([]byte, error) {
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("withdraw", wad)
+}
+
+// UnpackWETH9WithdrawInput unpacks the calldata of a transaction invoking the
+// contract method 0x2e1a7d4d, selector included.
+func UnpackWETH9WithdrawInput(data []byte) (*WETH9WithdrawInput, error) {
+	if len(data) < 4 || common.Bytes2Hex(data[:4]) != "2e1a7d4d" {
+		return nil, errors.New("calldata does not invoke withdraw(uint256)")
+	}
+	input := new(WETH9WithdrawInput)
+	parsed, err := loadWETH9ABI()
+	if err != nil {
+		return nil, err
+	}
+	args := unnamedWETH9Args(parsed.Methods["withdraw"].Inputs)
+	if err := args.Unpack(&input.Wad, data[4:]); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// ErrWETH9UnknownMethod is returned by DecodeWETH9Call for calldata that doesn't invoke a WETH9 method.
+var ErrWETH9UnknownMethod = errors.New("unknown WETH9 method")
+
+// DecodeWETH9Call identifies the method invoked by calldata through its 4-byte
+// selector, returning the matching *MethodInput holding its arguments.
+func DecodeWETH9Call(data []byte) (interface{}, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("%w: calldata too short", ErrWETH9UnknownMethod)
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "dd62ed3e":
+		input, err := UnpackWETH9AllowanceInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "70a08231":
+		input, err := UnpackWETH9BalanceOfInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "313ce567":
+		input, err := UnpackWETH9DecimalsInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "06fdde03":
+		input, err := UnpackWETH9NameInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "95d89b41":
+		input, err := UnpackWETH9SymbolInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "18160ddd":
+		input, err := UnpackWETH9TotalSupplyInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "095ea7b3":
+		input, err := UnpackWETH9ApproveInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "d0e30db0":
+		input, err := UnpackWETH9DepositInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "a9059cbb":
+		input, err := UnpackWETH9TransferInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "23b872dd":
+		input, err := UnpackWETH9TransferFromInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	case "2e1a7d4d":
+		input, err := UnpackWETH9WithdrawInput(data)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	}
+	return nil, fmt.Errorf("%w: selector %x", ErrWETH9UnknownMethod, data[:4])
+}
+
+//////////////////////////////////////////////////////
+//		Errors
+////////////////////////////////////////////////////
+
+// WETH9Revert is a revert with a reason string, as raised by require and revert.
+type WETH9Revert struct {
+	Reason string
+}
+
+func (e WETH9Revert) Error() string {
+	return "WETH9: execution reverted: " + e.Reason
+}
+
+// WETH9Panic is raised by failed assertions, arithmetic overflows and the like.
+type WETH9Panic struct {
+	Code *big.Int
+}
+
+func (e WETH9Panic) Error() string {
+	return fmt.Sprintf("WETH9: panic code %#x", e.Code)
+}
+
+// WETH9ErrorsABI describes the errors WETH9 can revert with, each as a function
+// returning the error arguments.
+const WETH9ErrorsABI = "[{\"type\":\"function\",\"name\":\"Error\",\"outputs\":[{\"name\":\"reason\",\"type\":\"string\"}]},{\"type\":\"function\",\"name\":\"Panic\",\"outputs\":[{\"name\":\"code\",\"type\":\"uint256\"}]}]"
+
+// DecodeWETH9Revert decodes revert data into a WETH9Revert, a WETH9Panic
+// or one of the custom errors of WETH9. It returns nil for unknown data.
+func DecodeWETH9Revert(data []byte) error {
+	if len(data) < 4 {
+		return nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(WETH9ErrorsABI))
+	if err != nil {
+		return nil
+	}
+	switch common.Bytes2Hex(data[:4]) {
+	case "08c379a0":
+		var e WETH9Revert
+		if parsed.Unpack(&e, "Error", data[4:]) == nil {
+			return e
+		}
+	case "4e487b71":
+		var e WETH9Panic
+		if parsed.Unpack(&e, "Panic", data[4:]) == nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// unpackWETH9Error replaces an error carrying revert data with the typed error
+// decoded from it, leaving any other error untouched. Only errors exposing the
+// data through ErrorData, like sim.RevertError, carry it; RPC errors don't.
+func unpackWETH9Error(err error) error {
+	var reverted interface{ ErrorData() interface{} }
+	if !errors.As(err, &reverted) {
+		return err
+	}
+	var data []byte
+	switch d := reverted.ErrorData().(type) {
+	case string:
+		data = common.FromHex(d)
+	case []byte:
+		data = d
+	}
+	if decoded := DecodeWETH9Revert(data); decoded != nil {
+		return decoded
+	}
+	return err
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+// logWETH9JSON is the JSON encoding shared by the events of WETH9.
+type logWETH9JSON struct {
+	Event       string          `json:"event"`
+	Contract    string          `json:"contract"`
+	Fields      json.RawMessage `json:"fields"`
+	Address     common.Address  `json:"address"`
+	Topics      []common.Hash   `json:"topics"`
+	Data        hexutil.Bytes   `json:"data"`
+	BlockNumber uint64          `json:"blockNumber"`
+	BlockHash   common.Hash     `json:"blockHash"`
+	TxHash      common.Hash     `json:"transactionHash"`
+	TxIndex     uint            `json:"transactionIndex"`
+	LogIndex    uint            `json:"logIndex"`
+	Removed     bool            `json:"removed"`
+}
+
+// marshalLogWETH9 encodes the decoded fields of an event along with its log.
+func marshalLogWETH9(name string, fields interface{}, log types.Log) ([]byte, error) {
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(logWETH9JSON{
+		Event:       name,
+		Contract:    "WETH9",
+		Fields:      encoded,
+		Address:     log.Address,
+		Topics:      log.Topics,
+		Data:        log.Data,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Removed:     log.Removed,
+	})
+}
+
+// unmarshalLogWETH9 decodes the JSON encoding of an event, checking its name
+// and returning the raw log.
+func unmarshalLogWETH9(input []byte, name string, fields interface{}) (types.Log, error) {
+	var dec logWETH9JSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return types.Log{}, err
+	}
+	if dec.Event != name || dec.Contract != "WETH9" {
+		return types.Log{}, fmt.Errorf("cannot unmarshal %s.%s into a WETH9.%s event", dec.Contract, dec.Event, name)
+	}
+	if err := json.Unmarshal(dec.Fields, fields); err != nil {
+		return types.Log{}, err
+	}
+	return types.Log{
+		Address:     dec.Address,
+		Topics:      dec.Topics,
+		Data:        dec.Data,
+		BlockNumber: dec.BlockNumber,
+		BlockHash:   dec.BlockHash,
+		TxHash:      dec.TxHash,
+		TxIndex:     dec.TxIndex,
+		Index:       dec.LogIndex,
+		Removed:     dec.Removed,
+	}, nil
+}
+
+//////// Approval ////////
+
+// ApprovalID is the hex of the Topic Hash
+const WETH9ApprovalID = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+
+// ApprovalLog represents a Approval event raised by the WETH9 contract.
+type ApprovalLog struct {
+	Src common.Address
+	Guy common.Address
+	Wad *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e ApprovalLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Src common.Address `json:"src"`
+		Guy common.Address `json:"guy"`
+		Wad string         `json:"wad"`
+	}
+	fields.Src = e.Src
+	fields.Guy = e.Guy
+	if e.Wad != nil {
+		fields.Wad = e.Wad.String()
+	}
+	return marshalLogWETH9("Approval", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *ApprovalLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Src common.Address `json:"src"`
+		Guy common.Address `json:"guy"`
+		Wad string         `json:"wad"`
+	}
+	raw, err := unmarshalLogWETH9(input, "Approval", &fields)
+	if err != nil {
+		return err
+	}
+	e.Src = fields.Src
+	e.Guy = fields.Guy
+	if fields.Wad != "" {
+		value, ok := new(big.Int).SetString(fields.Wad, 10)
+		if !ok {
+			return fmt.Errorf("invalid wad %q", fields.Wad)
+		}
+		e.Wad = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackApprovalLog is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925
+// Solidity: event Approval(address indexed src, address indexed guy, uint256 wad)
+func (_WETH9 *WETH9) UnpackApprovalLog(log types.Log) (*ApprovalLog, error) {
+	event := new(ApprovalLog)
+	if err := _WETH9.bound().UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ApprovalLogIterator is returned from FilterApproval and is used to iterate over
+// the raw logs and unpacked data for Approval events raised by the WETH9 contract.
+type ApprovalLogIterator struct {
+	Event *ApprovalLog // Event containing the contract specifics and raw log
+
+	contract *WETH9                // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ApprovalLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackApprovalLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackApprovalLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ApprovalLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ApprovalLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+// Solidity: event Approval(address indexed src, address indexed guy, uint256 wad)
+func (_WETH9 *WETH9) FilterApproval(opts *bind.FilterOpts, src []common.Address, guy []common.Address) (*ApprovalLogIterator, error) {
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+	var guyRule []interface{}
+	for _, guyItem := range guy {
+		guyRule = append(guyRule, guyItem)
+	}
+
+	logs, sub, err := _WETH9.bound().FilterLogs(opts, "Approval", srcRule, guyRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ApprovalLogIterator{contract: _WETH9, logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+// Solidity: event Approval(address indexed src, address indexed guy, uint256 wad)
+func (_WETH9 *WETH9) WatchApproval(opts *bind.WatchOpts, sink chan<- *ApprovalLog, src []common.Address, guy []common.Address) (event.Subscription, error) {
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+	var guyRule []interface{}
+	for _, guyItem := range guy {
+		guyRule = append(guyRule, guyItem)
+	}
+
+	logs, sub, err := _WETH9.bound().WatchLogs(opts, "Approval", srcRule, guyRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _WETH9.UnpackApprovalLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// Deposit ////////
+
+// DepositID is the hex of the Topic Hash
+const WETH9DepositID = "0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c"
+
+// DepositLog represents a Deposit event raised by the WETH9 contract.
+type DepositLog struct {
+	Dst common.Address
+	Wad *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e DepositLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Dst common.Address `json:"dst"`
+		Wad string         `json:"wad"`
+	}
+	fields.Dst = e.Dst
+	if e.Wad != nil {
+		fields.Wad = e.Wad.String()
+	}
+	return marshalLogWETH9("Deposit", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *DepositLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Dst common.Address `json:"dst"`
+		Wad string         `json:"wad"`
+	}
+	raw, err := unmarshalLogWETH9(input, "Deposit", &fields)
+	if err != nil {
+		return err
+	}
+	e.Dst = fields.Dst
+	if fields.Wad != "" {
+		value, ok := new(big.Int).SetString(fields.Wad, 10)
+		if !ok {
+			return fmt.Errorf("invalid wad %q", fields.Wad)
+		}
+		e.Wad = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackDepositLog is a log parse operation binding the contract event 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c
+// Solidity: event Deposit(address indexed dst, uint256 wad)
+func (_WETH9 *WETH9) UnpackDepositLog(log types.Log) (*DepositLog, error) {
+	event := new(DepositLog)
+	if err := _WETH9.bound().UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DepositLogIterator is returned from FilterDeposit and is used to iterate over
+// the raw logs and unpacked data for Deposit events raised by the WETH9 contract.
+type DepositLogIterator struct {
+	Event *DepositLog // Event containing the contract specifics and raw log
+
+	contract *WETH9                // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DepositLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackDepositLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackDepositLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DepositLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DepositLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c.
+// Solidity: event Deposit(address indexed dst, uint256 wad)
+func (_WETH9 *WETH9) FilterDeposit(opts *bind.FilterOpts, dst []common.Address) (*DepositLogIterator, error) {
+	var dstRule []interface{}
+	for _, dstItem := range dst {
+		dstRule = append(dstRule, dstItem)
+	}
+
+	logs, sub, err := _WETH9.bound().FilterLogs(opts, "Deposit", dstRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DepositLogIterator{contract: _WETH9, logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c.
+// Solidity: event Deposit(address indexed dst, uint256 wad)
+func (_WETH9 *WETH9) WatchDeposit(opts *bind.WatchOpts, sink chan<- *DepositLog, dst []common.Address) (event.Subscription, error) {
+	var dstRule []interface{}
+	for _, dstItem := range dst {
+		dstRule = append(dstRule, dstItem)
+	}
+
+	logs, sub, err := _WETH9.bound().WatchLogs(opts, "Deposit", dstRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _WETH9.UnpackDepositLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// Transfer ////////
+
+// TransferID is the hex of the Topic Hash
+const WETH9TransferID = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// TransferLog represents a Transfer event raised by the WETH9 contract.
+type TransferLog struct {
+	Src common.Address
+	Dst common.Address
+	Wad *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e TransferLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Src common.Address `json:"src"`
+		Dst common.Address `json:"dst"`
+		Wad string         `json:"wad"`
+	}
+	fields.Src = e.Src
+	fields.Dst = e.Dst
+	if e.Wad != nil {
+		fields.Wad = e.Wad.String()
+	}
+	return marshalLogWETH9("Transfer", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *TransferLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Src common.Address `json:"src"`
+		Dst common.Address `json:"dst"`
+		Wad string         `json:"wad"`
+	}
+	raw, err := unmarshalLogWETH9(input, "Transfer", &fields)
+	if err != nil {
+		return err
+	}
+	e.Src = fields.Src
+	e.Dst = fields.Dst
+	if fields.Wad != "" {
+		value, ok := new(big.Int).SetString(fields.Wad, 10)
+		if !ok {
+			return fmt.Errorf("invalid wad %q", fields.Wad)
+		}
+		e.Wad = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackTransferLog is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+// Solidity: event Transfer(address indexed src, address indexed dst, uint256 wad)
+func (_WETH9 *WETH9) UnpackTransferLog(log types.Log) (*TransferLog, error) {
+	event := new(TransferLog)
+	if err := _WETH9.bound().UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TransferLogIterator is returned from FilterTransfer and is used to iterate over
+// the raw logs and unpacked data for Transfer events raised by the WETH9 contract.
+type TransferLogIterator struct {
+	Event *TransferLog // Event containing the contract specifics and raw log
+
+	contract *WETH9                // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TransferLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackTransferLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackTransferLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TransferLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TransferLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// Solidity: event Transfer(address indexed src, address indexed dst, uint256 wad)
+func (_WETH9 *WETH9) FilterTransfer(opts *bind.FilterOpts, src []common.Address, dst []common.Address) (*TransferLogIterator, error) {
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+	var dstRule []interface{}
+	for _, dstItem := range dst {
+		dstRule = append(dstRule, dstItem)
+	}
+
+	logs, sub, err := _WETH9.bound().FilterLogs(opts, "Transfer", srcRule, dstRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferLogIterator{contract: _WETH9, logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// Solidity: event Transfer(address indexed src, address indexed dst, uint256 wad)
+func (_WETH9 *WETH9) WatchTransfer(opts *bind.WatchOpts, sink chan<- *TransferLog, src []common.Address, dst []common.Address) (event.Subscription, error) {
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+	var dstRule []interface{}
+	for _, dstItem := range dst {
+		dstRule = append(dstRule, dstItem)
+	}
+
+	logs, sub, err := _WETH9.bound().WatchLogs(opts, "Transfer", srcRule, dstRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _WETH9.UnpackTransferLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////// Withdrawal ////////
+
+// WithdrawalID is the hex of the Topic Hash
+const WETH9WithdrawalID = "0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65"
+
+// WithdrawalLog represents a Withdrawal event raised by the WETH9 contract.
+type WithdrawalLog struct {
+	Src common.Address
+	Wad *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// MarshalJSON encodes the event along with its name, the contract type and the
+// log metadata. Big numbers are written as decimal strings, byte arrays as hex.
+func (e WithdrawalLog) MarshalJSON() ([]byte, error) {
+	var fields struct {
+		Src common.Address `json:"src"`
+		Wad string         `json:"wad"`
+	}
+	fields.Src = e.Src
+	if e.Wad != nil {
+		fields.Wad = e.Wad.String()
+	}
+	return marshalLogWETH9("Withdrawal", fields, e.Raw)
+}
+
+// UnmarshalJSON decodes an event encoded by MarshalJSON.
+func (e *WithdrawalLog) UnmarshalJSON(input []byte) error {
+	var fields struct {
+		Src common.Address `json:"src"`
+		Wad string         `json:"wad"`
+	}
+	raw, err := unmarshalLogWETH9(input, "Withdrawal", &fields)
+	if err != nil {
+		return err
+	}
+	e.Src = fields.Src
+	if fields.Wad != "" {
+		value, ok := new(big.Int).SetString(fields.Wad, 10)
+		if !ok {
+			return fmt.Errorf("invalid wad %q", fields.Wad)
+		}
+		e.Wad = value
+	}
+	e.Raw = raw
+	return nil
+}
+
+// UnpackWithdrawalLog is a log parse operation binding the contract event 0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65
+// Solidity: event Withdrawal(address indexed src, uint256 wad)
+func (_WETH9 *WETH9) UnpackWithdrawalLog(log types.Log) (*WithdrawalLog, error) {
+	event := new(WithdrawalLog)
+	if err := _WETH9.bound().UnpackLog(event, "Withdrawal", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WithdrawalLogIterator is returned from FilterWithdrawal and is used to iterate over
+// the raw logs and unpacked data for Withdrawal events raised by the WETH9 contract.
+type WithdrawalLogIterator struct {
+	Event *WithdrawalLog // Event containing the contract specifics and raw log
+
+	contract *WETH9                // Contract used to unpack the raw logs
+	logs     chan types.Log        // Log channel receiving the found contract events
+	sub      ethereum.Subscription // Subscription for errors, completion and termination
+	done     bool                  // Whether the subscription completed delivering logs
+	fail     error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WithdrawalLogIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event, it.fail = it.contract.UnpackWithdrawalLog(log)
+			return it.fail == nil
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event, it.fail = it.contract.UnpackWithdrawalLog(log)
+		return it.fail == nil
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WithdrawalLogIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WithdrawalLogIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FilterWithdrawal is a free log retrieval operation binding the contract event 0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65.
+// Solidity: event Withdrawal(address indexed src, uint256 wad)
+func (_WETH9 *WETH9) FilterWithdrawal(opts *bind.FilterOpts, src []common.Address) (*WithdrawalLogIterator, error) {
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+
+	logs, sub, err := _WETH9.bound().FilterLogs(opts, "Withdrawal", srcRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WithdrawalLogIterator{contract: _WETH9, logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawal is a free log subscription operation binding the contract event 0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65.
+// Solidity: event Withdrawal(address indexed src, uint256 wad)
+func (_WETH9 *WETH9) WatchWithdrawal(opts *bind.WatchOpts, sink chan<- *WithdrawalLog, src []common.Address) (event.Subscription, error) {
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+
+	logs, sub, err := _WETH9.bound().WatchLogs(opts, "Withdrawal", srcRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event, err := _WETH9.UnpackWithdrawalLog(log)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+//////////////////////////////////////////////////////
+//		Event Dispatch
+////////////////////////////////////////////////////
+
+// WETH9EventHandler handles each of the events raised by the WETH9 contract.
+type WETH9EventHandler interface {
+	HandleApproval(event *ApprovalLog) error
+	HandleDeposit(event *DepositLog) error
+	HandleTransfer(event *TransferLog) error
+	HandleWithdrawal(event *WithdrawalLog) error
+}
+
+// ErrWETH9UnknownEvent is returned by Dispatch for logs that weren't raised by a WETH9 event.
+var ErrWETH9UnknownEvent = errors.New("unknown WETH9 event")
+
+// Dispatch unpacks the log according to its first topic and passes it on to the
+// matching method of the handler.
+func (_WETH9 *WETH9) Dispatch(log types.Log, h WETH9EventHandler) error {
+	if len(log.Topics) == 0 {
+		return fmt.Errorf("%w: log has no topics", ErrWETH9UnknownEvent)
+	}
+	switch log.Topics[0].Hex() {
+	case WETH9ApprovalID:
+		event, err := _WETH9.UnpackApprovalLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleApproval(event)
+
+	case WETH9DepositID:
+		event, err := _WETH9.UnpackDepositLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleDeposit(event)
+
+	case WETH9TransferID:
+		event, err := _WETH9.UnpackTransferLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleTransfer(event)
+
+	case WETH9WithdrawalID:
+		event, err := _WETH9.UnpackWithdrawalLog(log)
+		if err != nil {
+			return err
+		}
+		return h.HandleWithdrawal(event)
+
+	}
+	return fmt.Errorf("%w: topic %s", ErrWETH9UnknownEvent, log.Topics[0].Hex())
+}
+
+//////////////////////////////////////////////////////
+//		Bin and ABI
+////////////////////////////////////////////////////
+
+// WETH9Bin is used to deploy the generated contract
+const WETH9Bin = "0x60c0604052600d60809081526c2bb930b83832b21022ba3432b960991b60a05260009061002c9082610114565b506040805180820190915260048152630ae8aa8960e31b60208201526001906100559082610114565b506002805460ff1916601217905534801561006f57600080fd5b506101d3565b634e487b7160e01b600052604160045260246000fd5b600181811c9082168061009f57607f821691505b6020821081036100bf57634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561010f57600081815260208120601f850160051c810160208610156100ec5750805b601f850160051c820191505b8181101561010b578281556001016100f8565b5050505b505050565b81516001600160401b0381111561012d5761012d610075565b6101418161013b845461008b565b846100c5565b602080601f831160018114610176576000841561015e5750858301515b600019600386901b1c1916600185901b17855561010b565b600085815260208120601f198616915b828110156101a557888601518255948401946001909101908401610186565b50858210156101c35787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6107a8806101e26000396000f3fe60806040526004361061009c5760003560e01c8063313ce56711610064578063313ce5671461015e57806370a082311461018a57806395d89b41146101b7578063a9059cbb146101cc578063d0e30db01461009c578063dd62ed3e146101ec5761009c565b806306fdde03146100a6578063095ea7b3146100d157806318160ddd1461010157806323b872dd1461011e5780632e1a7d4d1461013e575b6100a4610224565b005b3480156100b257600080fd5b506100bb61027f565b6040516100c891906105c5565b60405180910390f35b3480156100dd57600080fd5b506100f16100ec36600461062f565b61030d565b60405190151581526020016100c8565b34801561010d57600080fd5b50475b6040519081526020016100c8565b34801561012a57600080fd5b506100f1610139366004610659565b61037a565b34801561014a57600080fd5b506100a4610159366004610695565b6104fe565b34801561016a57600080fd5b506002546101789060ff1681565b60405160ff90911681526020016100c8565b34801561019657600080fd5b506101106101a53660046106ae565b60036020526000908152604090205481565b3480156101c357600080fd5b506100bb6105a4565b3480156101d857600080fd5b506100f16101e736600461062f565b6105b1565b3480156101f857600080fd5b506101106102073660046106c9565b600460209081526000928352604080842090915290825290205481565b3360009081526003602052604081208054349290610243908490610712565b909155505060405134815233907fe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c9060200160405180910390a2565b6000805461028c90610725565b80601f01602080910402602001604051908101604052809291908181526020018280546102b890610725565b80156103055780601f106102da57610100808354040283529160200191610305565b820191906000526020600020905b8154815290600101906020018083116102e857829003601f168201915b505050505081565b3360008181526004602090815260408083206001600160a01b038716808552925280832085905551919290917f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925906103689086815260200190565b60405180910390a35060015b92915050565b6001600160a01b03831660009081526003602052604081205482111561039f57600080fd5b6001600160a01b03841633148015906103dd57506001600160a01b038416600090815260046020908152604080832033845290915290205460001914155b1561044b576001600160a01b038416600090815260046020908152604080832033845290915290205482111561041257600080fd5b6001600160a01b03841660009081526004602090815260408083203384529091528120805484929061044590849061075f565b90915550505b6001600160a01b0384166000908152600360205260408120805484929061047390849061075f565b90915550506001600160a01b038316600090815260036020526040812080548492906104a0908490610712565b92505081905550826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040516104ec91815260200190565b60405180910390a35060019392505050565b3360009081526003602052604090205481111561051a57600080fd5b336000908152600360205260408120805483929061053990849061075f565b9091555050604051339082156108fc029083906000818181858888f1935050505015801561056b573d6000803e3d6000fd5b5060405181815233907f7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b659060200160405180910390a250565b6001805461028c90610725565b60006105be33848461037a565b9392505050565b600060208083528351808285015260005b818110156105f2578581018301518582016040015282016105d6565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b038116811461062a57600080fd5b919050565b6000806040838503121561064257600080fd5b61064b83610613565b946020939093013593505050565b60008060006060848603121561066e57600080fd5b61067784610613565b925061068560208501610613565b9150604084013590509250925092565b6000602082840312156106a757600080fd5b5035919050565b6000602082840312156106c057600080fd5b6105be82610613565b600080604083850312156106dc57600080fd5b6106e583610613565b91506106f360208401610613565b90509250929050565b634e487b7160e01b600052601160045260246000fd5b80820180821115610374576103746106fc565b600181811c9082168061073957607f821691505b60208210810361075957634e487b7160e01b600052602260045260246000fd5b50919050565b81810381811115610374576103746106fc56fea2646970667358221220f87cdd50ae4c50f05dcab1fafd222e601f5b3b67c882d7d9646b127e1236917e64736f6c63430008150033"
+
+// WETH9ABI is used to communicate with the compiled solidity code of the generated contract
+const WETH9ABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"src\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"guy\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"wad\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"dst\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"wad\",\"type\":\"uint256\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"src\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"dst\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"wad\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"src\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"wad\",\"type\":\"uint256\"}],\"name\":\"Withdrawal\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"guy\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"wad\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deposit\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"dst\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"wad\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"src\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"dst\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"wad\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"wad\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// WETH9RuntimeBin is the code deployed by WETH9Bin, with libraries left unlinked
+const WETH9RuntimeBin = "0x60806040526004361061009c5760003560e01c8063313ce56711610064578063313ce5671461015e57806370a082311461018a57806395d89b41146101b7578063a9059cbb146101cc578063d0e30db01461009c578063dd62ed3e146101ec5761009c565b806306fdde03146100a6578063095ea7b3146100d157806318160ddd1461010157806323b872dd1461011e5780632e1a7d4d1461013e575b6100a4610224565b005b3480156100b257600080fd5b506100bb61027f565b6040516100c891906105c5565b60405180910390f35b3480156100dd57600080fd5b506100f16100ec36600461062f565b61030d565b60405190151581526020016100c8565b34801561010d57600080fd5b50475b6040519081526020016100c8565b34801561012a57600080fd5b506100f1610139366004610659565b61037a565b34801561014a57600080fd5b506100a4610159366004610695565b6104fe565b34801561016a57600080fd5b506002546101789060ff1681565b60405160ff90911681526020016100c8565b34801561019657600080fd5b506101106101a53660046106ae565b60036020526000908152604090205481565b3480156101c357600080fd5b506100bb6105a4565b3480156101d857600080fd5b506100f16101e736600461062f565b6105b1565b3480156101f857600080fd5b506101106102073660046106c9565b600460209081526000928352604080842090915290825290205481565b3360009081526003602052604081208054349290610243908490610712565b909155505060405134815233907fe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c9060200160405180910390a2565b6000805461028c90610725565b80601f01602080910402602001604051908101604052809291908181526020018280546102b890610725565b80156103055780601f106102da57610100808354040283529160200191610305565b820191906000526020600020905b8154815290600101906020018083116102e857829003601f168201915b505050505081565b3360008181526004602090815260408083206001600160a01b038716808552925280832085905551919290917f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925906103689086815260200190565b60405180910390a35060015b92915050565b6001600160a01b03831660009081526003602052604081205482111561039f57600080fd5b6001600160a01b03841633148015906103dd57506001600160a01b038416600090815260046020908152604080832033845290915290205460001914155b1561044b576001600160a01b038416600090815260046020908152604080832033845290915290205482111561041257600080fd5b6001600160a01b03841660009081526004602090815260408083203384529091528120805484929061044590849061075f565b90915550505b6001600160a01b0384166000908152600360205260408120805484929061047390849061075f565b90915550506001600160a01b038316600090815260036020526040812080548492906104a0908490610712565b92505081905550826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040516104ec91815260200190565b60405180910390a35060019392505050565b3360009081526003602052604090205481111561051a57600080fd5b336000908152600360205260408120805483929061053990849061075f565b9091555050604051339082156108fc029083906000818181858888f1935050505015801561056b573d6000803e3d6000fd5b5060405181815233907f7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b659060200160405180910390a250565b6001805461028c90610725565b60006105be33848461037a565b9392505050565b600060208083528351808285015260005b818110156105f2578581018301518582016040015282016105d6565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b038116811461062a57600080fd5b919050565b6000806040838503121561064257600080fd5b61064b83610613565b946020939093013593505050565b60008060006060848603121561066e57600080fd5b61067784610613565b925061068560208501610613565b9150604084013590509250925092565b6000602082840312156106a757600080fd5b5035919050565b6000602082840312156106c057600080fd5b6105be82610613565b600080604083850312156106dc57600080fd5b6106e583610613565b91506106f360208401610613565b90509250929050565b634e487b7160e01b600052601160045260246000fd5b80820180821115610374576103746106fc565b600181811c9082168061073957607f821691505b60208210810361075957634e487b7160e01b600052602260045260246000fd5b50919050565b81810381811115610374576103746106fc56fea2646970667358221220f87cdd50ae4c50f05dcab1fafd222e601f5b3b67c882d7d9646b127e1236917e64736f6c63430008150033"
+
+// WETH9SourceMap maps the instructions of WETH9Bin to the Solidity sources
+const WETH9SourceMap = "1051:40:0:-:0;1030:1824;1051:40;;1030:1824;1051:40;;;-1:-1:-1;;;1051:40:0;;-1:-1:-1;;1051:40:0;;-1:-1:-1;1051:40:0;:::i;:::-;-1:-1:-1;1097:31:0;;;;;;;;;;;;-1:-1:-1;;;1097:31:0;;;;;;;;;;:::i;:::-;-1:-1:-1;1134:27:0;;;-1:-1:-1;;1134:27:0;1159:2;1134:27;;;1030:1824;;;;;;;;;;;;14:127:1;75:10;70:3;66:20;63:1;56:31;106:4;103:1;96:15;130:4;127:1;120:15;146:380;225:1;221:12;;;;268;;;289:61;;343:4;335:6;331:17;321:27;;289:61;396:2;388:6;385:14;365:18;362:38;359:161;;442:10;437:3;433:20;430:1;423:31;477:4;474:1;467:15;505:4;502:1;495:15;359:161;;146:380;;;:::o;657:545::-;759:2;754:3;751:11;748:448;;;795:1;820:5;816:2;809:17;865:4;861:2;851:19;935:2;923:10;919:19;916:1;912:27;906:4;902:38;971:4;959:10;956:20;953:47;;;-1:-1:-1;994:4:1;953:47;1049:2;1044:3;1040:12;1037:1;1033:20;1027:4;1023:31;1013:41;;1104:82;1122:2;1115:5;1112:13;1104:82;;;1167:17;;;1148:1;1137:13;1104:82;;;1108:3;;;748:448;657:545;;;:::o;1378:1352::-;1498:10;;-1:-1:-1;;;;;1520:30:1;;1517:56;;;1553:18;;:::i;:::-;1582:97;1672:6;1632:38;1664:4;1658:11;1632:38;:::i;:::-;1626:4;1582:97;:::i;:::-;1734:4;;1798:2;1787:14;;1815:1;1810:663;;;;2517:1;2534:6;2531:89;;;-1:-1:-1;2586:19:1;;;2580:26;2531:89;-1:-1:-1;;1335:1:1;1331:11;;;1327:24;1323:29;1313:40;1359:1;1355:11;;;1310:57;2633:81;;1780:944;;1810:663;604:1;597:14;;;641:4;628:18;;-1:-1:-1;;1846:20:1;;;1964:236;1978:7;1975:1;1972:14;1964:236;;;2067:19;;;2061:26;2046:42;;2159:27;;;;2127:1;2115:14;;;;1994:19;;1964:236;;;1968:3;2228:6;2219:7;2216:19;2213:201;;;2289:19;;;2283:26;-1:-1:-1;;2372:1:1;2368:14;;;2384:3;2364:24;2360:37;2356:42;2341:58;2326:74;;2213:201;-1:-1:-1;;;;;2460:1:1;2444:14;;;2440:22;2427:36;;-1:-1:-1;1378:1352:1:o;:::-;1030:1824:0;;;;;;"
+
+// WETH9RuntimeSourceMap maps the instructions of WETH9RuntimeBin to the Solidity sources
+const WETH9RuntimeSourceMap = "1030:1824:0:-:0;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;1601:9;:7;:9::i;:::-;1030:1824;1051:40;;;;;;;;;;;;;:::i;:::-;;;;;;;:::i;:::-;;;;;;;;2076:177;;;;;;;;;;-1:-1:-1;2076:177:0;;;;;:::i;:::-;;:::i;:::-;;;1169:14:1;;1162:22;1144:41;;1132:2;1117:18;2076:177:0;1004:187:1;1975:95:0;;;;;;;;;;-1:-1:-1;2042:21:0;1975:95;;;1342:25:1;;;1330:2;1315:18;1975:95:0;1196:177:1;2386:466:0;;;;;;;;;;-1:-1:-1;2386:466:0;;;;;:::i;:::-;;:::i;1757:212::-;;;;;;;;;;-1:-1:-1;1757:212:0;;;;;:::i;:::-;;:::i;1134:27::-;;;;;;;;;;-1:-1:-1;1134:27:0;;;;;;;;;;;2068:4:1;2056:17;;;2038:36;;2026:2;2011:18;1134:27:0;1896:184:1;1420:65:0;;;;;;;;;;-1:-1:-1;1420:65:0;;;;;:::i;:::-;;;;;;;;;;;;;;1097:31;;;;;;;;;;;;;:::i;2259:121::-;;;;;;;;;;-1:-1:-1;2259:121:0;;;;;:::i;:::-;;:::i;1491:65::-;;;;;;;;;;-1:-1:-1;1491:65:0;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;1622:130;1676:10;1666:21;;;;:9;:21;;;;;:34;;1691:9;;1666:21;:34;;1691:9;;1666:34;:::i;:::-;;;;-1:-1:-1;;1715:30:0;;1735:9;1342:25:1;;1723:10:0;;1715:30;;1330:2:1;1315:18;1715:30:0;;;;;;;1622:130::o;1051:40::-;;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;:::i;:::-;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;:::o;2076:177::-;2158:10;2132:4;2148:21;;;:9;:21;;;;;;;;-1:-1:-1;;;;;2148:26:0;;;;;;;;;;:32;;;2195:30;2132:4;;2148:26;;2195:30;;;;2177:3;1342:25:1;;1330:2;1315:18;;1196:177;2195:30:0;;;;;;;;-1:-1:-1;2242:4:0;2076:177;;;;;:::o;2386:466::-;-1:-1:-1;;;;;2504:14:0;;2476:4;2504:14;;;:9;:14;;;;;;:21;-1:-1:-1;2504:21:0;2496:30;;;;;;-1:-1:-1;;;;;2541:17:0;;2548:10;2541:17;;;;:65;;-1:-1:-1;;;;;;2562:14:0;;;;;;:9;:14;;;;;;;;2577:10;2562:26;;;;;;;;-1:-1:-1;;2562:44:0;;2541:65;2537:185;;;-1:-1:-1;;;;;2630:14:0;;;;;;:9;:14;;;;;;;;2645:10;2630:26;;;;;;;;:33;-1:-1:-1;2630:33:0;2622:42;;;;;;-1:-1:-1;;;;;2678:14:0;;;;;;:9;:14;;;;;;;;2693:10;2678:26;;;;;;;:33;;2708:3;;2678:14;:33;;2708:3;;2678:33;:::i;:::-;;;;-1:-1:-1;;2537:185:0;-1:-1:-1;;;;;2732:14:0;;;;;;:9;:14;;;;;:21;;2750:3;;2732:14;:21;;2750:3;;2732:21;:::i;:::-;;;;-1:-1:-1;;;;;;;2763:14:0;;;;;;:9;:14;;;;;:21;;2781:3;;2763:14;:21;;2781:3;;2763:21;:::i;:::-;;;;;;;;2814:3;-1:-1:-1;;;;;2800:23:0;2809:3;-1:-1:-1;;;;;2800:23:0;;2819:3;2800:23;;;;1342:25:1;;1330:2;1315:18;;1196:177;2800:23:0;;;;;;;;-1:-1:-1;2841:4:0;2386:466;;;;;:::o;1757:212::-;1820:10;1810:21;;;;:9;:21;;;;;;:28;-1:-1:-1;1810:28:0;1802:37;;;;;;1859:10;1849:21;;;;:9;:21;;;;;:28;;1874:3;;1849:21;:28;;1874:3;;1849:28;:::i;:::-;;;;-1:-1:-1;;1887:33:0;;1895:10;;1887:33;;;;;1916:3;;1887:33;;;;1916:3;1895:10;1887:33;;;;;;;;;;;;;;;;;;;;-1:-1:-1;1935:27:0;;1342:25:1;;;1946:10:0;;1935:27;;1330:2:1;1315:18;1935:27:0;;;;;;;1757:212;:::o;1097:31::-;;;;;;;:::i;2259:121::-;2316:4;2339:34;2352:10;2364:3;2369;2339:12;:34::i;:::-;2332:41;2259:121;-1:-1:-1;;;2259:121:0:o;14:548:1:-;126:4;155:2;184;173:9;166:21;216:6;210:13;259:6;254:2;243:9;239:18;232:34;284:1;294:140;308:6;305:1;302:13;294:140;;;403:14;;;399:23;;393:30;369:17;;;388:2;365:26;358:66;323:10;;294:140;;;298:3;483:1;478:2;469:6;458:9;454:22;450:31;443:42;553:2;546;542:7;537:2;529:6;525:15;521:29;510:9;506:45;502:54;494:62;;;;14:548;;;;:::o;567:173::-;635:20;;-1:-1:-1;;;;;684:31:1;;674:42;;664:70;;730:1;727;720:12;664:70;567:173;;;:::o;745:254::-;813:6;821;874:2;862:9;853:7;849:23;845:32;842:52;;;890:1;887;880:12;842:52;913:29;932:9;913:29;:::i;:::-;903:39;989:2;974:18;;;;961:32;;-1:-1:-1;;;745:254:1:o;1378:328::-;1455:6;1463;1471;1524:2;1512:9;1503:7;1499:23;1495:32;1492:52;;;1540:1;1537;1530:12;1492:52;1563:29;1582:9;1563:29;:::i;:::-;1553:39;;1611:38;1645:2;1634:9;1630:18;1611:38;:::i;:::-;1601:48;;1696:2;1685:9;1681:18;1668:32;1658:42;;1378:328;;;;;:::o;1711:180::-;1770:6;1823:2;1811:9;1802:7;1798:23;1794:32;1791:52;;;1839:1;1836;1829:12;1791:52;-1:-1:-1;1862:23:1;;1711:180;-1:-1:-1;1711:180:1:o;2085:186::-;2144:6;2197:2;2185:9;2176:7;2172:23;2168:32;2165:52;;;2213:1;2210;2203:12;2165:52;2236:29;2255:9;2236:29;:::i;2276:260::-;2344:6;2352;2405:2;2393:9;2384:7;2380:23;2376:32;2373:52;;;2421:1;2418;2411:12;2373:52;2444:29;2463:9;2444:29;:::i;:::-;2434:39;;2492:38;2526:2;2515:9;2511:18;2492:38;:::i;:::-;2482:48;;2276:260;;;;;:::o;2541:127::-;2602:10;2597:3;2593:20;2590:1;2583:31;2633:4;2630:1;2623:15;2657:4;2654:1;2647:15;2673:125;2738:9;;;2759:10;;;2756:36;;;2772:18;;:::i;2803:380::-;2882:1;2878:12;;;;2925;;;2946:61;;3000:4;2992:6;2988:17;2978:27;;2946:61;3053:2;3045:6;3042:14;3022:18;3019:38;3016:161;;3099:10;3094:3;3090:20;3087:1;3080:31;3134:4;3131:1;3124:15;3162:4;3159:1;3152:15;3016:161;;2803:380;;;:::o;3188:128::-;3255:9;;;3276:11;;;3273:37;;;3290:18;;:::i"
+
+// WETH9FuncSigs maps the 4-byte function signature to its string representation.
+var WETH9FuncSigs = map[string]string{
+	"dd62ed3e": "allowance(address,address)",
+	"095ea7b3": "approve(address,uint256)",
+	"70a08231": "balanceOf(address)",
+	"313ce567": "decimals()",
+	"d0e30db0": "deposit()",
+	"06fdde03": "name()",
+	"95d89b41": "symbol()",
+	"18160ddd": "totalSupply()",
+	"a9059cbb": "transfer(address,uint256)",
+	"23b872dd": "transferFrom(address,address,uint256)",
+	"2e1a7d4d": "withdraw(uint256)",
+}
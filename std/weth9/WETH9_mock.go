@@ -0,0 +1,689 @@
+// Code generated by buddy. DO NOT EDIT.
+
+package weth9
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+)
+
+// WETH9Mock is a scriptable implementation of WETH9Interface for unit tests.
+// Results are scripted per method and arguments, for example
+//
+//	mock.OnMethod(args...).Return(results..., err)
+//
+// Calls that were not scripted return zero values and an error.
+type WETH9Mock struct {
+	mu    sync.Mutex
+	calls []WETH9MockCall
+
+	onAllowance   []*WETH9AllowanceMock
+	onBalanceOf   []*WETH9BalanceOfMock
+	onDecimals    []*WETH9DecimalsMock
+	onName        []*WETH9NameMock
+	onSymbol      []*WETH9SymbolMock
+	onTotalSupply []*WETH9TotalSupplyMock
+
+	onApprove      []*WETH9ApproveMock
+	onDeposit      []*WETH9DepositMock
+	onTransfer     []*WETH9TransferMock
+	onTransferFrom []*WETH9TransferFromMock
+	onWithdraw     []*WETH9WithdrawMock
+
+	onUnpackApprovalLog   []*WETH9UnpackApprovalLogMock
+	onUnpackDepositLog    []*WETH9UnpackDepositLogMock
+	onUnpackTransferLog   []*WETH9UnpackTransferLogMock
+	onUnpackWithdrawalLog []*WETH9UnpackWithdrawalLogMock
+}
+
+// This nil assignment ensures at compile time that WETH9Mock implements WETH9Interface.
+var _ WETH9Interface = (*WETH9Mock)(nil)
+
+// WETH9MockCall records a single call made to a WETH9Mock.
+type WETH9MockCall struct {
+	Method string        // Name of the called method
+	Args   []interface{} // Arguments passed to the method, excluding opts
+}
+
+// MockCalls returns every call made to the mock so far, in order.
+func (_m *WETH9Mock) MockCalls() []WETH9MockCall {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	return append([]WETH9MockCall(nil), _m.calls...)
+}
+
+// matchWETH9MockArgs compares scripted and received arguments, treating big
+// integers as equal whenever they hold the same value.
+func matchWETH9MockArgs(want, got []interface{}) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		w, wok := want[i].(*big.Int)
+		g, gok := got[i].(*big.Int)
+		if wok && gok && w != nil && g != nil {
+			if w.Cmp(g) != 0 {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(want[i], got[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+//////////////////////////////////////////////////////
+//		Data Calls
+////////////////////////////////////////////////////
+
+// WETH9AllowanceMock scripts the results of WETH9Mock.Allowance.
+type WETH9AllowanceMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnAllowance scripts the results of Allowance calls made with the given arguments.
+func (_m *WETH9Mock) OnAllowance(arg0 common.Address, arg1 common.Address) *WETH9AllowanceMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9AllowanceMock{mock: _m, args: []interface{}{arg0, arg1}}
+	_m.onAllowance = append(_m.onAllowance, _e)
+	return _e
+}
+
+// Return sets the results of the Allowance calls matching the scripted arguments.
+func (_e *WETH9AllowanceMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Allowance records the call and returns the results scripted for its arguments.
+func (_m *WETH9Mock) Allowance(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	_args := []interface{}{arg0, arg1}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "Allowance", Args: _args})
+	for _i := len(_m.onAllowance) - 1; _i >= 0; _i-- {
+		if _e := _m.onAllowance[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e WETH9AllowanceMock
+	return _e.ret0, fmt.Errorf("WETH9Mock: no results scripted for Allowance%v", _args)
+}
+
+// WETH9BalanceOfMock scripts the results of WETH9Mock.BalanceOf.
+type WETH9BalanceOfMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnBalanceOf scripts the results of BalanceOf calls made with the given arguments.
+func (_m *WETH9Mock) OnBalanceOf(arg0 common.Address) *WETH9BalanceOfMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9BalanceOfMock{mock: _m, args: []interface{}{arg0}}
+	_m.onBalanceOf = append(_m.onBalanceOf, _e)
+	return _e
+}
+
+// Return sets the results of the BalanceOf calls matching the scripted arguments.
+func (_e *WETH9BalanceOfMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// BalanceOf records the call and returns the results scripted for its arguments.
+func (_m *WETH9Mock) BalanceOf(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	_args := []interface{}{arg0}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "BalanceOf", Args: _args})
+	for _i := len(_m.onBalanceOf) - 1; _i >= 0; _i-- {
+		if _e := _m.onBalanceOf[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e WETH9BalanceOfMock
+	return _e.ret0, fmt.Errorf("WETH9Mock: no results scripted for BalanceOf%v", _args)
+}
+
+// WETH9DecimalsMock scripts the results of WETH9Mock.Decimals.
+type WETH9DecimalsMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	ret0 uint8
+	err  error
+}
+
+// OnDecimals scripts the results of Decimals calls made with the given arguments.
+func (_m *WETH9Mock) OnDecimals() *WETH9DecimalsMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9DecimalsMock{mock: _m, args: []interface{}{}}
+	_m.onDecimals = append(_m.onDecimals, _e)
+	return _e
+}
+
+// Return sets the results of the Decimals calls matching the scripted arguments.
+func (_e *WETH9DecimalsMock) Return(ret0 uint8, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Decimals records the call and returns the results scripted for its arguments.
+func (_m *WETH9Mock) Decimals(opts *bind.CallOpts) (uint8, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "Decimals", Args: _args})
+	for _i := len(_m.onDecimals) - 1; _i >= 0; _i-- {
+		if _e := _m.onDecimals[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e WETH9DecimalsMock
+	return _e.ret0, fmt.Errorf("WETH9Mock: no results scripted for Decimals%v", _args)
+}
+
+// WETH9NameMock scripts the results of WETH9Mock.Name.
+type WETH9NameMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	ret0 string
+	err  error
+}
+
+// OnName scripts the results of Name calls made with the given arguments.
+func (_m *WETH9Mock) OnName() *WETH9NameMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9NameMock{mock: _m, args: []interface{}{}}
+	_m.onName = append(_m.onName, _e)
+	return _e
+}
+
+// Return sets the results of the Name calls matching the scripted arguments.
+func (_e *WETH9NameMock) Return(ret0 string, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Name records the call and returns the results scripted for its arguments.
+func (_m *WETH9Mock) Name(opts *bind.CallOpts) (string, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "Name", Args: _args})
+	for _i := len(_m.onName) - 1; _i >= 0; _i-- {
+		if _e := _m.onName[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e WETH9NameMock
+	return _e.ret0, fmt.Errorf("WETH9Mock: no results scripted for Name%v", _args)
+}
+
+// WETH9SymbolMock scripts the results of WETH9Mock.Symbol.
+type WETH9SymbolMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	ret0 string
+	err  error
+}
+
+// OnSymbol scripts the results of Symbol calls made with the given arguments.
+func (_m *WETH9Mock) OnSymbol() *WETH9SymbolMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9SymbolMock{mock: _m, args: []interface{}{}}
+	_m.onSymbol = append(_m.onSymbol, _e)
+	return _e
+}
+
+// Return sets the results of the Symbol calls matching the scripted arguments.
+func (_e *WETH9SymbolMock) Return(ret0 string, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// Symbol records the call and returns the results scripted for its arguments.
+func (_m *WETH9Mock) Symbol(opts *bind.CallOpts) (string, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "Symbol", Args: _args})
+	for _i := len(_m.onSymbol) - 1; _i >= 0; _i-- {
+		if _e := _m.onSymbol[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e WETH9SymbolMock
+	return _e.ret0, fmt.Errorf("WETH9Mock: no results scripted for Symbol%v", _args)
+}
+
+// WETH9TotalSupplyMock scripts the results of WETH9Mock.TotalSupply.
+type WETH9TotalSupplyMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	ret0 *big.Int
+	err  error
+}
+
+// OnTotalSupply scripts the results of TotalSupply calls made with the given arguments.
+func (_m *WETH9Mock) OnTotalSupply() *WETH9TotalSupplyMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9TotalSupplyMock{mock: _m, args: []interface{}{}}
+	_m.onTotalSupply = append(_m.onTotalSupply, _e)
+	return _e
+}
+
+// Return sets the results of the TotalSupply calls matching the scripted arguments.
+func (_e *WETH9TotalSupplyMock) Return(ret0 *big.Int, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.ret0 = ret0
+
+	_e.err = err
+}
+
+// TotalSupply records the call and returns the results scripted for its arguments.
+func (_m *WETH9Mock) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "TotalSupply", Args: _args})
+	for _i := len(_m.onTotalSupply) - 1; _i >= 0; _i-- {
+		if _e := _m.onTotalSupply[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.ret0, _e.err
+		}
+	}
+	var _e WETH9TotalSupplyMock
+	return _e.ret0, fmt.Errorf("WETH9Mock: no results scripted for TotalSupply%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Transactions
+////////////////////////////////////////////////////
+
+// WETH9ApproveMock scripts the results of WETH9Mock.Approve.
+type WETH9ApproveMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnApprove scripts the results of Approve transactions made with the given arguments.
+func (_m *WETH9Mock) OnApprove(guy common.Address, wad *big.Int) *WETH9ApproveMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9ApproveMock{mock: _m, args: []interface{}{guy, wad}}
+	_m.onApprove = append(_m.onApprove, _e)
+	return _e
+}
+
+// Return sets the results of the Approve transactions matching the scripted arguments.
+func (_e *WETH9ApproveMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Approve records the transaction and returns the results scripted for its arguments.
+func (_m *WETH9Mock) Approve(opts *bind.TransactOpts, guy common.Address, wad *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{guy, wad}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "Approve", Args: _args})
+	for _i := len(_m.onApprove) - 1; _i >= 0; _i-- {
+		if _e := _m.onApprove[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("WETH9Mock: no results scripted for Approve%v", _args)
+}
+
+// WETH9DepositMock scripts the results of WETH9Mock.Deposit.
+type WETH9DepositMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnDeposit scripts the results of Deposit transactions made with the given arguments.
+func (_m *WETH9Mock) OnDeposit() *WETH9DepositMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9DepositMock{mock: _m, args: []interface{}{}}
+	_m.onDeposit = append(_m.onDeposit, _e)
+	return _e
+}
+
+// Return sets the results of the Deposit transactions matching the scripted arguments.
+func (_e *WETH9DepositMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Deposit records the transaction and returns the results scripted for its arguments.
+func (_m *WETH9Mock) Deposit(opts *bind.TransactOpts) (*types.Transaction, error) {
+	_args := []interface{}{}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "Deposit", Args: _args})
+	for _i := len(_m.onDeposit) - 1; _i >= 0; _i-- {
+		if _e := _m.onDeposit[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("WETH9Mock: no results scripted for Deposit%v", _args)
+}
+
+// WETH9TransferMock scripts the results of WETH9Mock.Transfer.
+type WETH9TransferMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnTransfer scripts the results of Transfer transactions made with the given arguments.
+func (_m *WETH9Mock) OnTransfer(dst common.Address, wad *big.Int) *WETH9TransferMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9TransferMock{mock: _m, args: []interface{}{dst, wad}}
+	_m.onTransfer = append(_m.onTransfer, _e)
+	return _e
+}
+
+// Return sets the results of the Transfer transactions matching the scripted arguments.
+func (_e *WETH9TransferMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Transfer records the transaction and returns the results scripted for its arguments.
+func (_m *WETH9Mock) Transfer(opts *bind.TransactOpts, dst common.Address, wad *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{dst, wad}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "Transfer", Args: _args})
+	for _i := len(_m.onTransfer) - 1; _i >= 0; _i-- {
+		if _e := _m.onTransfer[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("WETH9Mock: no results scripted for Transfer%v", _args)
+}
+
+// WETH9TransferFromMock scripts the results of WETH9Mock.TransferFrom.
+type WETH9TransferFromMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnTransferFrom scripts the results of TransferFrom transactions made with the given arguments.
+func (_m *WETH9Mock) OnTransferFrom(src common.Address, dst common.Address, wad *big.Int) *WETH9TransferFromMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9TransferFromMock{mock: _m, args: []interface{}{src, dst, wad}}
+	_m.onTransferFrom = append(_m.onTransferFrom, _e)
+	return _e
+}
+
+// Return sets the results of the TransferFrom transactions matching the scripted arguments.
+func (_e *WETH9TransferFromMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// TransferFrom records the transaction and returns the results scripted for its arguments.
+func (_m *WETH9Mock) TransferFrom(opts *bind.TransactOpts, src common.Address, dst common.Address, wad *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{src, dst, wad}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "TransferFrom", Args: _args})
+	for _i := len(_m.onTransferFrom) - 1; _i >= 0; _i-- {
+		if _e := _m.onTransferFrom[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("WETH9Mock: no results scripted for TransferFrom%v", _args)
+}
+
+// WETH9WithdrawMock scripts the results of WETH9Mock.Withdraw.
+type WETH9WithdrawMock struct {
+	mock *WETH9Mock
+	args []interface{}
+	tx   *types.Transaction
+	err  error
+}
+
+// OnWithdraw scripts the results of Withdraw transactions made with the given arguments.
+func (_m *WETH9Mock) OnWithdraw(wad *big.Int) *WETH9WithdrawMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9WithdrawMock{mock: _m, args: []interface{}{wad}}
+	_m.onWithdraw = append(_m.onWithdraw, _e)
+	return _e
+}
+
+// Return sets the results of the Withdraw transactions matching the scripted arguments.
+func (_e *WETH9WithdrawMock) Return(tx *types.Transaction, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.tx, _e.err = tx, err
+}
+
+// Withdraw records the transaction and returns the results scripted for its arguments.
+func (_m *WETH9Mock) Withdraw(opts *bind.TransactOpts, wad *big.Int) (*types.Transaction, error) {
+	_args := []interface{}{wad}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "Withdraw", Args: _args})
+	for _i := len(_m.onWithdraw) - 1; _i >= 0; _i-- {
+		if _e := _m.onWithdraw[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.tx, _e.err
+		}
+	}
+	return nil, fmt.Errorf("WETH9Mock: no results scripted for Withdraw%v", _args)
+}
+
+//////////////////////////////////////////////////////
+//		Events
+////////////////////////////////////////////////////
+
+// WETH9UnpackApprovalLogMock scripts the results of WETH9Mock.UnpackApprovalLog.
+type WETH9UnpackApprovalLogMock struct {
+	mock  *WETH9Mock
+	args  []interface{}
+	event *ApprovalLog
+	err   error
+}
+
+// OnUnpackApprovalLog scripts the results of unpacking the given log.
+func (_m *WETH9Mock) OnUnpackApprovalLog(log types.Log) *WETH9UnpackApprovalLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9UnpackApprovalLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackApprovalLog = append(_m.onUnpackApprovalLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *WETH9UnpackApprovalLogMock) Return(event *ApprovalLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackApprovalLog records the call and returns the results scripted for the log.
+func (_m *WETH9Mock) UnpackApprovalLog(log types.Log) (*ApprovalLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "UnpackApprovalLog", Args: _args})
+	for _i := len(_m.onUnpackApprovalLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackApprovalLog[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("WETH9Mock: no results scripted for UnpackApprovalLog")
+}
+
+// WETH9UnpackDepositLogMock scripts the results of WETH9Mock.UnpackDepositLog.
+type WETH9UnpackDepositLogMock struct {
+	mock  *WETH9Mock
+	args  []interface{}
+	event *DepositLog
+	err   error
+}
+
+// OnUnpackDepositLog scripts the results of unpacking the given log.
+func (_m *WETH9Mock) OnUnpackDepositLog(log types.Log) *WETH9UnpackDepositLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9UnpackDepositLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackDepositLog = append(_m.onUnpackDepositLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *WETH9UnpackDepositLogMock) Return(event *DepositLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackDepositLog records the call and returns the results scripted for the log.
+func (_m *WETH9Mock) UnpackDepositLog(log types.Log) (*DepositLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "UnpackDepositLog", Args: _args})
+	for _i := len(_m.onUnpackDepositLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackDepositLog[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("WETH9Mock: no results scripted for UnpackDepositLog")
+}
+
+// WETH9UnpackTransferLogMock scripts the results of WETH9Mock.UnpackTransferLog.
+type WETH9UnpackTransferLogMock struct {
+	mock  *WETH9Mock
+	args  []interface{}
+	event *TransferLog
+	err   error
+}
+
+// OnUnpackTransferLog scripts the results of unpacking the given log.
+func (_m *WETH9Mock) OnUnpackTransferLog(log types.Log) *WETH9UnpackTransferLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9UnpackTransferLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackTransferLog = append(_m.onUnpackTransferLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *WETH9UnpackTransferLogMock) Return(event *TransferLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackTransferLog records the call and returns the results scripted for the log.
+func (_m *WETH9Mock) UnpackTransferLog(log types.Log) (*TransferLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "UnpackTransferLog", Args: _args})
+	for _i := len(_m.onUnpackTransferLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackTransferLog[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("WETH9Mock: no results scripted for UnpackTransferLog")
+}
+
+// WETH9UnpackWithdrawalLogMock scripts the results of WETH9Mock.UnpackWithdrawalLog.
+type WETH9UnpackWithdrawalLogMock struct {
+	mock  *WETH9Mock
+	args  []interface{}
+	event *WithdrawalLog
+	err   error
+}
+
+// OnUnpackWithdrawalLog scripts the results of unpacking the given log.
+func (_m *WETH9Mock) OnUnpackWithdrawalLog(log types.Log) *WETH9UnpackWithdrawalLogMock {
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_e := &WETH9UnpackWithdrawalLogMock{mock: _m, args: []interface{}{log}}
+	_m.onUnpackWithdrawalLog = append(_m.onUnpackWithdrawalLog, _e)
+	return _e
+}
+
+// Return sets the results of unpacking the scripted log.
+func (_e *WETH9UnpackWithdrawalLogMock) Return(event *WithdrawalLog, err error) {
+	_e.mock.mu.Lock()
+	defer _e.mock.mu.Unlock()
+	_e.event, _e.err = event, err
+}
+
+// UnpackWithdrawalLog records the call and returns the results scripted for the log.
+func (_m *WETH9Mock) UnpackWithdrawalLog(log types.Log) (*WithdrawalLog, error) {
+	_args := []interface{}{log}
+	_m.mu.Lock()
+	defer _m.mu.Unlock()
+	_m.calls = append(_m.calls, WETH9MockCall{Method: "UnpackWithdrawalLog", Args: _args})
+	for _i := len(_m.onUnpackWithdrawalLog) - 1; _i >= 0; _i-- {
+		if _e := _m.onUnpackWithdrawalLog[_i]; matchWETH9MockArgs(_e.args, _args) {
+			return _e.event, _e.err
+		}
+	}
+	return nil, fmt.Errorf("WETH9Mock: no results scripted for UnpackWithdrawalLog")
+}
@@ -0,0 +1,74 @@
+package weth9
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/evan-forbes/buddy/sim"
+)
+
+func TestWETH9(t *testing.T) {
+	accounts := sim.NewAccounts("alice", "bob")
+	backend := sim.NewSimulatedBackend(accounts.Genesis(), 10000000)
+	defer backend.Close()
+	alice, bob := accounts["alice"], accounts["bob"]
+
+	address, _, weth, err := DeployWETH9(alice.TxOpts, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	if name, err := weth.Name(nil); err != nil || name != "Wrapped Ether" {
+		t.Errorf("name = %q, %v", name, err)
+	}
+	if symbol, err := weth.Symbol(nil); err != nil || symbol != "WETH" {
+		t.Errorf("symbol = %q, %v", symbol, err)
+	}
+	deposit := *alice.TxOpts
+	deposit.Value = big.NewInt(500)
+	if _, err := weth.Deposit(&deposit); err != nil {
+		t.Fatal(err)
+	}
+	// plain transfers of ether deposit too
+	nonce, err := backend.PendingNonceAt(context.Background(), bob.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := bob.TxOpts.Signer(types.HomesteadSigner{}, bob.Address, types.NewTransaction(nonce, address, big.NewInt(200), 100000, big.NewInt(1), nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := backend.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+	if _, err := weth.Transfer(alice.TxOpts, bob.Address, big.NewInt(100)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := weth.Withdraw(bob.TxOpts, big.NewInt(250)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	for account, want := range map[*sim.Account]int64{alice: 400, bob: 50} {
+		if balance, err := weth.BalanceOf(nil, account.Address); err != nil || balance.Int64() != want {
+			t.Errorf("balance of %s = %v, %v, want %d", account.Name, balance, err, want)
+		}
+	}
+	if total, err := weth.TotalSupply(nil); err != nil || total.Int64() != 450 {
+		t.Errorf("total supply = %v, %v, want 450", total, err)
+	}
+	withdrawals, err := weth.FilterWithdrawal(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !withdrawals.Next() || withdrawals.Event.Src != bob.Address || withdrawals.Event.Wad.Int64() != 250 {
+		t.Errorf("withdrawal was not logged")
+	}
+	if _, err := weth.Withdraw(bob.TxOpts, big.NewInt(51)); err == nil {
+		t.Errorf("withdrawing past the balance succeeded")
+	}
+}